@@ -0,0 +1,309 @@
+package acctest
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	// MockAccountID is the AWS account ID returned by mock endpoints
+	MockAccountID = "123456789012"
+
+	// MockRegion is the AWS region configured for providers using mock endpoints
+	MockRegion = endpoints.UsEast1RegionID
+)
+
+// mockSigningNameRegexp matches the service signing name in the credential scope of a SigV4 Authorization header.
+var mockSigningNameRegexp = regexp.MustCompile(`Credential=[^/]+/[^/]+/[^/]+/([^/]+)/aws4_request`)
+
+// mockService is an in-memory stand-in for a single AWS service API.
+type mockService interface {
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+// MockEndpoints is an in-process HTTP stand-in for a subset of AWS service APIs.
+//
+// Requests are routed to the service fake by the signing name in the SigV4
+// credential scope so that every service shares a single local endpoint.
+// STS GetCallerIdentity is always available so that provider credential
+// validation succeeds without network access.
+type MockEndpoints struct {
+	AccountID string
+	Region    string
+	URL       string
+
+	requestID uint64
+	server    *httptest.Server
+	services  map[string]mockService
+}
+
+// NewMockEndpoints starts mock endpoints for the given services (e.g. conns.SQS)
+// and stops them when the test and all its subtests complete.
+//
+// Supported services are DynamoDB, IAM, S3, SNS, SQS and STS.
+func NewMockEndpoints(t *testing.T, services ...string) *MockEndpoints {
+	t.Helper()
+
+	m := &MockEndpoints{
+		AccountID: MockAccountID,
+		Region:    MockRegion,
+		services:  make(map[string]mockService),
+	}
+
+	m.services[conns.STS] = newMockSTS(m)
+
+	for _, service := range services {
+		switch service {
+		case conns.DynamoDB:
+			m.services[service] = newMockDynamoDB(m)
+		case conns.IAM:
+			m.services[service] = newMockIAM(m)
+		case conns.S3:
+			m.services[service] = newMockS3(m)
+		case conns.SNS:
+			m.services[service] = newMockSNS(m)
+		case conns.SQS:
+			m.services[service] = newMockSQS(m)
+		case conns.STS:
+		default:
+			t.Fatalf("mock endpoints not supported for service: %s", service)
+		}
+	}
+
+	m.server = httptest.NewServer(m)
+	m.URL = m.server.URL
+	t.Cleanup(m.server.Close)
+
+	return m
+}
+
+// ConfigProvider returns a provider configuration pointing the configured services at the mock endpoints.
+//
+// Region, account ID and EC2 platform lookups that would otherwise require network access are skipped.
+// Any additional provider arguments or blocks are appended to the configuration.
+func (m *MockEndpoints) ConfigProvider(additional ...string) string {
+	services := make([]string, 0, len(m.services))
+	for service := range m.services {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	var endpoints strings.Builder
+	for _, service := range services {
+		fmt.Fprintf(&endpoints, "    %s = %q\n", service, m.URL)
+	}
+
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  access_key = "mock_access_key"
+  secret_key = "mock_secret_key"
+  region     = %[1]q
+
+  s3_force_path_style     = true
+  skip_get_ec2_platforms  = true
+  skip_metadata_api_check = true
+  skip_region_validation  = true
+
+  endpoints {
+%[2]s  }
+%[3]s
+}
+`, m.Region, endpoints.String(), strings.Join(additional, "\n"))
+}
+
+// ARN returns an ARN in the mock account and region.
+func (m *MockEndpoints) ARN(service, resource string) string {
+	return fmt.Sprintf("arn:%s:%s:%s:%s:%s", endpoints.AwsPartitionID, service, m.Region, m.AccountID, resource)
+}
+
+// GlobalARN returns an ARN in the mock account without a region.
+func (m *MockEndpoints) GlobalARN(service, resource string) string {
+	return fmt.Sprintf("arn:%s:%s::%s:%s", endpoints.AwsPartitionID, service, m.AccountID, resource)
+}
+
+func (m *MockEndpoints) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var service string
+
+	if matches := mockSigningNameRegexp.FindStringSubmatch(r.Header.Get("Authorization")); len(matches) > 1 {
+		service = matches[1]
+	}
+
+	log.Printf("[DEBUG] Mock endpoints request (%s): %s %s", service, r.Method, r.URL)
+
+	s, ok := m.services[service]
+
+	if !ok {
+		http.Error(w, fmt.Sprintf("mock endpoints not configured for service: %q", service), http.StatusNotImplemented)
+		return
+	}
+
+	s.ServeHTTP(w, r)
+}
+
+func (m *MockEndpoints) newRequestID() string {
+	return fmt.Sprintf("00000000-0000-0000-0000-%012d", atomic.AddUint64(&m.requestID, 1))
+}
+
+// mockQueryResponse is the envelope of a query protocol (e.g. IAM, SNS, SQS, STS) response.
+type mockQueryResponse struct {
+	XMLName          xml.Name
+	Result           interface{}
+	ResponseMetadata struct {
+		RequestId string
+	}
+}
+
+// writeQueryResult writes a query protocol response.
+// The result must be a struct with an XMLName of the form <Action>Result or nil.
+func (m *MockEndpoints) writeQueryResult(w http.ResponseWriter, action string, result interface{}) {
+	response := mockQueryResponse{
+		XMLName: xml.Name{Local: action + "Response"},
+		Result:  result,
+	}
+	response.ResponseMetadata.RequestId = m.newRequestID()
+
+	m.writeXML(w, http.StatusOK, response)
+}
+
+// writeQueryError writes a query protocol error response.
+func (m *MockEndpoints) writeQueryError(w http.ResponseWriter, status int, code, message string) {
+	type errorResponse struct {
+		XMLName xml.Name `xml:"ErrorResponse"`
+		Error   struct {
+			Type    string
+			Code    string
+			Message string
+		}
+		RequestId string
+	}
+
+	response := errorResponse{RequestId: m.newRequestID()}
+	response.Error.Type = "Sender"
+	response.Error.Code = code
+	response.Error.Message = message
+
+	m.writeXML(w, status, response)
+}
+
+func (m *MockEndpoints) writeXML(w http.ResponseWriter, status int, v interface{}) {
+	b, err := xml.Marshal(v)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/xml")
+	w.Header().Set("X-Amzn-RequestId", m.newRequestID())
+	w.WriteHeader(status)
+	io.WriteString(w, xml.Header)
+	w.Write(b)
+}
+
+// readJSONRequest unmarshals a JSON protocol (e.g. DynamoDB) request body into an AWS SDK input struct.
+func readJSONRequest(r *http.Request, v interface{}) error {
+	defer r.Body.Close()
+
+	return jsonutil.UnmarshalJSON(v, r.Body)
+}
+
+// writeJSONResult writes a JSON protocol response from an AWS SDK output struct.
+func (m *MockEndpoints) writeJSONResult(w http.ResponseWriter, v interface{}) {
+	b, err := jsonutil.BuildJSON(v)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+	w.Header().Set("X-Amzn-RequestId", m.newRequestID())
+	w.WriteHeader(http.StatusOK)
+	w.Write(b)
+}
+
+// writeJSONError writes a JSON protocol error response.
+func (m *MockEndpoints) writeJSONError(w http.ResponseWriter, status int, code, message string) {
+	b, _ := json.Marshal(map[string]string{
+		"__type":  code,
+		"message": message,
+	})
+
+	w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+	w.Header().Set("X-Amzn-RequestId", m.newRequestID())
+	w.WriteHeader(status)
+	w.Write(b)
+}
+
+// mockTag is a key/value tag as serialized by query protocol APIs.
+type mockTag struct {
+	Key   string
+	Value string
+}
+
+// mockQueryMap parses a map of the form <prefix>.N.<key> and <prefix>.N.<value> from a query protocol request,
+// e.g. Attribute.1.Name and Attribute.1.Value or Tags.member.1.Key and Tags.member.1.Value.
+func mockQueryMap(r *http.Request, prefix, key, value string) map[string]string {
+	m := make(map[string]string)
+
+	for i := 1; ; i++ {
+		k := r.Form.Get(fmt.Sprintf("%s.%d.%s", prefix, i, key))
+
+		if k == "" {
+			break
+		}
+
+		m[k] = r.Form.Get(fmt.Sprintf("%s.%d.%s", prefix, i, value))
+	}
+
+	return m
+}
+
+// mockQueryList parses a list of the form <prefix>.N from a query protocol request,
+// e.g. TagKey.1 or TagKeys.member.1.
+func mockQueryList(r *http.Request, prefix string) []string {
+	var values []string
+
+	for i := 1; ; i++ {
+		value := r.Form.Get(fmt.Sprintf("%s.%d", prefix, i))
+
+		if value == "" {
+			break
+		}
+
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// mockTagList returns tags sorted by key in query protocol form.
+func mockTagList(tags map[string]string) []mockTag {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	l := make([]mockTag, 0, len(keys))
+	for _, k := range keys {
+		l = append(l, mockTag{Key: k, Value: tags[k]})
+	}
+
+	return l
+}
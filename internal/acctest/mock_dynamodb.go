@@ -0,0 +1,419 @@
+package acctest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+const (
+	mockDynamoDBErrCodeResourceInUse    = "com.amazonaws.dynamodb.v20120810#ResourceInUseException"
+	mockDynamoDBErrCodeResourceNotFound = "com.amazonaws.dynamodb.v20120810#ResourceNotFoundException"
+	mockDynamoDBErrCodeValidation       = "com.amazon.coral.validate#ValidationException"
+)
+
+// mockDynamoDBTable is the in-memory state of a DynamoDB table.
+type mockDynamoDBTable struct {
+	continuousBackups *dynamodb.ContinuousBackupsDescription
+	description       *dynamodb.TableDescription
+	tags              map[string]string
+	timeToLive        *dynamodb.TimeToLiveDescription
+}
+
+// mockDynamoDB implements the DynamoDB table management API.
+type mockDynamoDB struct {
+	endpoints *MockEndpoints
+	mutex     sync.Mutex
+	tables    map[string]*mockDynamoDBTable // keyed by table name
+}
+
+func newMockDynamoDB(m *MockEndpoints) *mockDynamoDB {
+	return &mockDynamoDB{
+		endpoints: m,
+		tables:    make(map[string]*mockDynamoDBTable),
+	}
+}
+
+func (s *mockDynamoDB) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m := s.endpoints
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// e.g. X-Amz-Target: DynamoDB_20120810.CreateTable
+	target := r.Header.Get("X-Amz-Target")
+	operation := target[strings.LastIndex(target, ".")+1:]
+
+	switch operation {
+	case "CreateTable":
+		input := &dynamodb.CreateTableInput{}
+		if err := readJSONRequest(r, input); err != nil {
+			m.writeJSONError(w, http.StatusBadRequest, mockDynamoDBErrCodeValidation, err.Error())
+			return
+		}
+
+		name := aws.StringValue(input.TableName)
+
+		if _, ok := s.tables[name]; ok {
+			m.writeJSONError(w, http.StatusBadRequest, mockDynamoDBErrCodeResourceInUse, fmt.Sprintf("Table already exists: %s", name))
+			return
+		}
+
+		now := time.Now().UTC().Truncate(time.Second)
+		table := &mockDynamoDBTable{
+			continuousBackups: &dynamodb.ContinuousBackupsDescription{
+				ContinuousBackupsStatus: aws.String(dynamodb.ContinuousBackupsStatusEnabled),
+				PointInTimeRecoveryDescription: &dynamodb.PointInTimeRecoveryDescription{
+					PointInTimeRecoveryStatus: aws.String(dynamodb.PointInTimeRecoveryStatusDisabled),
+				},
+			},
+			description: &dynamodb.TableDescription{
+				AttributeDefinitions: input.AttributeDefinitions,
+				CreationDateTime:     aws.Time(now),
+				ItemCount:            aws.Int64(0),
+				KeySchema:            input.KeySchema,
+				ProvisionedThroughput: &dynamodb.ProvisionedThroughputDescription{
+					NumberOfDecreasesToday: aws.Int64(0),
+					ReadCapacityUnits:      aws.Int64(0),
+					WriteCapacityUnits:     aws.Int64(0),
+				},
+				TableArn:       aws.String(m.ARN("dynamodb", "table/"+name)),
+				TableId:        aws.String(fmt.Sprintf("00000000-0000-0000-0000-%012d", len(s.tables)+1)),
+				TableName:      aws.String(name),
+				TableSizeBytes: aws.Int64(0),
+				TableStatus:    aws.String(dynamodb.TableStatusActive),
+			},
+			tags: make(map[string]string),
+			timeToLive: &dynamodb.TimeToLiveDescription{
+				TimeToLiveStatus: aws.String(dynamodb.TimeToLiveStatusDisabled),
+			},
+		}
+
+		mockDynamoDBSetBillingMode(table.description, input.BillingMode, input.ProvisionedThroughput, now)
+		mockDynamoDBSetStreamSpecification(table.description, input.StreamSpecification, now)
+
+		for _, gsi := range input.GlobalSecondaryIndexes {
+			table.description.GlobalSecondaryIndexes = append(table.description.GlobalSecondaryIndexes, mockDynamoDBGlobalSecondaryIndexDescription(m, name, gsi))
+		}
+
+		for _, lsi := range input.LocalSecondaryIndexes {
+			table.description.LocalSecondaryIndexes = append(table.description.LocalSecondaryIndexes, &dynamodb.LocalSecondaryIndexDescription{
+				IndexArn:   aws.String(m.ARN("dynamodb", fmt.Sprintf("table/%s/index/%s", name, aws.StringValue(lsi.IndexName)))),
+				IndexName:  lsi.IndexName,
+				KeySchema:  lsi.KeySchema,
+				Projection: lsi.Projection,
+			})
+		}
+
+		for _, tag := range input.Tags {
+			table.tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+
+		s.tables[name] = table
+
+		m.writeJSONResult(w, &dynamodb.CreateTableOutput{TableDescription: table.description})
+	case "DeleteTable":
+		input := &dynamodb.DeleteTableInput{}
+		if err := readJSONRequest(r, input); err != nil {
+			m.writeJSONError(w, http.StatusBadRequest, mockDynamoDBErrCodeValidation, err.Error())
+			return
+		}
+
+		table, ok := s.table(w, aws.StringValue(input.TableName))
+		if !ok {
+			return
+		}
+
+		delete(s.tables, aws.StringValue(input.TableName))
+		table.description.TableStatus = aws.String(dynamodb.TableStatusDeleting)
+
+		m.writeJSONResult(w, &dynamodb.DeleteTableOutput{TableDescription: table.description})
+	case "DescribeContinuousBackups":
+		input := &dynamodb.DescribeContinuousBackupsInput{}
+		if err := readJSONRequest(r, input); err != nil {
+			m.writeJSONError(w, http.StatusBadRequest, mockDynamoDBErrCodeValidation, err.Error())
+			return
+		}
+
+		table, ok := s.table(w, aws.StringValue(input.TableName))
+		if !ok {
+			return
+		}
+
+		m.writeJSONResult(w, &dynamodb.DescribeContinuousBackupsOutput{ContinuousBackupsDescription: table.continuousBackups})
+	case "DescribeTable":
+		input := &dynamodb.DescribeTableInput{}
+		if err := readJSONRequest(r, input); err != nil {
+			m.writeJSONError(w, http.StatusBadRequest, mockDynamoDBErrCodeValidation, err.Error())
+			return
+		}
+
+		table, ok := s.table(w, aws.StringValue(input.TableName))
+		if !ok {
+			return
+		}
+
+		m.writeJSONResult(w, &dynamodb.DescribeTableOutput{Table: table.description})
+	case "DescribeTimeToLive":
+		input := &dynamodb.DescribeTimeToLiveInput{}
+		if err := readJSONRequest(r, input); err != nil {
+			m.writeJSONError(w, http.StatusBadRequest, mockDynamoDBErrCodeValidation, err.Error())
+			return
+		}
+
+		table, ok := s.table(w, aws.StringValue(input.TableName))
+		if !ok {
+			return
+		}
+
+		m.writeJSONResult(w, &dynamodb.DescribeTimeToLiveOutput{TimeToLiveDescription: table.timeToLive})
+	case "ListTables":
+		names := make([]string, 0, len(s.tables))
+		for name := range s.tables {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		m.writeJSONResult(w, &dynamodb.ListTablesOutput{TableNames: aws.StringSlice(names)})
+	case "ListTagsOfResource":
+		input := &dynamodb.ListTagsOfResourceInput{}
+		if err := readJSONRequest(r, input); err != nil {
+			m.writeJSONError(w, http.StatusBadRequest, mockDynamoDBErrCodeValidation, err.Error())
+			return
+		}
+
+		table, ok := s.tableByARN(w, aws.StringValue(input.ResourceArn))
+		if !ok {
+			return
+		}
+
+		output := &dynamodb.ListTagsOfResourceOutput{}
+		for _, tag := range mockTagList(table.tags) {
+			output.Tags = append(output.Tags, &dynamodb.Tag{Key: aws.String(tag.Key), Value: aws.String(tag.Value)})
+		}
+
+		m.writeJSONResult(w, output)
+	case "TagResource":
+		input := &dynamodb.TagResourceInput{}
+		if err := readJSONRequest(r, input); err != nil {
+			m.writeJSONError(w, http.StatusBadRequest, mockDynamoDBErrCodeValidation, err.Error())
+			return
+		}
+
+		table, ok := s.tableByARN(w, aws.StringValue(input.ResourceArn))
+		if !ok {
+			return
+		}
+
+		for _, tag := range input.Tags {
+			table.tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+
+		m.writeJSONResult(w, &dynamodb.TagResourceOutput{})
+	case "UntagResource":
+		input := &dynamodb.UntagResourceInput{}
+		if err := readJSONRequest(r, input); err != nil {
+			m.writeJSONError(w, http.StatusBadRequest, mockDynamoDBErrCodeValidation, err.Error())
+			return
+		}
+
+		table, ok := s.tableByARN(w, aws.StringValue(input.ResourceArn))
+		if !ok {
+			return
+		}
+
+		for _, key := range input.TagKeys {
+			delete(table.tags, aws.StringValue(key))
+		}
+
+		m.writeJSONResult(w, &dynamodb.UntagResourceOutput{})
+	case "UpdateContinuousBackups":
+		input := &dynamodb.UpdateContinuousBackupsInput{}
+		if err := readJSONRequest(r, input); err != nil {
+			m.writeJSONError(w, http.StatusBadRequest, mockDynamoDBErrCodeValidation, err.Error())
+			return
+		}
+
+		table, ok := s.table(w, aws.StringValue(input.TableName))
+		if !ok {
+			return
+		}
+
+		status := dynamodb.PointInTimeRecoveryStatusDisabled
+		if input.PointInTimeRecoverySpecification != nil && aws.BoolValue(input.PointInTimeRecoverySpecification.PointInTimeRecoveryEnabled) {
+			status = dynamodb.PointInTimeRecoveryStatusEnabled
+		}
+		table.continuousBackups.PointInTimeRecoveryDescription.PointInTimeRecoveryStatus = aws.String(status)
+
+		m.writeJSONResult(w, &dynamodb.UpdateContinuousBackupsOutput{ContinuousBackupsDescription: table.continuousBackups})
+	case "UpdateTable":
+		input := &dynamodb.UpdateTableInput{}
+		if err := readJSONRequest(r, input); err != nil {
+			m.writeJSONError(w, http.StatusBadRequest, mockDynamoDBErrCodeValidation, err.Error())
+			return
+		}
+
+		name := aws.StringValue(input.TableName)
+		table, ok := s.table(w, name)
+		if !ok {
+			return
+		}
+
+		now := time.Now().UTC().Truncate(time.Second)
+
+		if input.AttributeDefinitions != nil {
+			table.description.AttributeDefinitions = input.AttributeDefinitions
+		}
+
+		if input.BillingMode != nil || input.ProvisionedThroughput != nil {
+			billingMode := input.BillingMode
+			if billingMode == nil && table.description.BillingModeSummary != nil {
+				billingMode = table.description.BillingModeSummary.BillingMode
+			}
+
+			mockDynamoDBSetBillingMode(table.description, billingMode, input.ProvisionedThroughput, now)
+		}
+
+		if input.StreamSpecification != nil {
+			mockDynamoDBSetStreamSpecification(table.description, input.StreamSpecification, now)
+		}
+
+		for _, update := range input.GlobalSecondaryIndexUpdates {
+			switch {
+			case update.Create != nil:
+				table.description.GlobalSecondaryIndexes = append(table.description.GlobalSecondaryIndexes, mockDynamoDBGlobalSecondaryIndexDescription(m, name, &dynamodb.GlobalSecondaryIndex{
+					IndexName:             update.Create.IndexName,
+					KeySchema:             update.Create.KeySchema,
+					Projection:            update.Create.Projection,
+					ProvisionedThroughput: update.Create.ProvisionedThroughput,
+				}))
+			case update.Delete != nil:
+				var gsis []*dynamodb.GlobalSecondaryIndexDescription
+				for _, gsi := range table.description.GlobalSecondaryIndexes {
+					if aws.StringValue(gsi.IndexName) != aws.StringValue(update.Delete.IndexName) {
+						gsis = append(gsis, gsi)
+					}
+				}
+				table.description.GlobalSecondaryIndexes = gsis
+			case update.Update != nil:
+				for _, gsi := range table.description.GlobalSecondaryIndexes {
+					if aws.StringValue(gsi.IndexName) == aws.StringValue(update.Update.IndexName) && update.Update.ProvisionedThroughput != nil {
+						gsi.ProvisionedThroughput.ReadCapacityUnits = update.Update.ProvisionedThroughput.ReadCapacityUnits
+						gsi.ProvisionedThroughput.WriteCapacityUnits = update.Update.ProvisionedThroughput.WriteCapacityUnits
+					}
+				}
+			}
+		}
+
+		m.writeJSONResult(w, &dynamodb.UpdateTableOutput{TableDescription: table.description})
+	case "UpdateTimeToLive":
+		input := &dynamodb.UpdateTimeToLiveInput{}
+		if err := readJSONRequest(r, input); err != nil {
+			m.writeJSONError(w, http.StatusBadRequest, mockDynamoDBErrCodeValidation, err.Error())
+			return
+		}
+
+		table, ok := s.table(w, aws.StringValue(input.TableName))
+		if !ok {
+			return
+		}
+
+		table.timeToLive = &dynamodb.TimeToLiveDescription{
+			TimeToLiveStatus: aws.String(dynamodb.TimeToLiveStatusDisabled),
+		}
+
+		if spec := input.TimeToLiveSpecification; spec != nil && aws.BoolValue(spec.Enabled) {
+			table.timeToLive.AttributeName = spec.AttributeName
+			table.timeToLive.TimeToLiveStatus = aws.String(dynamodb.TimeToLiveStatusEnabled)
+		}
+
+		m.writeJSONResult(w, &dynamodb.UpdateTimeToLiveOutput{TimeToLiveSpecification: input.TimeToLiveSpecification})
+	default:
+		m.writeJSONError(w, http.StatusBadRequest, "com.amazon.coral.service#UnknownOperationException", "mock DynamoDB does not implement operation: "+operation)
+	}
+}
+
+// table returns the named table or writes a ResourceNotFoundException.
+func (s *mockDynamoDB) table(w http.ResponseWriter, name string) (*mockDynamoDBTable, bool) {
+	table, ok := s.tables[name]
+
+	if !ok {
+		s.endpoints.writeJSONError(w, http.StatusBadRequest, mockDynamoDBErrCodeResourceNotFound, fmt.Sprintf("Requested resource not found: Table: %s not found", name))
+	}
+
+	return table, ok
+}
+
+// tableByARN returns the table with the given ARN or writes a ResourceNotFoundException.
+func (s *mockDynamoDB) tableByARN(w http.ResponseWriter, arn string) (*mockDynamoDBTable, bool) {
+	for _, table := range s.tables {
+		if aws.StringValue(table.description.TableArn) == arn {
+			return table, true
+		}
+	}
+
+	s.endpoints.writeJSONError(w, http.StatusBadRequest, mockDynamoDBErrCodeResourceNotFound, fmt.Sprintf("Requested resource not found: ResourceArn: %s not found", arn))
+
+	return nil, false
+}
+
+func mockDynamoDBSetBillingMode(table *dynamodb.TableDescription, billingMode *string, throughput *dynamodb.ProvisionedThroughput, now time.Time) {
+	if aws.StringValue(billingMode) == dynamodb.BillingModePayPerRequest {
+		table.BillingModeSummary = &dynamodb.BillingModeSummary{
+			BillingMode:                       aws.String(dynamodb.BillingModePayPerRequest),
+			LastUpdateToPayPerRequestDateTime: aws.Time(now),
+		}
+		table.ProvisionedThroughput.ReadCapacityUnits = aws.Int64(0)
+		table.ProvisionedThroughput.WriteCapacityUnits = aws.Int64(0)
+
+		return
+	}
+
+	table.BillingModeSummary = nil
+
+	if throughput != nil {
+		table.ProvisionedThroughput.ReadCapacityUnits = throughput.ReadCapacityUnits
+		table.ProvisionedThroughput.WriteCapacityUnits = throughput.WriteCapacityUnits
+	}
+}
+
+func mockDynamoDBSetStreamSpecification(table *dynamodb.TableDescription, spec *dynamodb.StreamSpecification, now time.Time) {
+	if spec == nil || !aws.BoolValue(spec.StreamEnabled) {
+		table.StreamSpecification = nil
+
+		return
+	}
+
+	label := now.Format("2006-01-02T15:04:05.000")
+	table.StreamSpecification = spec
+	table.LatestStreamLabel = aws.String(label)
+	table.LatestStreamArn = aws.String(fmt.Sprintf("%s/stream/%s", aws.StringValue(table.TableArn), label))
+}
+
+func mockDynamoDBGlobalSecondaryIndexDescription(m *MockEndpoints, tableName string, gsi *dynamodb.GlobalSecondaryIndex) *dynamodb.GlobalSecondaryIndexDescription {
+	description := &dynamodb.GlobalSecondaryIndexDescription{
+		IndexArn:    aws.String(m.ARN("dynamodb", fmt.Sprintf("table/%s/index/%s", tableName, aws.StringValue(gsi.IndexName)))),
+		IndexName:   gsi.IndexName,
+		IndexStatus: aws.String(dynamodb.IndexStatusActive),
+		KeySchema:   gsi.KeySchema,
+		Projection:  gsi.Projection,
+		ProvisionedThroughput: &dynamodb.ProvisionedThroughputDescription{
+			NumberOfDecreasesToday: aws.Int64(0),
+			ReadCapacityUnits:      aws.Int64(0),
+			WriteCapacityUnits:     aws.Int64(0),
+		},
+	}
+
+	if gsi.ProvisionedThroughput != nil {
+		description.ProvisionedThroughput.ReadCapacityUnits = gsi.ProvisionedThroughput.ReadCapacityUnits
+		description.ProvisionedThroughput.WriteCapacityUnits = gsi.ProvisionedThroughput.WriteCapacityUnits
+	}
+
+	return description
+}
@@ -0,0 +1,332 @@
+package acctest
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	mockIAMErrCodeDeleteConflict      = "DeleteConflict"
+	mockIAMErrCodeEntityAlreadyExists = "EntityAlreadyExists"
+	mockIAMErrCodeNoSuchEntity        = "NoSuchEntity"
+)
+
+// mockIAMRole is the in-memory state of an IAM role.
+type mockIAMRole struct {
+	assumeRolePolicyDocument string
+	attachedPolicyARNs       map[string]struct{}
+	createDate               time.Time
+	description              string
+	inlinePolicies           map[string]string
+	maxSessionDuration       int
+	path                     string
+	permissionsBoundary      string
+	roleID                   string
+	roleName                 string
+	tags                     map[string]string
+}
+
+// mockIAM implements the IAM role management API.
+type mockIAM struct {
+	endpoints *MockEndpoints
+	mutex     sync.Mutex
+	nextID    int
+	roles     map[string]*mockIAMRole // keyed by role name
+}
+
+func newMockIAM(m *MockEndpoints) *mockIAM {
+	return &mockIAM{
+		endpoints: m,
+		roles:     make(map[string]*mockIAMRole),
+	}
+}
+
+type mockIAMRoleXML struct {
+	Arn                      string
+	AssumeRolePolicyDocument string
+	CreateDate               string
+	Description              string `xml:",omitempty"`
+	MaxSessionDuration       int
+	Path                     string
+	PermissionsBoundary      *struct {
+		PermissionsBoundaryArn  string
+		PermissionsBoundaryType string
+	} `xml:",omitempty"`
+	RoleId   string
+	RoleName string
+	Tags     []mockTag `xml:"Tags>member,omitempty"`
+}
+
+type mockIAMAttachedPolicyXML struct {
+	PolicyArn  string
+	PolicyName string
+}
+
+func (s *mockIAM) roleXML(role *mockIAMRole) mockIAMRoleXML {
+	v := mockIAMRoleXML{
+		Arn:                      s.endpoints.GlobalARN("iam", "role"+role.path+role.roleName),
+		AssumeRolePolicyDocument: url.QueryEscape(role.assumeRolePolicyDocument),
+		CreateDate:               role.createDate.Format(time.RFC3339),
+		Description:              role.description,
+		MaxSessionDuration:       role.maxSessionDuration,
+		Path:                     role.path,
+		RoleId:                   role.roleID,
+		RoleName:                 role.roleName,
+		Tags:                     mockTagList(role.tags),
+	}
+
+	if role.permissionsBoundary != "" {
+		v.PermissionsBoundary = &struct {
+			PermissionsBoundaryArn  string
+			PermissionsBoundaryType string
+		}{
+			PermissionsBoundaryArn:  role.permissionsBoundary,
+			PermissionsBoundaryType: "Policy",
+		}
+	}
+
+	return v
+}
+
+func (s *mockIAM) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m := s.endpoints
+
+	if err := r.ParseForm(); err != nil {
+		m.writeQueryError(w, http.StatusBadRequest, "MalformedInput", err.Error())
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	action := r.Form.Get("Action")
+	name := r.Form.Get("RoleName")
+
+	switch action {
+	case "CreateRole":
+		if _, ok := s.roles[name]; ok {
+			m.writeQueryError(w, http.StatusConflict, mockIAMErrCodeEntityAlreadyExists, fmt.Sprintf("Role with name %s already exists.", name))
+			return
+		}
+
+		s.nextID++
+		role := &mockIAMRole{
+			assumeRolePolicyDocument: r.Form.Get("AssumeRolePolicyDocument"),
+			attachedPolicyARNs:       make(map[string]struct{}),
+			createDate:               time.Now().UTC().Truncate(time.Second),
+			description:              r.Form.Get("Description"),
+			inlinePolicies:           make(map[string]string),
+			maxSessionDuration:       3600,
+			path:                     "/",
+			permissionsBoundary:      r.Form.Get("PermissionsBoundary"),
+			roleID:                   fmt.Sprintf("AROAMOCK%012d", s.nextID),
+			roleName:                 name,
+			tags:                     mockQueryMap(r, "Tags.member", "Key", "Value"),
+		}
+
+		if v := r.Form.Get("MaxSessionDuration"); v != "" {
+			role.maxSessionDuration, _ = strconv.Atoi(v)
+		}
+
+		if v := r.Form.Get("Path"); v != "" {
+			role.path = v
+		}
+
+		s.roles[name] = role
+
+		m.writeQueryResult(w, action, struct {
+			XMLName xml.Name `xml:"CreateRoleResult"`
+			Role    mockIAMRoleXML
+		}{Role: s.roleXML(role)})
+
+		return
+	case "ListRoles":
+		result := struct {
+			XMLName     xml.Name         `xml:"ListRolesResult"`
+			IsTruncated bool             `xml:"IsTruncated"`
+			Roles       []mockIAMRoleXML `xml:"Roles>member"`
+		}{}
+
+		names := make([]string, 0, len(s.roles))
+		for name := range s.roles {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			result.Roles = append(result.Roles, s.roleXML(s.roles[name]))
+		}
+
+		m.writeQueryResult(w, action, result)
+
+		return
+	}
+
+	role, ok := s.roles[name]
+
+	if !ok {
+		m.writeQueryError(w, http.StatusNotFound, mockIAMErrCodeNoSuchEntity, fmt.Sprintf("The role with name %s cannot be found.", name))
+		return
+	}
+
+	switch action {
+	case "AttachRolePolicy":
+		role.attachedPolicyARNs[r.Form.Get("PolicyArn")] = struct{}{}
+
+		m.writeQueryResult(w, action, nil)
+	case "DeleteRole":
+		if len(role.attachedPolicyARNs) > 0 || len(role.inlinePolicies) > 0 {
+			m.writeQueryError(w, http.StatusConflict, mockIAMErrCodeDeleteConflict, "Cannot delete entity, must detach all policies first.")
+			return
+		}
+
+		delete(s.roles, name)
+
+		m.writeQueryResult(w, action, nil)
+	case "DeleteRolePermissionsBoundary":
+		role.permissionsBoundary = ""
+
+		m.writeQueryResult(w, action, nil)
+	case "DeleteRolePolicy":
+		policyName := r.Form.Get("PolicyName")
+
+		if _, ok := role.inlinePolicies[policyName]; !ok {
+			m.writeQueryError(w, http.StatusNotFound, mockIAMErrCodeNoSuchEntity, fmt.Sprintf("The role policy with name %s cannot be found.", policyName))
+			return
+		}
+
+		delete(role.inlinePolicies, policyName)
+
+		m.writeQueryResult(w, action, nil)
+	case "DetachRolePolicy":
+		policyARN := r.Form.Get("PolicyArn")
+
+		if _, ok := role.attachedPolicyARNs[policyARN]; !ok {
+			m.writeQueryError(w, http.StatusNotFound, mockIAMErrCodeNoSuchEntity, fmt.Sprintf("Policy %s was not found.", policyARN))
+			return
+		}
+
+		delete(role.attachedPolicyARNs, policyARN)
+
+		m.writeQueryResult(w, action, nil)
+	case "GetRole":
+		m.writeQueryResult(w, action, struct {
+			XMLName xml.Name `xml:"GetRoleResult"`
+			Role    mockIAMRoleXML
+		}{Role: s.roleXML(role)})
+	case "GetRolePolicy":
+		policyName := r.Form.Get("PolicyName")
+		document, ok := role.inlinePolicies[policyName]
+
+		if !ok {
+			m.writeQueryError(w, http.StatusNotFound, mockIAMErrCodeNoSuchEntity, fmt.Sprintf("The role policy with name %s cannot be found.", policyName))
+			return
+		}
+
+		m.writeQueryResult(w, action, struct {
+			XMLName        xml.Name `xml:"GetRolePolicyResult"`
+			PolicyDocument string
+			PolicyName     string
+			RoleName       string
+		}{
+			PolicyDocument: url.QueryEscape(document),
+			PolicyName:     policyName,
+			RoleName:       name,
+		})
+	case "ListAttachedRolePolicies":
+		result := struct {
+			XMLName          xml.Name                   `xml:"ListAttachedRolePoliciesResult"`
+			AttachedPolicies []mockIAMAttachedPolicyXML `xml:"AttachedPolicies>member"`
+			IsTruncated      bool
+		}{}
+
+		for arn := range role.attachedPolicyARNs {
+			result.AttachedPolicies = append(result.AttachedPolicies, mockIAMAttachedPolicyXML{PolicyArn: arn, PolicyName: mockIAMPolicyName(arn)})
+		}
+		sort.Slice(result.AttachedPolicies, func(i, j int) bool {
+			return result.AttachedPolicies[i].PolicyArn < result.AttachedPolicies[j].PolicyArn
+		})
+
+		m.writeQueryResult(w, action, result)
+	case "ListInstanceProfilesForRole":
+		m.writeQueryResult(w, action, struct {
+			XMLName          xml.Name `xml:"ListInstanceProfilesForRoleResult"`
+			InstanceProfiles struct{}
+			IsTruncated      bool
+		}{})
+	case "ListRolePolicies":
+		result := struct {
+			XMLName     xml.Name `xml:"ListRolePoliciesResult"`
+			IsTruncated bool
+			PolicyNames []string `xml:"PolicyNames>member"`
+		}{}
+
+		for policyName := range role.inlinePolicies {
+			result.PolicyNames = append(result.PolicyNames, policyName)
+		}
+		sort.Strings(result.PolicyNames)
+
+		m.writeQueryResult(w, action, result)
+	case "ListRoleTags":
+		m.writeQueryResult(w, action, struct {
+			XMLName     xml.Name `xml:"ListRoleTagsResult"`
+			IsTruncated bool
+			Tags        []mockTag `xml:"Tags>member"`
+		}{Tags: mockTagList(role.tags)})
+	case "PutRolePermissionsBoundary":
+		role.permissionsBoundary = r.Form.Get("PermissionsBoundary")
+
+		m.writeQueryResult(w, action, nil)
+	case "PutRolePolicy":
+		role.inlinePolicies[r.Form.Get("PolicyName")] = r.Form.Get("PolicyDocument")
+
+		m.writeQueryResult(w, action, nil)
+	case "TagRole":
+		for k, v := range mockQueryMap(r, "Tags.member", "Key", "Value") {
+			role.tags[k] = v
+		}
+
+		m.writeQueryResult(w, action, nil)
+	case "UntagRole":
+		for _, k := range mockQueryList(r, "TagKeys.member") {
+			delete(role.tags, k)
+		}
+
+		m.writeQueryResult(w, action, nil)
+	case "UpdateAssumeRolePolicy":
+		role.assumeRolePolicyDocument = r.Form.Get("PolicyDocument")
+
+		m.writeQueryResult(w, action, nil)
+	case "UpdateRole":
+		if _, ok := r.Form["Description"]; ok {
+			role.description = r.Form.Get("Description")
+		}
+
+		if v := r.Form.Get("MaxSessionDuration"); v != "" {
+			role.maxSessionDuration, _ = strconv.Atoi(v)
+		}
+
+		m.writeQueryResult(w, action, nil)
+	case "UpdateRoleDescription":
+		role.description = r.Form.Get("Description")
+
+		m.writeQueryResult(w, action, struct {
+			XMLName xml.Name `xml:"UpdateRoleDescriptionResult"`
+			Role    mockIAMRoleXML
+		}{Role: s.roleXML(role)})
+	default:
+		m.writeQueryError(w, http.StatusBadRequest, "InvalidAction", "mock IAM does not implement action: "+action)
+	}
+}
+
+// mockIAMPolicyName returns the policy name from a policy ARN.
+func mockIAMPolicyName(arn string) string {
+	return arn[strings.LastIndex(arn, "/")+1:]
+}
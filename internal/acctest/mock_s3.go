@@ -0,0 +1,447 @@
+package acctest
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const mockS3Namespace = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+// mockS3SubresourceNotFound maps bucket and object subresources without a default
+// configuration to the error code returned when the configuration is not set.
+var mockS3SubresourceNotFound = map[string]string{
+	"cors":                "NoSuchCORSConfiguration",
+	"encryption":          "ServerSideEncryptionConfigurationNotFoundError",
+	"lifecycle":           "NoSuchLifecycleConfiguration",
+	"object-lock":         "ObjectLockConfigurationNotFoundError",
+	"ownershipControls":   "OwnershipControlsNotFoundError",
+	"policy":              "NoSuchBucketPolicy",
+	"publicAccessBlock":   "NoSuchPublicAccessBlockConfiguration",
+	"replication":         "ReplicationConfigurationNotFoundError",
+	"tagging":             "NoSuchTagSet",
+	"website":             "NoSuchWebsiteConfiguration",
+	"analytics":           "NoSuchConfiguration",
+	"intelligent-tiering": "NoSuchConfiguration",
+	"inventory":           "NoSuchConfiguration",
+	"metrics":             "NoSuchConfiguration",
+}
+
+// mockS3SubresourceDefaults are the configurations returned for unset bucket subresources.
+var mockS3SubresourceDefaults = map[string]string{
+	"accelerate":     `<AccelerateConfiguration xmlns="` + mockS3Namespace + `"/>`,
+	"logging":        `<BucketLoggingStatus xmlns="` + mockS3Namespace + `"/>`,
+	"notification":   `<NotificationConfiguration xmlns="` + mockS3Namespace + `"/>`,
+	"requestPayment": `<RequestPaymentConfiguration xmlns="` + mockS3Namespace + `"><Payer>BucketOwner</Payer></RequestPaymentConfiguration>`,
+	"versioning":     `<VersioningConfiguration xmlns="` + mockS3Namespace + `"/>`,
+}
+
+// mockS3Object is the in-memory state of an S3 object.
+type mockS3Object struct {
+	body         []byte
+	header       http.Header
+	lastModified time.Time
+	subresources map[string][]byte
+}
+
+func (o *mockS3Object) etag() string {
+	sum := md5.Sum(o.body)
+
+	return strconv.Quote(hex.EncodeToString(sum[:]))
+}
+
+// mockS3Bucket is the in-memory state of an S3 bucket.
+type mockS3Bucket struct {
+	creationDate time.Time
+	objects      map[string]*mockS3Object
+	subresources map[string][]byte
+}
+
+// mockS3 implements the S3 bucket and object API using path-style addressing.
+//
+// Bucket configurations (e.g. ?cors, ?versioning) are stored and returned verbatim.
+type mockS3 struct {
+	buckets   map[string]*mockS3Bucket
+	endpoints *MockEndpoints
+	mutex     sync.Mutex
+}
+
+func newMockS3(m *MockEndpoints) *mockS3 {
+	return &mockS3{
+		buckets:   make(map[string]*mockS3Bucket),
+		endpoints: m,
+	}
+}
+
+type mockS3ObjectXML struct {
+	ETag         string
+	Key          string
+	LastModified string
+	Size         int
+	StorageClass string
+}
+
+func (s *mockS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/")
+
+	if path == "" {
+		s.listBuckets(w, r)
+		return
+	}
+
+	name, key := path, ""
+	if i := strings.Index(path, "/"); i >= 0 {
+		name, key = path[:i], path[i+1:]
+	}
+
+	subresource := mockS3Subresource(r)
+
+	if r.Method == http.MethodPut && key == "" && subresource == "" {
+		s.createBucket(w, r, name)
+		return
+	}
+
+	bucket, ok := s.buckets[name]
+
+	if !ok {
+		s.writeError(w, r, http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist")
+		return
+	}
+
+	if key != "" {
+		s.serveObject(w, r, bucket, key, subresource)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodHead:
+		w.Header().Set("X-Amz-Bucket-Region", s.endpoints.Region)
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodDelete && subresource == "":
+		if len(bucket.objects) > 0 {
+			s.writeError(w, r, http.StatusConflict, "BucketNotEmpty", "The bucket you tried to delete is not empty")
+			return
+		}
+
+		delete(s.buckets, name)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet && subresource == "location":
+		// us-east-1 is represented by an empty location constraint.
+		location := s.endpoints.Region
+		if location == MockRegion {
+			location = ""
+		}
+
+		s.writeXML(w, http.StatusOK, fmt.Sprintf(`<LocationConstraint xmlns="%s">%s</LocationConstraint>`, mockS3Namespace, location))
+	case r.Method == http.MethodGet && subresource == "acl":
+		if v, ok := bucket.subresources[subresource]; ok {
+			s.writeXML(w, http.StatusOK, string(v))
+			return
+		}
+
+		s.writeXML(w, http.StatusOK, s.defaultACL())
+	case r.Method == http.MethodGet && (subresource == "" || subresource == "versions"):
+		s.listObjects(w, r, name, bucket, subresource == "versions")
+	case r.Method == http.MethodPost && subresource == "delete":
+		s.deleteObjects(w, r, bucket)
+	default:
+		s.serveSubresource(w, r, bucket.subresources, subresource, false)
+	}
+}
+
+func (s *mockS3) createBucket(w http.ResponseWriter, r *http.Request, name string) {
+	if _, ok := s.buckets[name]; ok {
+		s.writeError(w, r, http.StatusConflict, "BucketAlreadyOwnedByYou", "Your previous request to create the named bucket succeeded and you already own it.")
+		return
+	}
+
+	s.buckets[name] = &mockS3Bucket{
+		creationDate: time.Now().UTC().Truncate(time.Second),
+		objects:      make(map[string]*mockS3Object),
+		subresources: make(map[string][]byte),
+	}
+
+	w.Header().Set("Location", "/"+name)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *mockS3) listBuckets(w http.ResponseWriter, r *http.Request) {
+	names := make([]string, 0, len(s.buckets))
+	for name := range s.buckets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<ListAllMyBucketsResult xmlns="%s"><Owner><ID>%s</ID></Owner><Buckets>`, mockS3Namespace, s.endpoints.AccountID)
+	for _, name := range names {
+		fmt.Fprintf(&b, `<Bucket><Name>%s</Name><CreationDate>%s</CreationDate></Bucket>`, name, s.buckets[name].creationDate.Format(time.RFC3339))
+	}
+	b.WriteString(`</Buckets></ListAllMyBucketsResult>`)
+
+	s.writeXML(w, http.StatusOK, b.String())
+}
+
+func (s *mockS3) listObjects(w http.ResponseWriter, r *http.Request, name string, bucket *mockS3Bucket, versions bool) {
+	prefix := r.URL.Query().Get("prefix")
+
+	keys := make([]string, 0, len(bucket.objects))
+	for key := range bucket.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	objects := make([]mockS3ObjectXML, 0, len(keys))
+	for _, key := range keys {
+		object := bucket.objects[key]
+		objects = append(objects, mockS3ObjectXML{
+			ETag:         object.etag(),
+			Key:          key,
+			LastModified: object.lastModified.Format(time.RFC3339),
+			Size:         len(object.body),
+			StorageClass: "STANDARD",
+		})
+	}
+
+	if versions {
+		type version struct {
+			mockS3ObjectXML
+			IsLatest  bool
+			VersionId string
+		}
+
+		result := struct {
+			XMLName     xml.Name `xml:"ListVersionsResult"`
+			Xmlns       string   `xml:"xmlns,attr"`
+			IsTruncated bool
+			Name        string
+			Prefix      string
+			Version     []version
+		}{Xmlns: mockS3Namespace, Name: name, Prefix: prefix}
+
+		for _, object := range objects {
+			result.Version = append(result.Version, version{mockS3ObjectXML: object, IsLatest: true, VersionId: "null"})
+		}
+
+		s.writeXMLValue(w, result)
+
+		return
+	}
+
+	result := struct {
+		XMLName     xml.Name `xml:"ListBucketResult"`
+		Xmlns       string   `xml:"xmlns,attr"`
+		Contents    []mockS3ObjectXML
+		IsTruncated bool
+		KeyCount    int
+		Name        string
+		Prefix      string
+	}{Xmlns: mockS3Namespace, Contents: objects, KeyCount: len(objects), Name: name, Prefix: prefix}
+
+	s.writeXMLValue(w, result)
+}
+
+func (s *mockS3) deleteObjects(w http.ResponseWriter, r *http.Request, bucket *mockS3Bucket) {
+	input := struct {
+		Object []struct {
+			Key string
+		}
+	}{}
+
+	b, _ := ioutil.ReadAll(r.Body)
+	if err := xml.Unmarshal(b, &input); err != nil {
+		s.writeError(w, r, http.StatusBadRequest, "MalformedXML", err.Error())
+		return
+	}
+
+	result := struct {
+		XMLName xml.Name `xml:"DeleteResult"`
+		Xmlns   string   `xml:"xmlns,attr"`
+		Deleted []struct {
+			Key string
+		}
+	}{Xmlns: mockS3Namespace}
+
+	for _, object := range input.Object {
+		delete(bucket.objects, object.Key)
+		result.Deleted = append(result.Deleted, struct{ Key string }{Key: object.Key})
+	}
+
+	s.writeXMLValue(w, result)
+}
+
+func (s *mockS3) serveObject(w http.ResponseWriter, r *http.Request, bucket *mockS3Bucket, key, subresource string) {
+	object, ok := bucket.objects[key]
+
+	if r.Method == http.MethodPut && subresource == "" {
+		body, _ := ioutil.ReadAll(r.Body)
+		object = &mockS3Object{
+			body:         body,
+			header:       http.Header{},
+			lastModified: time.Now().UTC().Truncate(time.Second),
+			subresources: make(map[string][]byte),
+		}
+
+		for k, v := range r.Header {
+			switch k := http.CanonicalHeaderKey(k); {
+			case strings.HasPrefix(k, "X-Amz-Meta-"), k == "Cache-Control", k == "Content-Disposition", k == "Content-Encoding", k == "Content-Language", k == "Content-Type":
+				object.header[k] = v
+			}
+		}
+
+		bucket.objects[key] = object
+
+		w.Header().Set("ETag", object.etag())
+		w.WriteHeader(http.StatusOK)
+
+		return
+	}
+
+	if r.Method == http.MethodDelete && subresource == "" {
+		delete(bucket.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	if !ok {
+		s.writeError(w, r, http.StatusNotFound, "NoSuchKey", "The specified key does not exist.")
+		return
+	}
+
+	if subresource != "" {
+		s.serveSubresource(w, r, object.subresources, subresource, true)
+		return
+	}
+
+	for k, v := range object.header {
+		w.Header()[k] = v
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(object.body)))
+	w.Header().Set("ETag", object.etag())
+	w.Header().Set("Last-Modified", object.lastModified.Format(http.TimeFormat))
+	w.WriteHeader(http.StatusOK)
+
+	if r.Method == http.MethodGet {
+		w.Write(object.body)
+	}
+}
+
+// serveSubresource stores, returns or removes a bucket or object configuration.
+func (s *mockS3) serveSubresource(w http.ResponseWriter, r *http.Request, subresources map[string][]byte, subresource string, object bool) {
+	// Configurations such as analytics and metrics are identified by ID.
+	id := subresource
+	if v := r.URL.Query().Get("id"); v != "" {
+		id += "=" + v
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if v, ok := subresources[id]; ok {
+			if subresource == "policy" {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				w.Write(v)
+				return
+			}
+
+			s.writeXML(w, http.StatusOK, string(v))
+			return
+		}
+
+		if v, ok := mockS3SubresourceDefaults[subresource]; ok {
+			s.writeXML(w, http.StatusOK, v)
+			return
+		}
+
+		// Unlike buckets, objects without tags return an empty tag set.
+		if subresource == "tagging" && object {
+			s.writeXML(w, http.StatusOK, fmt.Sprintf(`<Tagging xmlns="%s"><TagSet></TagSet></Tagging>`, mockS3Namespace))
+			return
+		}
+
+		if code, ok := mockS3SubresourceNotFound[subresource]; ok {
+			s.writeError(w, r, http.StatusNotFound, code, fmt.Sprintf("The %s configuration does not exist", subresource))
+			return
+		}
+	case http.MethodPut:
+		body, _ := ioutil.ReadAll(r.Body)
+		subresources[id] = body
+
+		w.WriteHeader(http.StatusOK)
+		return
+	case http.MethodDelete:
+		delete(subresources, id)
+
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	s.writeError(w, r, http.StatusNotImplemented, "NotImplemented", fmt.Sprintf("mock S3 does not implement %s ?%s", r.Method, subresource))
+}
+
+func (s *mockS3) defaultACL() string {
+	return fmt.Sprintf(`<AccessControlPolicy xmlns="%[1]s"><Owner><ID>%[2]s</ID></Owner><AccessControlList><Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser"><ID>%[2]s</ID></Grantee><Permission>FULL_CONTROL</Permission></Grant></AccessControlList></AccessControlPolicy>`, mockS3Namespace, s.endpoints.AccountID)
+}
+
+func (s *mockS3) writeError(w http.ResponseWriter, r *http.Request, status int, code, message string) {
+	if r.Method == http.MethodHead {
+		w.WriteHeader(status)
+		return
+	}
+
+	s.writeXML(w, status, fmt.Sprintf(`<Error><Code>%s</Code><Message>%s</Message><RequestId>%s</RequestId></Error>`, code, message, s.endpoints.newRequestID()))
+}
+
+func (s *mockS3) writeXML(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.Header().Set("X-Amz-Request-Id", s.endpoints.newRequestID())
+	w.WriteHeader(status)
+	w.Write([]byte(xml.Header + body))
+}
+
+func (s *mockS3) writeXMLValue(w http.ResponseWriter, v interface{}) {
+	b, err := xml.Marshal(v)
+
+	if err != nil {
+		s.writeXML(w, http.StatusInternalServerError, fmt.Sprintf(`<Error><Code>InternalError</Code><Message>%s</Message></Error>`, err))
+		return
+	}
+
+	s.writeXML(w, http.StatusOK, string(b))
+}
+
+// mockS3Subresource returns the subresource (e.g. "cors" for ?cors) of an S3 request.
+func mockS3Subresource(r *http.Request) string {
+	query := r.URL.Query()
+
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		switch k {
+		case "continuation-token", "delimiter", "encoding-type", "id", "key-marker", "list-type", "marker", "max-keys", "prefix", "start-after", "version-id", "version-id-marker", "x-id":
+			continue
+		}
+
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	if len(keys) == 0 {
+		return ""
+	}
+
+	return keys[0]
+}
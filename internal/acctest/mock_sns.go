@@ -0,0 +1,172 @@
+package acctest
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+)
+
+// mockSNSTopic is the in-memory state of an SNS topic.
+type mockSNSTopic struct {
+	attributes map[string]string
+	tags       map[string]string
+}
+
+// mockSNS implements the SNS topic management API.
+type mockSNS struct {
+	endpoints *MockEndpoints
+	mutex     sync.Mutex
+	topics    map[string]*mockSNSTopic // keyed by topic ARN
+}
+
+func newMockSNS(m *MockEndpoints) *mockSNS {
+	return &mockSNS{
+		endpoints: m,
+		topics:    make(map[string]*mockSNSTopic),
+	}
+}
+
+type mockSNSEntry struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
+
+type mockSNSTopicMember struct {
+	TopicArn string
+}
+
+func (s *mockSNS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m := s.endpoints
+
+	if err := r.ParseForm(); err != nil {
+		m.writeQueryError(w, http.StatusBadRequest, "MalformedInput", err.Error())
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	switch action := r.Form.Get("Action"); action {
+	case "CreateTopic":
+		arn := m.ARN("sns", r.Form.Get("Name"))
+
+		if _, ok := s.topics[arn]; !ok {
+			topic := &mockSNSTopic{
+				attributes: map[string]string{
+					"DisplayName":             "",
+					"Owner":                   m.AccountID,
+					"Policy":                  mockSNSDefaultTopicPolicy(m, arn),
+					"SubscriptionsConfirmed":  "0",
+					"SubscriptionsDeleted":    "0",
+					"SubscriptionsPending":    "0",
+					"TopicArn":                arn,
+					"EffectiveDeliveryPolicy": `{"http":{"defaultHealthyRetryPolicy":{"minDelayTarget":20,"maxDelayTarget":20,"numRetries":3,"numMaxDelayRetries":0,"numNoDelayRetries":0,"numMinDelayRetries":0,"backoffFunction":"linear"},"disableSubscriptionOverrides":false}}`,
+				},
+				tags: mockQueryMap(r, "Tags.member", "Key", "Value"),
+			}
+
+			for k, v := range mockQueryMap(r, "Attributes.entry", "key", "value") {
+				topic.attributes[k] = v
+			}
+
+			s.topics[arn] = topic
+		}
+
+		m.writeQueryResult(w, action, struct {
+			XMLName  xml.Name `xml:"CreateTopicResult"`
+			TopicArn string
+		}{TopicArn: arn})
+	case "DeleteTopic":
+		delete(s.topics, r.Form.Get("TopicArn"))
+
+		m.writeQueryResult(w, action, nil)
+	case "GetTopicAttributes":
+		topic, ok := s.topics[r.Form.Get("TopicArn")]
+
+		if !ok {
+			m.writeQueryError(w, http.StatusNotFound, "NotFound", "Topic does not exist")
+			return
+		}
+
+		result := struct {
+			XMLName    xml.Name       `xml:"GetTopicAttributesResult"`
+			Attributes []mockSNSEntry `xml:"Attributes>entry"`
+		}{}
+
+		for k, v := range topic.attributes {
+			result.Attributes = append(result.Attributes, mockSNSEntry{Key: k, Value: v})
+		}
+		sort.Slice(result.Attributes, func(i, j int) bool { return result.Attributes[i].Key < result.Attributes[j].Key })
+
+		m.writeQueryResult(w, action, result)
+	case "ListTagsForResource":
+		topic, ok := s.topics[r.Form.Get("ResourceArn")]
+
+		if !ok {
+			m.writeQueryError(w, http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+			return
+		}
+
+		m.writeQueryResult(w, action, struct {
+			XMLName xml.Name  `xml:"ListTagsForResourceResult"`
+			Tags    []mockTag `xml:"Tags>member"`
+		}{Tags: mockTagList(topic.tags)})
+	case "ListTopics":
+		result := struct {
+			XMLName xml.Name             `xml:"ListTopicsResult"`
+			Topics  []mockSNSTopicMember `xml:"Topics>member"`
+		}{}
+
+		for arn := range s.topics {
+			result.Topics = append(result.Topics, mockSNSTopicMember{TopicArn: arn})
+		}
+		sort.Slice(result.Topics, func(i, j int) bool { return result.Topics[i].TopicArn < result.Topics[j].TopicArn })
+
+		m.writeQueryResult(w, action, result)
+	case "SetTopicAttributes":
+		topic, ok := s.topics[r.Form.Get("TopicArn")]
+
+		if !ok {
+			m.writeQueryError(w, http.StatusNotFound, "NotFound", "Topic does not exist")
+			return
+		}
+
+		topic.attributes[r.Form.Get("AttributeName")] = r.Form.Get("AttributeValue")
+
+		m.writeQueryResult(w, action, nil)
+	case "TagResource":
+		topic, ok := s.topics[r.Form.Get("ResourceArn")]
+
+		if !ok {
+			m.writeQueryError(w, http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+			return
+		}
+
+		for k, v := range mockQueryMap(r, "Tags.member", "Key", "Value") {
+			topic.tags[k] = v
+		}
+
+		m.writeQueryResult(w, action, nil)
+	case "UntagResource":
+		topic, ok := s.topics[r.Form.Get("ResourceArn")]
+
+		if !ok {
+			m.writeQueryError(w, http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+			return
+		}
+
+		for _, k := range mockQueryList(r, "TagKeys.member") {
+			delete(topic.tags, k)
+		}
+
+		m.writeQueryResult(w, action, nil)
+	default:
+		m.writeQueryError(w, http.StatusBadRequest, "InvalidAction", "mock SNS does not implement action: "+action)
+	}
+}
+
+func mockSNSDefaultTopicPolicy(m *MockEndpoints, arn string) string {
+	return fmt.Sprintf(`{"Version":"2008-10-17","Id":"__default_policy_ID","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Principal":{"AWS":"*"},"Action":["SNS:GetTopicAttributes","SNS:SetTopicAttributes","SNS:AddPermission","SNS:RemovePermission","SNS:DeleteTopic","SNS:Subscribe","SNS:ListSubscriptionsByTopic","SNS:Publish"],"Resource":%[1]q,"Condition":{"StringEquals":{"AWS:SourceOwner":%[2]q}}}]}`, arn, m.AccountID)
+}
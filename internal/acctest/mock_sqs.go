@@ -0,0 +1,206 @@
+package acctest
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	mockSQSErrCodeNonExistentQueue = "AWS.SimpleQueueService.NonExistentQueue"
+)
+
+// mockSQSQueue is the in-memory state of an SQS queue.
+type mockSQSQueue struct {
+	attributes map[string]string
+	tags       map[string]string
+}
+
+// setAttributes updates queue attributes.
+// As with SQS, attributes set to an empty value are removed rather than returned empty.
+func (q *mockSQSQueue) setAttributes(attributes map[string]string) {
+	for k, v := range attributes {
+		if v == "" {
+			delete(q.attributes, k)
+			continue
+		}
+
+		q.attributes[k] = v
+	}
+}
+
+// mockSQS implements the SQS queue management API.
+type mockSQS struct {
+	endpoints *MockEndpoints
+	mutex     sync.Mutex
+	queues    map[string]*mockSQSQueue // keyed by queue URL
+}
+
+func newMockSQS(m *MockEndpoints) *mockSQS {
+	return &mockSQS{
+		endpoints: m,
+		queues:    make(map[string]*mockSQSQueue),
+	}
+}
+
+type mockSQSAttribute struct {
+	Name  string
+	Value string
+}
+
+func (s *mockSQS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m := s.endpoints
+
+	if err := r.ParseForm(); err != nil {
+		m.writeQueryError(w, http.StatusBadRequest, "MalformedInput", err.Error())
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	action := r.Form.Get("Action")
+
+	if action == "CreateQueue" {
+		name := r.Form.Get("QueueName")
+		url := fmt.Sprintf("%s/%s/%s", m.URL, m.AccountID, name)
+
+		if _, ok := s.queues[url]; !ok {
+			now := strconv.FormatInt(time.Now().Unix(), 10)
+			queue := &mockSQSQueue{
+				attributes: map[string]string{
+					"CreatedTimestamp":              now,
+					"DelaySeconds":                  "0",
+					"LastModifiedTimestamp":         now,
+					"MaximumMessageSize":            "262144",
+					"MessageRetentionPeriod":        "345600",
+					"QueueArn":                      m.ARN("sqs", name),
+					"ReceiveMessageWaitTimeSeconds": "0",
+					"VisibilityTimeout":             "30",
+				},
+				tags: mockQueryMap(r, "Tag", "Key", "Value"),
+			}
+
+			queue.setAttributes(mockQueryMap(r, "Attribute", "Name", "Value"))
+
+			s.queues[url] = queue
+		}
+
+		m.writeQueryResult(w, action, struct {
+			XMLName  xml.Name `xml:"CreateQueueResult"`
+			QueueUrl string
+		}{QueueUrl: url})
+
+		return
+	}
+
+	if action == "GetQueueUrl" {
+		url := fmt.Sprintf("%s/%s/%s", m.URL, m.AccountID, r.Form.Get("QueueName"))
+
+		if _, ok := s.queues[url]; !ok {
+			m.writeQueryError(w, http.StatusBadRequest, mockSQSErrCodeNonExistentQueue, "The specified queue does not exist.")
+			return
+		}
+
+		m.writeQueryResult(w, action, struct {
+			XMLName  xml.Name `xml:"GetQueueUrlResult"`
+			QueueUrl string
+		}{QueueUrl: url})
+
+		return
+	}
+
+	if action == "ListQueues" {
+		prefix := fmt.Sprintf("%s/%s/%s", m.URL, m.AccountID, r.Form.Get("QueueNamePrefix"))
+		result := struct {
+			XMLName  xml.Name `xml:"ListQueuesResult"`
+			QueueUrl []string
+		}{}
+
+		for url := range s.queues {
+			if strings.HasPrefix(url, prefix) {
+				result.QueueUrl = append(result.QueueUrl, url)
+			}
+		}
+		sort.Strings(result.QueueUrl)
+
+		m.writeQueryResult(w, action, result)
+
+		return
+	}
+
+	url := r.Form.Get("QueueUrl")
+	queue, ok := s.queues[url]
+
+	if !ok {
+		m.writeQueryError(w, http.StatusBadRequest, mockSQSErrCodeNonExistentQueue, "The specified queue does not exist.")
+		return
+	}
+
+	switch action {
+	case "DeleteQueue":
+		delete(s.queues, url)
+		m.writeQueryResult(w, action, nil)
+	case "GetQueueAttributes":
+		result := struct {
+			XMLName   xml.Name `xml:"GetQueueAttributesResult"`
+			Attribute []mockSQSAttribute
+		}{}
+
+		names := mockQueryList(r, "AttributeName")
+		all := len(names) == 0
+
+		for _, name := range names {
+			if name == "All" {
+				all = true
+			}
+		}
+
+		if all {
+			names = names[:0]
+			for name := range queue.attributes {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			if v, ok := queue.attributes[name]; ok {
+				result.Attribute = append(result.Attribute, mockSQSAttribute{Name: name, Value: v})
+			}
+		}
+
+		m.writeQueryResult(w, action, result)
+	case "ListQueueTags":
+		result := struct {
+			XMLName xml.Name `xml:"ListQueueTagsResult"`
+			Tag     []mockTag
+		}{Tag: mockTagList(queue.tags)}
+
+		m.writeQueryResult(w, action, result)
+	case "SetQueueAttributes":
+		queue.setAttributes(mockQueryMap(r, "Attribute", "Name", "Value"))
+		queue.attributes["LastModifiedTimestamp"] = strconv.FormatInt(time.Now().Unix(), 10)
+
+		m.writeQueryResult(w, action, nil)
+	case "TagQueue":
+		for k, v := range mockQueryMap(r, "Tag", "Key", "Value") {
+			queue.tags[k] = v
+		}
+
+		m.writeQueryResult(w, action, nil)
+	case "UntagQueue":
+		for _, k := range mockQueryList(r, "TagKey") {
+			delete(queue.tags, k)
+		}
+
+		m.writeQueryResult(w, action, nil)
+	default:
+		m.writeQueryError(w, http.StatusBadRequest, "InvalidAction", "mock SQS does not implement action: "+action)
+	}
+}
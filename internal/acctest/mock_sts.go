@@ -0,0 +1,40 @@
+package acctest
+
+import (
+	"encoding/xml"
+	"net/http"
+)
+
+// mockSTS implements STS GetCallerIdentity for the mock account.
+type mockSTS struct {
+	endpoints *MockEndpoints
+}
+
+func newMockSTS(m *MockEndpoints) *mockSTS {
+	return &mockSTS{endpoints: m}
+}
+
+func (s *mockSTS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m := s.endpoints
+
+	if err := r.ParseForm(); err != nil {
+		m.writeQueryError(w, http.StatusBadRequest, "MalformedInput", err.Error())
+		return
+	}
+
+	switch action := r.Form.Get("Action"); action {
+	case "GetCallerIdentity":
+		m.writeQueryResult(w, action, struct {
+			XMLName xml.Name `xml:"GetCallerIdentityResult"`
+			Account string
+			Arn     string
+			UserId  string
+		}{
+			Account: m.AccountID,
+			Arn:     m.GlobalARN("iam", "user/mock"),
+			UserId:  "AIDAMOCKUSERID",
+		})
+	default:
+		m.writeQueryError(w, http.StatusBadRequest, "InvalidAction", "mock STS does not implement action: "+action)
+	}
+}
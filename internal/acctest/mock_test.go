@@ -0,0 +1,357 @@
+package acctest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func testAccMockSession(t *testing.T, m *MockEndpoints) *session.Session {
+	t.Helper()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials:      credentials.NewStaticCredentials("mock_access_key", "mock_secret_key", ""),
+		Endpoint:         aws.String(m.URL),
+		MaxRetries:       aws.Int(0),
+		Region:           aws.String(m.Region),
+		S3ForcePathStyle: aws.Bool(true),
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	return sess
+}
+
+func TestMockEndpointsConfigProvider(t *testing.T) {
+	m := NewMockEndpoints(t, conns.SQS)
+	config := m.ConfigProvider()
+
+	for _, want := range []string{
+		`sqs = "` + m.URL + `"`,
+		`sts = "` + m.URL + `"`,
+		`skip_metadata_api_check = true`,
+	} {
+		if !strings.Contains(config, want) {
+			t.Errorf("provider configuration missing %q:\n%s", want, config)
+		}
+	}
+}
+
+func TestMockEndpointsSTS(t *testing.T) {
+	m := NewMockEndpoints(t)
+	conn := sts.New(testAccMockSession(t, m))
+
+	output, err := conn.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(output.Account), MockAccountID; got != want {
+		t.Errorf("got account %s, expected %s", got, want)
+	}
+}
+
+func TestMockEndpointsUnsupportedService(t *testing.T) {
+	m := NewMockEndpoints(t)
+	conn := sqs.New(testAccMockSession(t, m))
+
+	if _, err := conn.ListQueues(&sqs.ListQueuesInput{}); err == nil {
+		t.Fatal("expected error for service without mock endpoints")
+	}
+}
+
+func TestMockEndpointsSQS(t *testing.T) {
+	m := NewMockEndpoints(t, conns.SQS)
+	conn := sqs.New(testAccMockSession(t, m))
+
+	created, err := conn.CreateQueue(&sqs.CreateQueueInput{
+		QueueName:  aws.String("test"),
+		Attributes: aws.StringMap(map[string]string{sqs.QueueAttributeNameDelaySeconds: "10"}),
+		Tags:       aws.StringMap(map[string]string{"key1": "value1"}),
+	})
+
+	if err != nil {
+		t.Fatalf("error creating queue: %s", err)
+	}
+
+	url := created.QueueUrl
+
+	attributes, err := conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNameAll}),
+		QueueUrl:       url,
+	})
+
+	if err != nil {
+		t.Fatalf("error reading queue attributes: %s", err)
+	}
+
+	if got, want := aws.StringValue(attributes.Attributes[sqs.QueueAttributeNameDelaySeconds]), "10"; got != want {
+		t.Errorf("got DelaySeconds %s, expected %s", got, want)
+	}
+
+	if got, want := aws.StringValue(attributes.Attributes[sqs.QueueAttributeNameQueueArn]), m.ARN("sqs", "test"); got != want {
+		t.Errorf("got QueueArn %s, expected %s", got, want)
+	}
+
+	if _, err := conn.UntagQueue(&sqs.UntagQueueInput{QueueUrl: url, TagKeys: aws.StringSlice([]string{"key1"})}); err != nil {
+		t.Fatalf("error untagging queue: %s", err)
+	}
+
+	tags, err := conn.ListQueueTags(&sqs.ListQueueTagsInput{QueueUrl: url})
+
+	if err != nil {
+		t.Fatalf("error listing queue tags: %s", err)
+	}
+
+	if len(tags.Tags) != 0 {
+		t.Errorf("expected no tags, got %v", tags.Tags)
+	}
+
+	if _, err := conn.DeleteQueue(&sqs.DeleteQueueInput{QueueUrl: url}); err != nil {
+		t.Fatalf("error deleting queue: %s", err)
+	}
+
+	_, err = conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{QueueUrl: url})
+
+	if !tfawserr.ErrCodeEquals(err, sqs.ErrCodeQueueDoesNotExist) {
+		t.Errorf("expected %s error, got %v", sqs.ErrCodeQueueDoesNotExist, err)
+	}
+}
+
+func TestMockEndpointsSNS(t *testing.T) {
+	m := NewMockEndpoints(t, conns.SNS)
+	conn := sns.New(testAccMockSession(t, m))
+
+	created, err := conn.CreateTopic(&sns.CreateTopicInput{
+		Name:       aws.String("test"),
+		Attributes: aws.StringMap(map[string]string{"DisplayName": "Test"}),
+		Tags:       []*sns.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}},
+	})
+
+	if err != nil {
+		t.Fatalf("error creating topic: %s", err)
+	}
+
+	arn := created.TopicArn
+
+	attributes, err := conn.GetTopicAttributes(&sns.GetTopicAttributesInput{TopicArn: arn})
+
+	if err != nil {
+		t.Fatalf("error reading topic attributes: %s", err)
+	}
+
+	if got, want := aws.StringValue(attributes.Attributes["DisplayName"]), "Test"; got != want {
+		t.Errorf("got DisplayName %s, expected %s", got, want)
+	}
+
+	tags, err := conn.ListTagsForResource(&sns.ListTagsForResourceInput{ResourceArn: arn})
+
+	if err != nil {
+		t.Fatalf("error listing topic tags: %s", err)
+	}
+
+	if len(tags.Tags) != 1 || aws.StringValue(tags.Tags[0].Value) != "value1" {
+		t.Errorf("unexpected tags: %v", tags.Tags)
+	}
+
+	if _, err := conn.DeleteTopic(&sns.DeleteTopicInput{TopicArn: arn}); err != nil {
+		t.Fatalf("error deleting topic: %s", err)
+	}
+
+	_, err = conn.GetTopicAttributes(&sns.GetTopicAttributesInput{TopicArn: arn})
+
+	if !tfawserr.ErrCodeEquals(err, sns.ErrCodeNotFoundException) {
+		t.Errorf("expected %s error, got %v", sns.ErrCodeNotFoundException, err)
+	}
+}
+
+func TestMockEndpointsIAM(t *testing.T) {
+	m := NewMockEndpoints(t, conns.IAM)
+	conn := iam.New(testAccMockSession(t, m))
+	document := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+
+	_, err := conn.CreateRole(&iam.CreateRoleInput{
+		AssumeRolePolicyDocument: aws.String(document),
+		RoleName:                 aws.String("test"),
+		Tags:                     []*iam.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}},
+	})
+
+	if err != nil {
+		t.Fatalf("error creating role: %s", err)
+	}
+
+	output, err := conn.GetRole(&iam.GetRoleInput{RoleName: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("error reading role: %s", err)
+	}
+
+	if got, want := aws.StringValue(output.Role.Arn), m.GlobalARN("iam", "role/test"); got != want {
+		t.Errorf("got ARN %s, expected %s", got, want)
+	}
+
+	if len(output.Role.Tags) != 1 {
+		t.Errorf("unexpected tags: %v", output.Role.Tags)
+	}
+
+	if _, err := conn.AttachRolePolicy(&iam.AttachRolePolicyInput{PolicyArn: aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess"), RoleName: aws.String("test")}); err != nil {
+		t.Fatalf("error attaching role policy: %s", err)
+	}
+
+	_, err = conn.DeleteRole(&iam.DeleteRoleInput{RoleName: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, iam.ErrCodeDeleteConflictException) {
+		t.Errorf("expected %s error, got %v", iam.ErrCodeDeleteConflictException, err)
+	}
+
+	if _, err := conn.DetachRolePolicy(&iam.DetachRolePolicyInput{PolicyArn: aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess"), RoleName: aws.String("test")}); err != nil {
+		t.Fatalf("error detaching role policy: %s", err)
+	}
+
+	if _, err := conn.DeleteRole(&iam.DeleteRoleInput{RoleName: aws.String("test")}); err != nil {
+		t.Fatalf("error deleting role: %s", err)
+	}
+
+	_, err = conn.GetRole(&iam.GetRoleInput{RoleName: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		t.Errorf("expected %s error, got %v", iam.ErrCodeNoSuchEntityException, err)
+	}
+}
+
+func TestMockEndpointsDynamoDB(t *testing.T) {
+	m := NewMockEndpoints(t, conns.DynamoDB)
+	conn := dynamodb.New(testAccMockSession(t, m))
+
+	_, err := conn.CreateTable(&dynamodb.CreateTableInput{
+		AttributeDefinitions: []*dynamodb.AttributeDefinition{{AttributeName: aws.String("id"), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)}},
+		BillingMode:          aws.String(dynamodb.BillingModePayPerRequest),
+		KeySchema:            []*dynamodb.KeySchemaElement{{AttributeName: aws.String("id"), KeyType: aws.String(dynamodb.KeyTypeHash)}},
+		TableName:            aws.String("test"),
+	})
+
+	if err != nil {
+		t.Fatalf("error creating table: %s", err)
+	}
+
+	output, err := conn.DescribeTable(&dynamodb.DescribeTableInput{TableName: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("error reading table: %s", err)
+	}
+
+	if got, want := aws.StringValue(output.Table.TableStatus), dynamodb.TableStatusActive; got != want {
+		t.Errorf("got status %s, expected %s", got, want)
+	}
+
+	if got, want := aws.StringValue(output.Table.BillingModeSummary.BillingMode), dynamodb.BillingModePayPerRequest; got != want {
+		t.Errorf("got billing mode %s, expected %s", got, want)
+	}
+
+	if _, err := conn.DeleteTable(&dynamodb.DeleteTableInput{TableName: aws.String("test")}); err != nil {
+		t.Fatalf("error deleting table: %s", err)
+	}
+
+	_, err = conn.DescribeTable(&dynamodb.DescribeTableInput{TableName: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		t.Errorf("expected %s error, got %v", dynamodb.ErrCodeResourceNotFoundException, err)
+	}
+}
+
+func TestMockEndpointsS3(t *testing.T) {
+	m := NewMockEndpoints(t, conns.S3)
+	conn := s3.New(testAccMockSession(t, m))
+
+	if _, err := conn.CreateBucket(&s3.CreateBucketInput{Bucket: aws.String("test")}); err != nil {
+		t.Fatalf("error creating bucket: %s", err)
+	}
+
+	if _, err := conn.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String("test")}); err != nil {
+		t.Fatalf("error reading bucket: %s", err)
+	}
+
+	_, err := conn.GetBucketCors(&s3.GetBucketCorsInput{Bucket: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, "NoSuchCORSConfiguration") {
+		t.Errorf("expected NoSuchCORSConfiguration error, got %v", err)
+	}
+
+	_, err = conn.PutBucketVersioning(&s3.PutBucketVersioningInput{
+		Bucket:                  aws.String("test"),
+		VersioningConfiguration: &s3.VersioningConfiguration{Status: aws.String(s3.BucketVersioningStatusEnabled)},
+	})
+
+	if err != nil {
+		t.Fatalf("error putting bucket versioning: %s", err)
+	}
+
+	versioning, err := conn.GetBucketVersioning(&s3.GetBucketVersioningInput{Bucket: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("error reading bucket versioning: %s", err)
+	}
+
+	if got, want := aws.StringValue(versioning.Status), s3.BucketVersioningStatusEnabled; got != want {
+		t.Errorf("got versioning status %s, expected %s", got, want)
+	}
+
+	_, err = conn.PutObject(&s3.PutObjectInput{
+		Body:        strings.NewReader("content"),
+		Bucket:      aws.String("test"),
+		ContentType: aws.String("text/plain"),
+		Key:         aws.String("path/to/object"),
+	})
+
+	if err != nil {
+		t.Fatalf("error putting object: %s", err)
+	}
+
+	object, err := conn.HeadObject(&s3.HeadObjectInput{Bucket: aws.String("test"), Key: aws.String("path/to/object")})
+
+	if err != nil {
+		t.Fatalf("error reading object: %s", err)
+	}
+
+	if got, want := aws.StringValue(object.ContentType), "text/plain"; got != want {
+		t.Errorf("got content type %s, expected %s", got, want)
+	}
+
+	_, err = conn.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, "BucketNotEmpty") {
+		t.Errorf("expected BucketNotEmpty error, got %v", err)
+	}
+
+	list, err := conn.ListObjectsV2(&s3.ListObjectsV2Input{Bucket: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("error listing objects: %s", err)
+	}
+
+	if len(list.Contents) != 1 {
+		t.Errorf("expected 1 object, got %d", len(list.Contents))
+	}
+
+	if _, err := conn.DeleteObject(&s3.DeleteObjectInput{Bucket: aws.String("test"), Key: aws.String("path/to/object")}); err != nil {
+		t.Fatalf("error deleting object: %s", err)
+	}
+
+	if _, err := conn.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String("test")}); err != nil {
+		t.Fatalf("error deleting bucket: %s", err)
+	}
+}
@@ -0,0 +1,187 @@
+package conns
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// AssumeRole is the configuration of an IAM Role assumed by the provider.
+type AssumeRole struct {
+	RoleARN           string
+	DurationSeconds   int
+	ExternalID        string
+	Policy            string
+	PolicyARNs        []string
+	SessionName       string
+	Tags              map[string]string
+	TransitiveTagKeys []string
+}
+
+// applyToAWSBaseConfig configures aws-sdk-go-base to assume the role.
+func (r *AssumeRole) applyToAWSBaseConfig(c *awsbase.Config) {
+	c.AssumeRoleARN = r.RoleARN
+	c.AssumeRoleDurationSeconds = r.DurationSeconds
+	c.AssumeRoleExternalID = r.ExternalID
+	c.AssumeRolePolicy = r.Policy
+	c.AssumeRolePolicyARNs = r.PolicyARNs
+	c.AssumeRoleSessionName = r.SessionName
+	c.AssumeRoleTags = r.Tags
+	c.AssumeRoleTransitiveTagKeys = r.TransitiveTagKeys
+}
+
+// credentialsProvider returns an STS AssumeRole credentials provider for the role using the specified client.
+func (r *AssumeRole) credentialsProvider(conn *sts.STS) *stscreds.AssumeRoleProvider {
+	p := &stscreds.AssumeRoleProvider{
+		Client:   conn,
+		Duration: stscreds.DefaultDuration,
+		RoleARN:  r.RoleARN,
+	}
+
+	if r.DurationSeconds > 0 {
+		p.Duration = time.Duration(r.DurationSeconds) * time.Second
+	}
+
+	if r.ExternalID != "" {
+		p.ExternalID = aws.String(r.ExternalID)
+	}
+
+	if r.Policy != "" {
+		p.Policy = aws.String(r.Policy)
+	}
+
+	for _, policyARN := range r.PolicyARNs {
+		p.PolicyArns = append(p.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	p.RoleSessionName = r.SessionName
+	if p.RoleSessionName == "" {
+		p.RoleSessionName = fmt.Sprintf("%d", time.Now().UTC().UnixNano())
+	}
+
+	for k, v := range r.Tags {
+		p.Tags = append(p.Tags, &sts.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	if len(r.TransitiveTagKeys) > 0 {
+		p.TransitiveTagKeys = aws.StringSlice(r.TransitiveTagKeys)
+	}
+
+	return p
+}
+
+// chainAssumeRoles returns a session whose credentials are obtained by assuming each role in turn,
+// starting with the credentials of the specified session.
+// STS requests are sent to stsEndpoint, if set.
+func chainAssumeRoles(sess *session.Session, roles []*AssumeRole, stsEndpoint string) *session.Session {
+	for _, role := range roles {
+		log.Printf("[INFO] Chaining AssumeRole %s (SessionName: %q, ExternalId: %q)", role.RoleARN, role.SessionName, role.ExternalID)
+
+		conn := sts.New(sess.Copy(&aws.Config{Endpoint: aws.String(stsEndpoint)}))
+		sess = sess.Copy(&aws.Config{Credentials: credentials.NewCredentials(role.credentialsProvider(conn))})
+	}
+
+	return sess
+}
+
+// accountIDAndPartitionFromRoleARN returns the account ID and partition of the specified IAM Role.
+func accountIDAndPartitionFromRoleARN(roleARN string) (string, string, error) {
+	v, err := arn.Parse(roleARN)
+
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing IAM Role ARN (%s): %w", roleARN, err)
+	}
+
+	return v.AccountID, v.Partition, nil
+}
+
+// webIdentityExpiryWindow is how long before web identity credentials expire that they are refreshed,
+// re-reading any token file so that a rotated token is used.
+const webIdentityExpiryWindow = 5 * time.Minute
+
+// AssumeRoleWithWebIdentity is the configuration of an IAM Role assumed by the provider using an OIDC token.
+type AssumeRoleWithWebIdentity struct {
+	RoleARN              string
+	DurationSeconds      int
+	PolicyARNs           []string
+	SessionName          string
+	WebIdentityToken     string
+	WebIdentityTokenFile string
+}
+
+// staticTokenFetcher returns a web identity token configured inline.
+type staticTokenFetcher string
+
+func (f staticTokenFetcher) FetchToken(credentials.Context) ([]byte, error) {
+	return []byte(f), nil
+}
+
+// credentialsProvider returns an STS AssumeRoleWithWebIdentity credentials provider for the role using the specified client.
+// Any token file is read each time credentials are retrieved.
+func (r *AssumeRoleWithWebIdentity) credentialsProvider(conn *sts.STS) (*stscreds.WebIdentityRoleProvider, error) {
+	var tokenFetcher stscreds.TokenFetcher = staticTokenFetcher(r.WebIdentityToken)
+
+	if r.WebIdentityTokenFile != "" {
+		path, err := homedir.Expand(r.WebIdentityTokenFile)
+
+		if err != nil {
+			return nil, fmt.Errorf("error expanding web identity token file (%s): %w", r.WebIdentityTokenFile, err)
+		}
+
+		tokenFetcher = stscreds.FetchTokenPath(path)
+	}
+
+	sessionName := r.SessionName
+	if sessionName == "" {
+		sessionName = fmt.Sprintf("%d", time.Now().UTC().UnixNano())
+	}
+
+	p := stscreds.NewWebIdentityRoleProviderWithToken(conn, r.RoleARN, sessionName, tokenFetcher)
+	p.ExpiryWindow = webIdentityExpiryWindow
+
+	if r.DurationSeconds > 0 {
+		p.Duration = time.Duration(r.DurationSeconds) * time.Second
+	}
+
+	for _, policyARN := range r.PolicyARNs {
+		p.PolicyArns = append(p.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	return p, nil
+}
+
+// withWebIdentityCredentials returns a session whose credentials are obtained by assuming the role with a web identity.
+// STS requests are sent to stsEndpoint, if set.
+func withWebIdentityCredentials(sess *session.Session, role *AssumeRoleWithWebIdentity, stsEndpoint string) (*session.Session, error) {
+	log.Printf("[INFO] Attempting to AssumeRoleWithWebIdentity %s (SessionName: %q, WebIdentityTokenFile: %q)", role.RoleARN, role.SessionName, role.WebIdentityTokenFile)
+
+	// AssumeRoleWithWebIdentity requests are not signed.
+	conn := sts.New(sess.Copy(&aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		Endpoint:    aws.String(stsEndpoint),
+	}))
+
+	p, err := role.credentialsProvider(conn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sess.Copy(&aws.Config{Credentials: credentials.NewCredentials(p)}), nil
+}
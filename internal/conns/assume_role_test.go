@@ -0,0 +1,276 @@
+package conns
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func TestChainAssumeRoles(t *testing.T) {
+	var mutex sync.Mutex
+	var calls []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("error parsing form: %s", err)
+		}
+
+		// The access key ID is the first component of the credential scope.
+		accessKeyID := strings.SplitN(strings.TrimPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential="), "/", 2)[0]
+
+		mutex.Lock()
+		calls = append(calls, fmt.Sprintf("%s:%s:%s", r.Form.Get("Action"), accessKeyID, r.Form.Get("RoleSessionName")))
+		mutex.Unlock()
+
+		w.Header().Set("Content-Type", "text/xml")
+
+		switch r.Form.Get("Action") {
+		case "AssumeRole":
+			roleARN := r.Form.Get("RoleArn")
+			fmt.Fprintf(w, `<AssumeRoleResponse><AssumeRoleResult>
+<AssumedRoleUser><Arn>%[1]s</Arn><AssumedRoleId>AROA:%[2]s</AssumedRoleId></AssumedRoleUser>
+<Credentials><AccessKeyId>%[2]s</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken><Expiration>2099-01-01T00:00:00Z</Expiration></Credentials>
+</AssumeRoleResult></AssumeRoleResponse>`, roleARN, r.Form.Get("RoleSessionName"))
+		case "GetCallerIdentity":
+			fmt.Fprintf(w, `<GetCallerIdentityResponse><GetCallerIdentityResult>
+<Account>222222222222</Account><Arn>arn:aws:sts::222222222222:assumed-role/second/%[1]s</Arn><UserId>AROA:%[1]s</UserId>
+</GetCallerIdentityResult></GetCallerIdentityResponse>`, accessKeyID)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("base", "secret", ""),
+		Region:      aws.String("us-east-1"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	sess = chainAssumeRoles(sess, []*AssumeRole{
+		{RoleARN: "arn:aws:iam::111111111111:role/first", SessionName: "first"},   //lintignore:AWSAT005
+		{RoleARN: "arn:aws:iam::222222222222:role/second", SessionName: "second"}, //lintignore:AWSAT005
+	}, server.URL)
+
+	output, err := sts.New(sess, &aws.Config{Endpoint: aws.String(server.URL)}).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+	if err != nil {
+		t.Fatalf("error getting caller identity: %s", err)
+	}
+
+	if got, want := aws.StringValue(output.Account), "222222222222"; got != want {
+		t.Errorf("got account %s, expected %s", got, want)
+	}
+
+	// Each role is assumed using the credentials of the previous one.
+	want := []string{
+		"AssumeRole:base:first",
+		"AssumeRole:first:second",
+		"GetCallerIdentity:second:",
+	}
+
+	if got := calls; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("got calls %v, expected %v", got, want)
+	}
+}
+
+func TestAssumeRoleCredentialsProvider(t *testing.T) {
+	role := &AssumeRole{
+		RoleARN:           "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+		DurationSeconds:   1800,
+		ExternalID:        "external",
+		Policy:            `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		PolicyARNs:        []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"}, //lintignore:AWSAT005
+		SessionName:       "session",
+		Tags:              map[string]string{"key": "value"},
+		TransitiveTagKeys: []string{"key"},
+	}
+
+	p := role.credentialsProvider(nil)
+
+	if got, want := p.RoleARN, role.RoleARN; got != want {
+		t.Errorf("got RoleARN %s, expected %s", got, want)
+	}
+
+	if got, want := p.Duration, 30*time.Minute; got != want {
+		t.Errorf("got Duration %s, expected %s", got, want)
+	}
+
+	if got, want := aws.StringValue(p.ExternalID), role.ExternalID; got != want {
+		t.Errorf("got ExternalID %s, expected %s", got, want)
+	}
+
+	if got, want := aws.StringValue(p.Policy), role.Policy; got != want {
+		t.Errorf("got Policy %s, expected %s", got, want)
+	}
+
+	if got, want := len(p.PolicyArns), 1; got != want {
+		t.Fatalf("got %d PolicyArns, expected %d", got, want)
+	}
+
+	if got, want := aws.StringValue(p.PolicyArns[0].Arn), role.PolicyARNs[0]; got != want {
+		t.Errorf("got PolicyArn %s, expected %s", got, want)
+	}
+
+	if got, want := p.RoleSessionName, role.SessionName; got != want {
+		t.Errorf("got RoleSessionName %s, expected %s", got, want)
+	}
+
+	if got, want := len(p.Tags), 1; got != want {
+		t.Fatalf("got %d Tags, expected %d", got, want)
+	}
+
+	if got, want := aws.StringValueSlice(p.TransitiveTagKeys), role.TransitiveTagKeys; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("got TransitiveTagKeys %v, expected %v", got, want)
+	}
+
+	// No session policy is sent when none is configured.
+	if p := (&AssumeRole{RoleARN: role.RoleARN}).credentialsProvider(nil); p.Policy != nil {
+		t.Errorf("got Policy %s, expected none", aws.StringValue(p.Policy))
+	}
+}
+
+func TestAccountIDAndPartitionFromRoleARN(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		RoleARN           string
+		ExpectedAccountID string
+		ExpectedPartition string
+		ExpectedErr       bool
+	}{
+		{
+			Name:              "commercial",
+			RoleARN:           "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+			ExpectedAccountID: "123456789012",
+			ExpectedPartition: "aws",
+		},
+		{
+			Name:              "GovCloud",
+			RoleARN:           "arn:aws-us-gov:iam::123456789012:role/path/test", //lintignore:AWSAT005
+			ExpectedAccountID: "123456789012",
+			ExpectedPartition: "aws-us-gov",
+		},
+		{
+			Name:        "invalid",
+			RoleARN:     "test",
+			ExpectedErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			accountID, partition, err := accountIDAndPartitionFromRoleARN(testCase.RoleARN)
+
+			if testCase.ExpectedErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if accountID != testCase.ExpectedAccountID || partition != testCase.ExpectedPartition {
+				t.Errorf("got %s, %s, expected %s, %s", accountID, partition, testCase.ExpectedAccountID, testCase.ExpectedPartition)
+			}
+		})
+	}
+}
+
+func TestWithWebIdentityCredentials(t *testing.T) {
+	var mutex sync.Mutex
+	var tokens []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("error parsing form: %s", err)
+		}
+
+		w.Header().Set("Content-Type", "text/xml")
+
+		switch r.Form.Get("Action") {
+		case "AssumeRoleWithWebIdentity":
+			if v := r.Header.Get("Authorization"); v != "" {
+				t.Errorf("expected unsigned request, got Authorization: %s", v)
+			}
+
+			mutex.Lock()
+			tokens = append(tokens, r.Form.Get("WebIdentityToken"))
+			mutex.Unlock()
+
+			// Credentials expiring within the expiry window are refreshed on every use.
+			fmt.Fprintf(w, `<AssumeRoleWithWebIdentityResponse><AssumeRoleWithWebIdentityResult>
+<AssumedRoleUser><Arn>%[1]s</Arn><AssumedRoleId>AROA:ci</AssumedRoleId></AssumedRoleUser>
+<Credentials><AccessKeyId>ci</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken><Expiration>%[2]s</Expiration></Credentials>
+</AssumeRoleWithWebIdentityResult></AssumeRoleWithWebIdentityResponse>`, r.Form.Get("RoleArn"), time.Now().UTC().Add(time.Minute).Format(time.RFC3339))
+		case "GetCallerIdentity":
+			fmt.Fprint(w, `<GetCallerIdentityResponse><GetCallerIdentityResult>
+<Account>111111111111</Account><Arn>arn:aws:sts::111111111111:assumed-role/ci/ci</Arn><UserId>AROA:ci</UserId>
+</GetCallerIdentityResult></GetCallerIdentityResponse>`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+
+	if err := ioutil.WriteFile(tokenFile, []byte("token1"), 0600); err != nil {
+		t.Fatalf("error writing token file: %s", err)
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("placeholder", "placeholder", ""),
+		Region:      aws.String("us-east-1"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	sess, err = withWebIdentityCredentials(sess, &AssumeRoleWithWebIdentity{
+		RoleARN:              "arn:aws:iam::111111111111:role/ci", //lintignore:AWSAT005
+		SessionName:          "ci",
+		WebIdentityTokenFile: tokenFile,
+	}, server.URL)
+
+	if err != nil {
+		t.Fatalf("error configuring web identity credentials: %s", err)
+	}
+
+	conn := sts.New(sess, &aws.Config{Endpoint: aws.String(server.URL)})
+
+	if _, err := conn.GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("error getting caller identity: %s", err)
+	}
+
+	// Rotate the token, as CI systems do for long running jobs.
+	if err := ioutil.WriteFile(tokenFile, []byte("token2"), 0600); err != nil {
+		t.Fatalf("error writing token file: %s", err)
+	}
+
+	if _, err := conn.GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("error getting caller identity: %s", err)
+	}
+
+	if got, want := strings.Join(tokens, ","), "token1,token2"; got != want {
+		t.Errorf("got tokens %s, expected %s", got, want)
+	}
+}
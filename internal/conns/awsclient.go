@@ -0,0 +1,2251 @@
+package conns
+
+// Service clients are created on first use so that provider configuration does not
+// pay the cost of constructing a client for every AWS service.
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go/service/account"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/aws/aws-sdk-go/service/alexaforbusiness"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/aws/aws-sdk-go/service/amplifybackend"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/aws/aws-sdk-go/service/appflow"
+	"github.com/aws/aws-sdk-go/service/appintegrationsservice"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/applicationcostprofiler"
+	"github.com/aws/aws-sdk-go/service/applicationdiscoveryservice"
+	"github.com/aws/aws-sdk-go/service/applicationinsights"
+	"github.com/aws/aws-sdk-go/service/appmesh"
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/aws/aws-sdk-go/service/augmentedairuntime"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscalingplans"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/braket"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/aws/aws-sdk-go/service/chime"
+	"github.com/aws/aws-sdk-go/service/cloud9"
+	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
+	"github.com/aws/aws-sdk-go/service/clouddirectory"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go/service/cloudsearch"
+	"github.com/aws/aws-sdk-go/service/cloudsearchdomain"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/codeartifact"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/aws-sdk-go/service/codeguruprofiler"
+	"github.com/aws/aws-sdk-go/service/codegurureviewer"
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/codestar"
+	"github.com/aws/aws-sdk-go/service/codestarconnections"
+	"github.com/aws/aws-sdk-go/service/codestarnotifications"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/cognitosync"
+	"github.com/aws/aws-sdk-go/service/comprehend"
+	"github.com/aws/aws-sdk-go/service/comprehendmedical"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/aws/aws-sdk-go/service/connectcontactlens"
+	"github.com/aws/aws-sdk-go/service/connectparticipant"
+	"github.com/aws/aws-sdk-go/service/costandusagereportservice"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go/service/dataexchange"
+	"github.com/aws/aws-sdk-go/service/datapipeline"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/aws/aws-sdk-go/service/devicefarm"
+	"github.com/aws/aws-sdk-go/service/devopsguru"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/aws/aws-sdk-go/service/dlm"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodbstreams"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2instanceconnect"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/elasticinference"
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/aws/aws-sdk-go/service/finspace"
+	"github.com/aws/aws-sdk-go/service/finspacedata"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/fis"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/aws/aws-sdk-go/service/forecastqueryservice"
+	"github.com/aws/aws-sdk-go/service/forecastservice"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/aws/aws-sdk-go/service/gluedatabrew"
+	"github.com/aws/aws-sdk-go/service/greengrass"
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	"github.com/aws/aws-sdk-go/service/groundstation"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/aws/aws-sdk-go/service/health"
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/aws/aws-sdk-go/service/honeycode"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/aws/aws-sdk-go/service/inspector"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/aws/aws-sdk-go/service/iot1clickdevicesservice"
+	"github.com/aws/aws-sdk-go/service/iot1clickprojects"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/aws/aws-sdk-go/service/iotdataplane"
+	"github.com/aws/aws-sdk-go/service/iotdeviceadvisor"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/aws/aws-sdk-go/service/ioteventsdata"
+	"github.com/aws/aws-sdk-go/service/iotfleethub"
+	"github.com/aws/aws-sdk-go/service/iotjobsdataplane"
+	"github.com/aws/aws-sdk-go/service/iotsecuretunneling"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	"github.com/aws/aws-sdk-go/service/iotthingsgraph"
+	"github.com/aws/aws-sdk-go/service/iotwireless"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesisanalytics"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/aws/aws-sdk-go/service/kinesisvideoarchivedmedia"
+	"github.com/aws/aws-sdk-go/service/kinesisvideomedia"
+	"github.com/aws/aws-sdk-go/service/kinesisvideosignalingchannels"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/aws/aws-sdk-go/service/lexruntimeservice"
+	"github.com/aws/aws-sdk-go/service/lexruntimev2"
+	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/aws/aws-sdk-go/service/lookoutequipment"
+	"github.com/aws/aws-sdk-go/service/lookoutforvision"
+	"github.com/aws/aws-sdk-go/service/lookoutmetrics"
+	"github.com/aws/aws-sdk-go/service/machinelearning"
+	"github.com/aws/aws-sdk-go/service/macie"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/aws/aws-sdk-go/service/marketplacecatalog"
+	"github.com/aws/aws-sdk-go/service/marketplacecommerceanalytics"
+	"github.com/aws/aws-sdk-go/service/marketplaceentitlementservice"
+	"github.com/aws/aws-sdk-go/service/marketplacemetering"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/aws/aws-sdk-go/service/mediapackagevod"
+	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/aws/aws-sdk-go/service/mediastoredata"
+	"github.com/aws/aws-sdk-go/service/mediatailor"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/aws/aws-sdk-go/service/mgn"
+	"github.com/aws/aws-sdk-go/service/migrationhub"
+	"github.com/aws/aws-sdk-go/service/migrationhubconfig"
+	"github.com/aws/aws-sdk-go/service/mobile"
+	"github.com/aws/aws-sdk-go/service/mobileanalytics"
+	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/aws/aws-sdk-go/service/mturk"
+	"github.com/aws/aws-sdk-go/service/mwaa"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/aws/aws-sdk-go/service/nimblestudio"
+	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/aws/aws-sdk-go/service/opsworkscm"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/aws/aws-sdk-go/service/personalize"
+	"github.com/aws/aws-sdk-go/service/personalizeevents"
+	"github.com/aws/aws-sdk-go/service/personalizeruntime"
+	"github.com/aws/aws-sdk-go/service/pi"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/aws/aws-sdk-go/service/pinpointemail"
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoice"
+	"github.com/aws/aws-sdk-go/service/polly"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/aws/aws-sdk-go/service/prometheusservice"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/aws/aws-sdk-go/service/qldb"
+	"github.com/aws/aws-sdk-go/service/qldbsession"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/rdsdataservice"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/redshiftdataapiservice"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/robomaker"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/s3outposts"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/sagemakeredgemanager"
+	"github.com/aws/aws-sdk-go/service/sagemakerfeaturestoreruntime"
+	"github.com/aws/aws-sdk-go/service/sagemakerruntime"
+	"github.com/aws/aws-sdk-go/service/savingsplans"
+	"github.com/aws/aws-sdk-go/service/schemas"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/aws/aws-sdk-go/service/signer"
+	"github.com/aws/aws-sdk-go/service/simpledb"
+	"github.com/aws/aws-sdk-go/service/sms"
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	"github.com/aws/aws-sdk-go/service/sso"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/aws/aws-sdk-go/service/ssooidc"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/support"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/aws/aws-sdk-go/service/textract"
+	"github.com/aws/aws-sdk-go/service/timestreamquery"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	"github.com/aws/aws-sdk-go/service/transcribestreamingservice"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/aws/aws-sdk-go/service/translate"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/wellarchitected"
+	"github.com/aws/aws-sdk-go/service/workdocs"
+	"github.com/aws/aws-sdk-go/service/worklink"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/aws/aws-sdk-go/service/workmailmessageflow"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/aws/aws-sdk-go/service/xray"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+const (
+	// s3URICleaningDisabled is the key of the S3 client with REST protocol URI cleaning disabled.
+	s3URICleaningDisabled = "s3uricleaningdisabled"
+)
+
+// conn returns the cached service client for key, creating it with newConn on first use.
+// newConn is called with the client's session and must not call conn.
+func (client *AWSClient) conn(key string, newConn func(*session.Session) interface{}) interface{} {
+	client.connsMutex.Lock()
+	defer client.connsMutex.Unlock()
+
+	if conn, ok := client.conns[key]; ok {
+		return conn
+	}
+
+	conn := newConn(client.session)
+	client.conns[key] = conn
+
+	return conn
+}
+
+// s3Config returns the configuration shared by all S3 service clients.
+func (client *AWSClient) s3Config() *aws.Config {
+	return &aws.Config{
+		Endpoint:         aws.String(client.endpoints[S3]),
+		S3ForcePathStyle: aws.Bool(client.s3ForcePathStyle),
+	}
+}
+
+func (client *AWSClient) AccessAnalyzerConn() *accessanalyzer.AccessAnalyzer {
+	return client.conn(AccessAnalyzer, func(sess *session.Session) interface{} {
+		return accessanalyzer.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[AccessAnalyzer])}))
+	}).(*accessanalyzer.AccessAnalyzer)
+}
+
+func (client *AWSClient) AccountConn() *account.Account {
+	return client.conn(Account, func(sess *session.Session) interface{} {
+		return account.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Account])}))
+	}).(*account.Account)
+}
+
+func (client *AWSClient) ACMConn() *acm.ACM {
+	return client.conn(ACM, func(sess *session.Session) interface{} {
+		return acm.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ACM])}))
+	}).(*acm.ACM)
+}
+
+func (client *AWSClient) ACMPCAConn() *acmpca.ACMPCA {
+	return client.conn(ACMPCA, func(sess *session.Session) interface{} {
+		return acmpca.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ACMPCA])}))
+	}).(*acmpca.ACMPCA)
+}
+
+func (client *AWSClient) AlexaForBusinessConn() *alexaforbusiness.AlexaForBusiness {
+	return client.conn(AlexaForBusiness, func(sess *session.Session) interface{} {
+		return alexaforbusiness.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[AlexaForBusiness])}))
+	}).(*alexaforbusiness.AlexaForBusiness)
+}
+
+func (client *AWSClient) AMPConn() *prometheusservice.PrometheusService {
+	return client.conn(AMP, func(sess *session.Session) interface{} {
+		return prometheusservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[AMP])}))
+	}).(*prometheusservice.PrometheusService)
+}
+
+func (client *AWSClient) AmplifyBackendConn() *amplifybackend.AmplifyBackend {
+	return client.conn(AmplifyBackend, func(sess *session.Session) interface{} {
+		return amplifybackend.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[AmplifyBackend])}))
+	}).(*amplifybackend.AmplifyBackend)
+}
+
+func (client *AWSClient) AmplifyConn() *amplify.Amplify {
+	return client.conn(Amplify, func(sess *session.Session) interface{} {
+		return amplify.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Amplify])}))
+	}).(*amplify.Amplify)
+}
+
+func (client *AWSClient) APIGatewayConn() *apigateway.APIGateway {
+	return client.conn(APIGateway, func(sess *session.Session) interface{} {
+		conn := apigateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[APIGateway])}))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// Many operations can return an error such as:
+			//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
+			// Handle them all globally for the service client.
+			if tfawserr.ErrMessageContains(r.Error, apigateway.ErrCodeConflictException, "try again later") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*apigateway.APIGateway)
+}
+
+func (client *AWSClient) APIGatewayV2Conn() *apigatewayv2.ApiGatewayV2 {
+	return client.conn(APIGatewayV2, func(sess *session.Session) interface{} {
+		return apigatewayv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[APIGatewayV2])}))
+	}).(*apigatewayv2.ApiGatewayV2)
+}
+
+func (client *AWSClient) AppAutoScalingConn() *applicationautoscaling.ApplicationAutoScaling {
+	return client.conn(AppAutoScaling, func(sess *session.Session) interface{} {
+		conn := applicationautoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[AppAutoScaling])}))
+
+		// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
+				return
+			}
+			if tfawserr.ErrCodeEquals(r.Error, applicationautoscaling.ErrCodeFailedResourceAccessException) {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*applicationautoscaling.ApplicationAutoScaling)
+}
+
+func (client *AWSClient) AppConfigConn() *appconfig.AppConfig {
+	return client.conn(AppConfig, func(sess *session.Session) interface{} {
+		conn := appconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[AppConfig])}))
+
+		// StartDeployment operations can return a ConflictException
+		// if ongoing deployments are in-progress, thus we handle them
+		// here for the service client.
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "StartDeployment" {
+				if tfawserr.ErrCodeEquals(r.Error, appconfig.ErrCodeConflictException) {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*appconfig.AppConfig)
+}
+
+func (client *AWSClient) AppFlowConn() *appflow.Appflow {
+	return client.conn(AppFlow, func(sess *session.Session) interface{} {
+		return appflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[AppFlow])}))
+	}).(*appflow.Appflow)
+}
+
+func (client *AWSClient) AppIntegrationsConn() *appintegrationsservice.AppIntegrationsService {
+	return client.conn(AppIntegrations, func(sess *session.Session) interface{} {
+		return appintegrationsservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[AppIntegrations])}))
+	}).(*appintegrationsservice.AppIntegrationsService)
+}
+
+func (client *AWSClient) ApplicationCostProfilerConn() *applicationcostprofiler.ApplicationCostProfiler {
+	return client.conn(ApplicationCostProfiler, func(sess *session.Session) interface{} {
+		return applicationcostprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ApplicationCostProfiler])}))
+	}).(*applicationcostprofiler.ApplicationCostProfiler)
+}
+
+func (client *AWSClient) ApplicationDiscoveryConn() *applicationdiscoveryservice.ApplicationDiscoveryService {
+	return client.conn(ApplicationDiscovery, func(sess *session.Session) interface{} {
+		return applicationdiscoveryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ApplicationDiscovery])}))
+	}).(*applicationdiscoveryservice.ApplicationDiscoveryService)
+}
+
+func (client *AWSClient) ApplicationInsightsConn() *applicationinsights.ApplicationInsights {
+	return client.conn(ApplicationInsights, func(sess *session.Session) interface{} {
+		return applicationinsights.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ApplicationInsights])}))
+	}).(*applicationinsights.ApplicationInsights)
+}
+
+func (client *AWSClient) AppMeshConn() *appmesh.AppMesh {
+	return client.conn(AppMesh, func(sess *session.Session) interface{} {
+		return appmesh.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[AppMesh])}))
+	}).(*appmesh.AppMesh)
+}
+
+func (client *AWSClient) AppRegistryConn() *appregistry.AppRegistry {
+	return client.conn(AppRegistry, func(sess *session.Session) interface{} {
+		return appregistry.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[AppRegistry])}))
+	}).(*appregistry.AppRegistry)
+}
+
+func (client *AWSClient) AppRunnerConn() *apprunner.AppRunner {
+	return client.conn(AppRunner, func(sess *session.Session) interface{} {
+		return apprunner.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[AppRunner])}))
+	}).(*apprunner.AppRunner)
+}
+
+func (client *AWSClient) AppStreamConn() *appstream.AppStream {
+	return client.conn(AppStream, func(sess *session.Session) interface{} {
+		return appstream.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[AppStream])}))
+	}).(*appstream.AppStream)
+}
+
+func (client *AWSClient) AppSyncConn() *appsync.AppSync {
+	return client.conn(AppSync, func(sess *session.Session) interface{} {
+		conn := appsync.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[AppSync])}))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "CreateGraphqlApi" {
+				if tfawserr.ErrMessageContains(r.Error, appsync.ErrCodeConcurrentModificationException, "a GraphQL API creation is already in progress") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*appsync.AppSync)
+}
+
+func (client *AWSClient) AthenaConn() *athena.Athena {
+	return client.conn(Athena, func(sess *session.Session) interface{} {
+		return athena.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Athena])}))
+	}).(*athena.Athena)
+}
+
+func (client *AWSClient) AuditManagerConn() *auditmanager.AuditManager {
+	return client.conn(AuditManager, func(sess *session.Session) interface{} {
+		return auditmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[AuditManager])}))
+	}).(*auditmanager.AuditManager)
+}
+
+func (client *AWSClient) AugmentedAIRuntimeConn() *augmentedairuntime.AugmentedAIRuntime {
+	return client.conn(AugmentedAIRuntime, func(sess *session.Session) interface{} {
+		return augmentedairuntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[AugmentedAIRuntime])}))
+	}).(*augmentedairuntime.AugmentedAIRuntime)
+}
+
+func (client *AWSClient) AutoScalingConn() *autoscaling.AutoScaling {
+	return client.conn(AutoScaling, func(sess *session.Session) interface{} {
+		return autoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[AutoScaling])}))
+	}).(*autoscaling.AutoScaling)
+}
+
+func (client *AWSClient) AutoScalingPlansConn() *autoscalingplans.AutoScalingPlans {
+	return client.conn(AutoScalingPlans, func(sess *session.Session) interface{} {
+		return autoscalingplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[AutoScalingPlans])}))
+	}).(*autoscalingplans.AutoScalingPlans)
+}
+
+func (client *AWSClient) BackupConn() *backup.Backup {
+	return client.conn(Backup, func(sess *session.Session) interface{} {
+		return backup.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Backup])}))
+	}).(*backup.Backup)
+}
+
+func (client *AWSClient) BatchConn() *batch.Batch {
+	return client.conn(Batch, func(sess *session.Session) interface{} {
+		return batch.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Batch])}))
+	}).(*batch.Batch)
+}
+
+func (client *AWSClient) BraketConn() *braket.Braket {
+	return client.conn(Braket, func(sess *session.Session) interface{} {
+		return braket.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Braket])}))
+	}).(*braket.Braket)
+}
+
+func (client *AWSClient) BudgetsConn() *budgets.Budgets {
+	return client.conn(Budgets, func(sess *session.Session) interface{} {
+		return budgets.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Budgets])}))
+	}).(*budgets.Budgets)
+}
+
+func (client *AWSClient) ChimeConn() *chime.Chime {
+	return client.conn(Chime, func(sess *session.Session) interface{} {
+		conn := chime.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Chime])}))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// When calling CreateVoiceConnector across multiple resources,
+			// the API can randomly return a BadRequestException without explanation
+			if r.Operation.Name == "CreateVoiceConnector" {
+				if tfawserr.ErrMessageContains(r.Error, chime.ErrCodeBadRequestException, "Service received a bad request") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*chime.Chime)
+}
+
+func (client *AWSClient) Cloud9Conn() *cloud9.Cloud9 {
+	return client.conn(Cloud9, func(sess *session.Session) interface{} {
+		return cloud9.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Cloud9])}))
+	}).(*cloud9.Cloud9)
+}
+
+func (client *AWSClient) CloudControlConn() *cloudcontrolapi.CloudControlApi {
+	return client.conn(CloudControl, func(sess *session.Session) interface{} {
+		return cloudcontrolapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CloudControl])}))
+	}).(*cloudcontrolapi.CloudControlApi)
+}
+
+func (client *AWSClient) CloudDirectoryConn() *clouddirectory.CloudDirectory {
+	return client.conn(CloudDirectory, func(sess *session.Session) interface{} {
+		return clouddirectory.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CloudDirectory])}))
+	}).(*clouddirectory.CloudDirectory)
+}
+
+func (client *AWSClient) CloudFormationConn() *cloudformation.CloudFormation {
+	return client.conn(CloudFormation, func(sess *session.Session) interface{} {
+		conn := cloudformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CloudFormation])}))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if tfawserr.ErrMessageContains(r.Error, cloudformation.ErrCodeOperationInProgressException, "Another Operation on StackSet") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*cloudformation.CloudFormation)
+}
+
+func (client *AWSClient) CloudFrontConn() *cloudfront.CloudFront {
+	return client.conn(CloudFront, func(sess *session.Session) interface{} {
+		return cloudfront.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CloudFront])}))
+	}).(*cloudfront.CloudFront)
+}
+
+func (client *AWSClient) CloudHSMV2Conn() *cloudhsmv2.CloudHSMV2 {
+	return client.conn(CloudHSMV2, func(sess *session.Session) interface{} {
+		conn := cloudhsmv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CloudHSMV2])}))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if tfawserr.ErrMessageContains(r.Error, cloudhsmv2.ErrCodeCloudHsmInternalFailureException, "request was rejected because of an AWS CloudHSM internal failure") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*cloudhsmv2.CloudHSMV2)
+}
+
+func (client *AWSClient) CloudSearchConn() *cloudsearch.CloudSearch {
+	return client.conn(CloudSearch, func(sess *session.Session) interface{} {
+		return cloudsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CloudSearch])}))
+	}).(*cloudsearch.CloudSearch)
+}
+
+func (client *AWSClient) CloudSearchDomainConn() *cloudsearchdomain.CloudSearchDomain {
+	return client.conn(CloudSearchDomain, func(sess *session.Session) interface{} {
+		return cloudsearchdomain.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CloudSearchDomain])}))
+	}).(*cloudsearchdomain.CloudSearchDomain)
+}
+
+func (client *AWSClient) CloudTrailConn() *cloudtrail.CloudTrail {
+	return client.conn(CloudTrail, func(sess *session.Session) interface{} {
+		return cloudtrail.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CloudTrail])}))
+	}).(*cloudtrail.CloudTrail)
+}
+
+func (client *AWSClient) CloudWatchConn() *cloudwatch.CloudWatch {
+	return client.conn(CloudWatch, func(sess *session.Session) interface{} {
+		return cloudwatch.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CloudWatch])}))
+	}).(*cloudwatch.CloudWatch)
+}
+
+func (client *AWSClient) CloudWatchLogsConn() *cloudwatchlogs.CloudWatchLogs {
+	return client.conn(CloudWatchLogs, func(sess *session.Session) interface{} {
+		return cloudwatchlogs.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CloudWatchLogs])}))
+	}).(*cloudwatchlogs.CloudWatchLogs)
+}
+
+func (client *AWSClient) CodeArtifactConn() *codeartifact.CodeArtifact {
+	return client.conn(CodeArtifact, func(sess *session.Session) interface{} {
+		return codeartifact.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CodeArtifact])}))
+	}).(*codeartifact.CodeArtifact)
+}
+
+func (client *AWSClient) CodeBuildConn() *codebuild.CodeBuild {
+	return client.conn(CodeBuild, func(sess *session.Session) interface{} {
+		return codebuild.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CodeBuild])}))
+	}).(*codebuild.CodeBuild)
+}
+
+func (client *AWSClient) CodeCommitConn() *codecommit.CodeCommit {
+	return client.conn(CodeCommit, func(sess *session.Session) interface{} {
+		return codecommit.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CodeCommit])}))
+	}).(*codecommit.CodeCommit)
+}
+
+func (client *AWSClient) CodeDeployConn() *codedeploy.CodeDeploy {
+	return client.conn(CodeDeploy, func(sess *session.Session) interface{} {
+		return codedeploy.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CodeDeploy])}))
+	}).(*codedeploy.CodeDeploy)
+}
+
+func (client *AWSClient) CodeGuruProfilerConn() *codeguruprofiler.CodeGuruProfiler {
+	return client.conn(CodeGuruProfiler, func(sess *session.Session) interface{} {
+		return codeguruprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CodeGuruProfiler])}))
+	}).(*codeguruprofiler.CodeGuruProfiler)
+}
+
+func (client *AWSClient) CodeGuruReviewerConn() *codegurureviewer.CodeGuruReviewer {
+	return client.conn(CodeGuruReviewer, func(sess *session.Session) interface{} {
+		return codegurureviewer.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CodeGuruReviewer])}))
+	}).(*codegurureviewer.CodeGuruReviewer)
+}
+
+func (client *AWSClient) CodePipelineConn() *codepipeline.CodePipeline {
+	return client.conn(CodePipeline, func(sess *session.Session) interface{} {
+		return codepipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CodePipeline])}))
+	}).(*codepipeline.CodePipeline)
+}
+
+func (client *AWSClient) CodeStarConn() *codestar.CodeStar {
+	return client.conn(CodeStar, func(sess *session.Session) interface{} {
+		return codestar.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CodeStar])}))
+	}).(*codestar.CodeStar)
+}
+
+func (client *AWSClient) CodeStarConnectionsConn() *codestarconnections.CodeStarConnections {
+	return client.conn(CodeStarConnections, func(sess *session.Session) interface{} {
+		return codestarconnections.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CodeStarConnections])}))
+	}).(*codestarconnections.CodeStarConnections)
+}
+
+func (client *AWSClient) CodeStarNotificationsConn() *codestarnotifications.CodeStarNotifications {
+	return client.conn(CodeStarNotifications, func(sess *session.Session) interface{} {
+		return codestarnotifications.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CodeStarNotifications])}))
+	}).(*codestarnotifications.CodeStarNotifications)
+}
+
+func (client *AWSClient) CognitoIdentityConn() *cognitoidentity.CognitoIdentity {
+	return client.conn(CognitoIdentity, func(sess *session.Session) interface{} {
+		return cognitoidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CognitoIdentity])}))
+	}).(*cognitoidentity.CognitoIdentity)
+}
+
+func (client *AWSClient) CognitoIDPConn() *cognitoidentityprovider.CognitoIdentityProvider {
+	return client.conn(CognitoIDP, func(sess *session.Session) interface{} {
+		return cognitoidentityprovider.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CognitoIDP])}))
+	}).(*cognitoidentityprovider.CognitoIdentityProvider)
+}
+
+func (client *AWSClient) CognitoSyncConn() *cognitosync.CognitoSync {
+	return client.conn(CognitoSync, func(sess *session.Session) interface{} {
+		return cognitosync.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CognitoSync])}))
+	}).(*cognitosync.CognitoSync)
+}
+
+func (client *AWSClient) ComprehendConn() *comprehend.Comprehend {
+	return client.conn(Comprehend, func(sess *session.Session) interface{} {
+		return comprehend.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Comprehend])}))
+	}).(*comprehend.Comprehend)
+}
+
+func (client *AWSClient) ComprehendMedicalConn() *comprehendmedical.ComprehendMedical {
+	return client.conn(ComprehendMedical, func(sess *session.Session) interface{} {
+		return comprehendmedical.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ComprehendMedical])}))
+	}).(*comprehendmedical.ComprehendMedical)
+}
+
+func (client *AWSClient) ConfigServiceConn() *configservice.ConfigService {
+	return client.conn(ConfigService, func(sess *session.Session) interface{} {
+		conn := configservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ConfigService])}))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// When calling Config Organization Rules API actions immediately
+			// after Organization creation, the API can randomly return the
+			// OrganizationAccessDeniedException error for a few minutes, even
+			// after succeeding a few requests.
+			switch r.Operation.Name {
+			case "DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule":
+				if !tfawserr.ErrMessageContains(r.Error, configservice.ErrCodeOrganizationAccessDeniedException, "This action can be only made by AWS Organization's master account.") {
+					return
+				}
+
+				// We only want to retry briefly as the default max retry count would
+				// excessively retry when the error could be legitimate.
+				// We currently depend on the DefaultRetryer exponential backoff here.
+				// ~10 retries gives a fair backoff of a few seconds.
+				if r.RetryCount < 9 {
+					r.Retryable = aws.Bool(true)
+				} else {
+					r.Retryable = aws.Bool(false)
+				}
+			case "DeleteOrganizationConformancePack", "DescribeOrganizationConformancePacks", "DescribeOrganizationConformancePackStatuses", "PutOrganizationConformancePack":
+				if !tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeOrganizationAccessDeniedException) {
+					if r.Operation.Name == "DeleteOrganizationConformancePack" && tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeResourceInUseException) {
+						r.Retryable = aws.Bool(true)
+					}
+					return
+				}
+
+				// We only want to retry briefly as the default max retry count would
+				// excessively retry when the error could be legitimate.
+				// We currently depend on the DefaultRetryer exponential backoff here.
+				// ~10 retries gives a fair backoff of a few seconds.
+				if r.RetryCount < 9 {
+					r.Retryable = aws.Bool(true)
+				} else {
+					r.Retryable = aws.Bool(false)
+				}
+			}
+		})
+
+		return conn
+	}).(*configservice.ConfigService)
+}
+
+func (client *AWSClient) ConnectConn() *connect.Connect {
+	return client.conn(Connect, func(sess *session.Session) interface{} {
+		return connect.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Connect])}))
+	}).(*connect.Connect)
+}
+
+func (client *AWSClient) ConnectContactLensConn() *connectcontactlens.ConnectContactLens {
+	return client.conn(ConnectContactLens, func(sess *session.Session) interface{} {
+		return connectcontactlens.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ConnectContactLens])}))
+	}).(*connectcontactlens.ConnectContactLens)
+}
+
+func (client *AWSClient) ConnectParticipantConn() *connectparticipant.ConnectParticipant {
+	return client.conn(ConnectParticipant, func(sess *session.Session) interface{} {
+		return connectparticipant.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ConnectParticipant])}))
+	}).(*connectparticipant.ConnectParticipant)
+}
+
+func (client *AWSClient) CostExplorerConn() *costexplorer.CostExplorer {
+	return client.conn(CostExplorer, func(sess *session.Session) interface{} {
+		return costexplorer.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CostExplorer])}))
+	}).(*costexplorer.CostExplorer)
+}
+
+func (client *AWSClient) CURConn() *costandusagereportservice.CostandUsageReportService {
+	return client.conn(CUR, func(sess *session.Session) interface{} {
+		return costandusagereportservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[CUR])}))
+	}).(*costandusagereportservice.CostandUsageReportService)
+}
+
+func (client *AWSClient) DataExchangeConn() *dataexchange.DataExchange {
+	return client.conn(DataExchange, func(sess *session.Session) interface{} {
+		return dataexchange.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[DataExchange])}))
+	}).(*dataexchange.DataExchange)
+}
+
+func (client *AWSClient) DataPipelineConn() *datapipeline.DataPipeline {
+	return client.conn(DataPipeline, func(sess *session.Session) interface{} {
+		return datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[DataPipeline])}))
+	}).(*datapipeline.DataPipeline)
+}
+
+func (client *AWSClient) DataSyncConn() *datasync.DataSync {
+	return client.conn(DataSync, func(sess *session.Session) interface{} {
+		return datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[DataSync])}))
+	}).(*datasync.DataSync)
+}
+
+func (client *AWSClient) DAXConn() *dax.DAX {
+	return client.conn(DAX, func(sess *session.Session) interface{} {
+		return dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[DAX])}))
+	}).(*dax.DAX)
+}
+
+func (client *AWSClient) DetectiveConn() *detective.Detective {
+	return client.conn(Detective, func(sess *session.Session) interface{} {
+		return detective.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Detective])}))
+	}).(*detective.Detective)
+}
+
+func (client *AWSClient) DeviceFarmConn() *devicefarm.DeviceFarm {
+	return client.conn(DeviceFarm, func(sess *session.Session) interface{} {
+		return devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[DeviceFarm])}))
+	}).(*devicefarm.DeviceFarm)
+}
+
+func (client *AWSClient) DevOpsGuruConn() *devopsguru.DevOpsGuru {
+	return client.conn(DevOpsGuru, func(sess *session.Session) interface{} {
+		return devopsguru.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[DevOpsGuru])}))
+	}).(*devopsguru.DevOpsGuru)
+}
+
+func (client *AWSClient) DirectConnectConn() *directconnect.DirectConnect {
+	return client.conn(DirectConnect, func(sess *session.Session) interface{} {
+		return directconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[DirectConnect])}))
+	}).(*directconnect.DirectConnect)
+}
+
+func (client *AWSClient) DLMConn() *dlm.DLM {
+	return client.conn(DLM, func(sess *session.Session) interface{} {
+		return dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[DLM])}))
+	}).(*dlm.DLM)
+}
+
+func (client *AWSClient) DMSConn() *databasemigrationservice.DatabaseMigrationService {
+	return client.conn(DMS, func(sess *session.Session) interface{} {
+		return databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[DMS])}))
+	}).(*databasemigrationservice.DatabaseMigrationService)
+}
+
+func (client *AWSClient) DocDBConn() *docdb.DocDB {
+	return client.conn(DocDB, func(sess *session.Session) interface{} {
+		return docdb.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[DocDB])}))
+	}).(*docdb.DocDB)
+}
+
+func (client *AWSClient) DSConn() *directoryservice.DirectoryService {
+	return client.conn(DS, func(sess *session.Session) interface{} {
+		return directoryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[DS])}))
+	}).(*directoryservice.DirectoryService)
+}
+
+func (client *AWSClient) DynamoDBConn() *dynamodb.DynamoDB {
+	return client.conn(DynamoDB, func(sess *session.Session) interface{} {
+		conn := dynamodb.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[DynamoDB])}))
+
+		// See https://github.com/aws/aws-sdk-go/pull/1276
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
+				return
+			}
+			if tfawserr.ErrMessageContains(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*dynamodb.DynamoDB)
+}
+
+func (client *AWSClient) DynamoDBStreamsConn() *dynamodbstreams.DynamoDBStreams {
+	return client.conn(DynamoDBStreams, func(sess *session.Session) interface{} {
+		return dynamodbstreams.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[DynamoDBStreams])}))
+	}).(*dynamodbstreams.DynamoDBStreams)
+}
+
+func (client *AWSClient) EC2Conn() *ec2.EC2 {
+	return client.conn(EC2, func(sess *session.Session) interface{} {
+		conn := ec2.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[EC2])}))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "CreateClientVpnEndpoint" {
+				if tfawserr.ErrMessageContains(r.Error, "OperationNotPermitted", "Endpoint cannot be created while another endpoint is being created") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+
+			if r.Operation.Name == "CreateVpnConnection" {
+				if tfawserr.ErrMessageContains(r.Error, "VpnConnectionLimitExceeded", "maximum number of mutating objects has been reached") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+
+			if r.Operation.Name == "CreateVpnGateway" {
+				if tfawserr.ErrMessageContains(r.Error, "VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+
+			if r.Operation.Name == "AttachVpnGateway" || r.Operation.Name == "DetachVpnGateway" {
+				if tfawserr.ErrMessageContains(r.Error, "InvalidParameterValue", "This call cannot be completed because there are pending VPNs or Virtual Interfaces") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*ec2.EC2)
+}
+
+func (client *AWSClient) EC2InstanceConnectConn() *ec2instanceconnect.EC2InstanceConnect {
+	return client.conn(EC2InstanceConnect, func(sess *session.Session) interface{} {
+		return ec2instanceconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[EC2InstanceConnect])}))
+	}).(*ec2instanceconnect.EC2InstanceConnect)
+}
+
+func (client *AWSClient) ECRConn() *ecr.ECR {
+	return client.conn(ECR, func(sess *session.Session) interface{} {
+		return ecr.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ECR])}))
+	}).(*ecr.ECR)
+}
+
+func (client *AWSClient) ECRPublicConn() *ecrpublic.ECRPublic {
+	return client.conn(ECRPublic, func(sess *session.Session) interface{} {
+		return ecrpublic.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ECRPublic])}))
+	}).(*ecrpublic.ECRPublic)
+}
+
+func (client *AWSClient) ECSConn() *ecs.ECS {
+	return client.conn(ECS, func(sess *session.Session) interface{} {
+		return ecs.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ECS])}))
+	}).(*ecs.ECS)
+}
+
+func (client *AWSClient) EFSConn() *efs.EFS {
+	return client.conn(EFS, func(sess *session.Session) interface{} {
+		return efs.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[EFS])}))
+	}).(*efs.EFS)
+}
+
+func (client *AWSClient) EKSConn() *eks.EKS {
+	return client.conn(EKS, func(sess *session.Session) interface{} {
+		return eks.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[EKS])}))
+	}).(*eks.EKS)
+}
+
+func (client *AWSClient) ElastiCacheConn() *elasticache.ElastiCache {
+	return client.conn(ElastiCache, func(sess *session.Session) interface{} {
+		return elasticache.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ElastiCache])}))
+	}).(*elasticache.ElastiCache)
+}
+
+func (client *AWSClient) ElasticBeanstalkConn() *elasticbeanstalk.ElasticBeanstalk {
+	return client.conn(ElasticBeanstalk, func(sess *session.Session) interface{} {
+		return elasticbeanstalk.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ElasticBeanstalk])}))
+	}).(*elasticbeanstalk.ElasticBeanstalk)
+}
+
+func (client *AWSClient) ElasticInferenceConn() *elasticinference.ElasticInference {
+	return client.conn(ElasticInference, func(sess *session.Session) interface{} {
+		return elasticinference.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ElasticInference])}))
+	}).(*elasticinference.ElasticInference)
+}
+
+func (client *AWSClient) ElasticsearchConn() *elasticsearch.ElasticsearchService {
+	return client.conn(Elasticsearch, func(sess *session.Session) interface{} {
+		return elasticsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Elasticsearch])}))
+	}).(*elasticsearch.ElasticsearchService)
+}
+
+func (client *AWSClient) ElasticTranscoderConn() *elastictranscoder.ElasticTranscoder {
+	return client.conn(ElasticTranscoder, func(sess *session.Session) interface{} {
+		return elastictranscoder.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ElasticTranscoder])}))
+	}).(*elastictranscoder.ElasticTranscoder)
+}
+
+func (client *AWSClient) ELBConn() *elb.ELB {
+	return client.conn(ELB, func(sess *session.Session) interface{} {
+		return elb.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ELB])}))
+	}).(*elb.ELB)
+}
+
+func (client *AWSClient) ELBV2Conn() *elbv2.ELBV2 {
+	return client.conn(ELBV2, func(sess *session.Session) interface{} {
+		return elbv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ELBV2])}))
+	}).(*elbv2.ELBV2)
+}
+
+func (client *AWSClient) EMRConn() *emr.EMR {
+	return client.conn(EMR, func(sess *session.Session) interface{} {
+		return emr.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[EMR])}))
+	}).(*emr.EMR)
+}
+
+func (client *AWSClient) EMRContainersConn() *emrcontainers.EMRContainers {
+	return client.conn(EMRContainers, func(sess *session.Session) interface{} {
+		return emrcontainers.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[EMRContainers])}))
+	}).(*emrcontainers.EMRContainers)
+}
+
+func (client *AWSClient) EventsConn() *eventbridge.EventBridge {
+	return client.conn(Events, func(sess *session.Session) interface{} {
+		return eventbridge.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Events])}))
+	}).(*eventbridge.EventBridge)
+}
+
+func (client *AWSClient) FinSpaceConn() *finspace.Finspace {
+	return client.conn(FinSpace, func(sess *session.Session) interface{} {
+		return finspace.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[FinSpace])}))
+	}).(*finspace.Finspace)
+}
+
+func (client *AWSClient) FinSpaceDataConn() *finspacedata.FinSpaceData {
+	return client.conn(FinSpaceData, func(sess *session.Session) interface{} {
+		return finspacedata.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[FinSpaceData])}))
+	}).(*finspacedata.FinSpaceData)
+}
+
+func (client *AWSClient) FirehoseConn() *firehose.Firehose {
+	return client.conn(Firehose, func(sess *session.Session) interface{} {
+		return firehose.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Firehose])}))
+	}).(*firehose.Firehose)
+}
+
+func (client *AWSClient) FISConn() *fis.FIS {
+	return client.conn(FIS, func(sess *session.Session) interface{} {
+		return fis.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[FIS])}))
+	}).(*fis.FIS)
+}
+
+func (client *AWSClient) FMSConn() *fms.FMS {
+	return client.conn(FMS, func(sess *session.Session) interface{} {
+		conn := fms.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[FMS])}))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// Acceptance testing creates and deletes resources in quick succession.
+			// The FMS onboarding process into Organizations is opaque to consumers.
+			// Since we cannot reasonably check this status before receiving the error,
+			// set the operation as retryable.
+			switch r.Operation.Name {
+			case "AssociateAdminAccount":
+				if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently offboarding with AWS Firewall Manager. Please submit onboard request after offboarded.") {
+					r.Retryable = aws.Bool(true)
+				}
+			case "DisassociateAdminAccount":
+				if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently onboarding with AWS Firewall Manager and cannot be offboarded.") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*fms.FMS)
+}
+
+func (client *AWSClient) ForecastConn() *forecastservice.ForecastService {
+	return client.conn(Forecast, func(sess *session.Session) interface{} {
+		return forecastservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Forecast])}))
+	}).(*forecastservice.ForecastService)
+}
+
+func (client *AWSClient) ForecastQueryConn() *forecastqueryservice.ForecastQueryService {
+	return client.conn(ForecastQuery, func(sess *session.Session) interface{} {
+		return forecastqueryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ForecastQuery])}))
+	}).(*forecastqueryservice.ForecastQueryService)
+}
+
+func (client *AWSClient) FraudDetectorConn() *frauddetector.FraudDetector {
+	return client.conn(FraudDetector, func(sess *session.Session) interface{} {
+		return frauddetector.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[FraudDetector])}))
+	}).(*frauddetector.FraudDetector)
+}
+
+func (client *AWSClient) FSxConn() *fsx.FSx {
+	return client.conn(FSx, func(sess *session.Session) interface{} {
+		return fsx.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[FSx])}))
+	}).(*fsx.FSx)
+}
+
+func (client *AWSClient) GameLiftConn() *gamelift.GameLift {
+	return client.conn(GameLift, func(sess *session.Session) interface{} {
+		return gamelift.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[GameLift])}))
+	}).(*gamelift.GameLift)
+}
+
+func (client *AWSClient) GlacierConn() *glacier.Glacier {
+	return client.conn(Glacier, func(sess *session.Session) interface{} {
+		return glacier.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Glacier])}))
+	}).(*glacier.Glacier)
+}
+
+func (client *AWSClient) GlobalAcceleratorConn() *globalaccelerator.GlobalAccelerator {
+	return client.conn(GlobalAccelerator, func(sess *session.Session) interface{} {
+		config := &aws.Config{Endpoint: aws.String(client.endpoints[GlobalAccelerator])}
+
+		// Force "global" services to correct regions
+		if client.Partition == endpoints.AwsPartitionID {
+			config.Region = aws.String(endpoints.UsWest2RegionID)
+		}
+
+		return globalaccelerator.New(sess.Copy(config))
+	}).(*globalaccelerator.GlobalAccelerator)
+}
+
+func (client *AWSClient) GlueConn() *glue.Glue {
+	return client.conn(Glue, func(sess *session.Session) interface{} {
+		return glue.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Glue])}))
+	}).(*glue.Glue)
+}
+
+func (client *AWSClient) GlueDataBrewConn() *gluedatabrew.GlueDataBrew {
+	return client.conn(GlueDataBrew, func(sess *session.Session) interface{} {
+		return gluedatabrew.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[GlueDataBrew])}))
+	}).(*gluedatabrew.GlueDataBrew)
+}
+
+func (client *AWSClient) GreengrassConn() *greengrass.Greengrass {
+	return client.conn(Greengrass, func(sess *session.Session) interface{} {
+		return greengrass.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Greengrass])}))
+	}).(*greengrass.Greengrass)
+}
+
+func (client *AWSClient) GreengrassV2Conn() *greengrassv2.GreengrassV2 {
+	return client.conn(GreengrassV2, func(sess *session.Session) interface{} {
+		return greengrassv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[GreengrassV2])}))
+	}).(*greengrassv2.GreengrassV2)
+}
+
+func (client *AWSClient) GroundStationConn() *groundstation.GroundStation {
+	return client.conn(GroundStation, func(sess *session.Session) interface{} {
+		return groundstation.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[GroundStation])}))
+	}).(*groundstation.GroundStation)
+}
+
+func (client *AWSClient) GuardDutyConn() *guardduty.GuardDuty {
+	return client.conn(GuardDuty, func(sess *session.Session) interface{} {
+		return guardduty.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[GuardDuty])}))
+	}).(*guardduty.GuardDuty)
+}
+
+func (client *AWSClient) HealthConn() *health.Health {
+	return client.conn(Health, func(sess *session.Session) interface{} {
+		return health.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Health])}))
+	}).(*health.Health)
+}
+
+func (client *AWSClient) HealthLakeConn() *healthlake.HealthLake {
+	return client.conn(HealthLake, func(sess *session.Session) interface{} {
+		return healthlake.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[HealthLake])}))
+	}).(*healthlake.HealthLake)
+}
+
+func (client *AWSClient) HoneycodeConn() *honeycode.Honeycode {
+	return client.conn(Honeycode, func(sess *session.Session) interface{} {
+		return honeycode.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Honeycode])}))
+	}).(*honeycode.Honeycode)
+}
+
+func (client *AWSClient) IAMConn() *iam.IAM {
+	return client.conn(IAM, func(sess *session.Session) interface{} {
+		return iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[IAM])}))
+	}).(*iam.IAM)
+}
+
+func (client *AWSClient) IdentityStoreConn() *identitystore.IdentityStore {
+	return client.conn(IdentityStore, func(sess *session.Session) interface{} {
+		return identitystore.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[IdentityStore])}))
+	}).(*identitystore.IdentityStore)
+}
+
+func (client *AWSClient) ImageBuilderConn() *imagebuilder.Imagebuilder {
+	return client.conn(ImageBuilder, func(sess *session.Session) interface{} {
+		return imagebuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ImageBuilder])}))
+	}).(*imagebuilder.Imagebuilder)
+}
+
+func (client *AWSClient) InspectorConn() *inspector.Inspector {
+	return client.conn(Inspector, func(sess *session.Session) interface{} {
+		return inspector.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Inspector])}))
+	}).(*inspector.Inspector)
+}
+
+func (client *AWSClient) IoT1ClickDevicesConn() *iot1clickdevicesservice.IoT1ClickDevicesService {
+	return client.conn(IoT1ClickDevices, func(sess *session.Session) interface{} {
+		return iot1clickdevicesservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[IoT1ClickDevices])}))
+	}).(*iot1clickdevicesservice.IoT1ClickDevicesService)
+}
+
+func (client *AWSClient) IoT1ClickProjectsConn() *iot1clickprojects.IoT1ClickProjects {
+	return client.conn(IoT1ClickProjects, func(sess *session.Session) interface{} {
+		return iot1clickprojects.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[IoT1ClickProjects])}))
+	}).(*iot1clickprojects.IoT1ClickProjects)
+}
+
+func (client *AWSClient) IoTAnalyticsConn() *iotanalytics.IoTAnalytics {
+	return client.conn(IoTAnalytics, func(sess *session.Session) interface{} {
+		return iotanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[IoTAnalytics])}))
+	}).(*iotanalytics.IoTAnalytics)
+}
+
+func (client *AWSClient) IoTConn() *iot.IoT {
+	return client.conn(IoT, func(sess *session.Session) interface{} {
+		return iot.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[IoT])}))
+	}).(*iot.IoT)
+}
+
+func (client *AWSClient) IoTDataPlaneConn() *iotdataplane.IoTDataPlane {
+	return client.conn(IoTDataPlane, func(sess *session.Session) interface{} {
+		return iotdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[IoTDataPlane])}))
+	}).(*iotdataplane.IoTDataPlane)
+}
+
+func (client *AWSClient) IoTDeviceAdvisorConn() *iotdeviceadvisor.IoTDeviceAdvisor {
+	return client.conn(IoTDeviceAdvisor, func(sess *session.Session) interface{} {
+		return iotdeviceadvisor.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[IoTDeviceAdvisor])}))
+	}).(*iotdeviceadvisor.IoTDeviceAdvisor)
+}
+
+func (client *AWSClient) IoTEventsConn() *iotevents.IoTEvents {
+	return client.conn(IoTEvents, func(sess *session.Session) interface{} {
+		return iotevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[IoTEvents])}))
+	}).(*iotevents.IoTEvents)
+}
+
+func (client *AWSClient) IoTEventsDataConn() *ioteventsdata.IoTEventsData {
+	return client.conn(IoTEventsData, func(sess *session.Session) interface{} {
+		return ioteventsdata.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[IoTEventsData])}))
+	}).(*ioteventsdata.IoTEventsData)
+}
+
+func (client *AWSClient) IoTFleetHubConn() *iotfleethub.IoTFleetHub {
+	return client.conn(IoTFleetHub, func(sess *session.Session) interface{} {
+		return iotfleethub.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[IoTFleetHub])}))
+	}).(*iotfleethub.IoTFleetHub)
+}
+
+func (client *AWSClient) IoTJobsDataPlaneConn() *iotjobsdataplane.IoTJobsDataPlane {
+	return client.conn(IoTJobsDataPlane, func(sess *session.Session) interface{} {
+		return iotjobsdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[IoTJobsDataPlane])}))
+	}).(*iotjobsdataplane.IoTJobsDataPlane)
+}
+
+func (client *AWSClient) IoTSecureTunnelingConn() *iotsecuretunneling.IoTSecureTunneling {
+	return client.conn(IoTSecureTunneling, func(sess *session.Session) interface{} {
+		return iotsecuretunneling.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[IoTSecureTunneling])}))
+	}).(*iotsecuretunneling.IoTSecureTunneling)
+}
+
+func (client *AWSClient) IoTSiteWiseConn() *iotsitewise.IoTSiteWise {
+	return client.conn(IoTSiteWise, func(sess *session.Session) interface{} {
+		return iotsitewise.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[IoTSiteWise])}))
+	}).(*iotsitewise.IoTSiteWise)
+}
+
+func (client *AWSClient) IoTThingsGraphConn() *iotthingsgraph.IoTThingsGraph {
+	return client.conn(IoTThingsGraph, func(sess *session.Session) interface{} {
+		return iotthingsgraph.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[IoTThingsGraph])}))
+	}).(*iotthingsgraph.IoTThingsGraph)
+}
+
+func (client *AWSClient) IoTWirelessConn() *iotwireless.IoTWireless {
+	return client.conn(IoTWireless, func(sess *session.Session) interface{} {
+		return iotwireless.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[IoTWireless])}))
+	}).(*iotwireless.IoTWireless)
+}
+
+func (client *AWSClient) KafkaConn() *kafka.Kafka {
+	return client.conn(Kafka, func(sess *session.Session) interface{} {
+		conn := kafka.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Kafka])}))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if tfawserr.ErrMessageContains(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*kafka.Kafka)
+}
+
+func (client *AWSClient) KendraConn() *kendra.Kendra {
+	return client.conn(Kendra, func(sess *session.Session) interface{} {
+		return kendra.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Kendra])}))
+	}).(*kendra.Kendra)
+}
+
+func (client *AWSClient) KinesisAnalyticsConn() *kinesisanalytics.KinesisAnalytics {
+	return client.conn(KinesisAnalytics, func(sess *session.Session) interface{} {
+		return kinesisanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[KinesisAnalytics])}))
+	}).(*kinesisanalytics.KinesisAnalytics)
+}
+
+func (client *AWSClient) KinesisAnalyticsV2Conn() *kinesisanalyticsv2.KinesisAnalyticsV2 {
+	return client.conn(KinesisAnalyticsV2, func(sess *session.Session) interface{} {
+		return kinesisanalyticsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[KinesisAnalyticsV2])}))
+	}).(*kinesisanalyticsv2.KinesisAnalyticsV2)
+}
+
+func (client *AWSClient) KinesisConn() *kinesis.Kinesis {
+	return client.conn(Kinesis, func(sess *session.Session) interface{} {
+		conn := kinesis.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Kinesis])}))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "CreateStream" {
+				if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+			if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
+				if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*kinesis.Kinesis)
+}
+
+func (client *AWSClient) KinesisVideoArchivedMediaConn() *kinesisvideoarchivedmedia.KinesisVideoArchivedMedia {
+	return client.conn(KinesisVideoArchivedMedia, func(sess *session.Session) interface{} {
+		return kinesisvideoarchivedmedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[KinesisVideoArchivedMedia])}))
+	}).(*kinesisvideoarchivedmedia.KinesisVideoArchivedMedia)
+}
+
+func (client *AWSClient) KinesisVideoConn() *kinesisvideo.KinesisVideo {
+	return client.conn(KinesisVideo, func(sess *session.Session) interface{} {
+		return kinesisvideo.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[KinesisVideo])}))
+	}).(*kinesisvideo.KinesisVideo)
+}
+
+func (client *AWSClient) KinesisVideoMediaConn() *kinesisvideomedia.KinesisVideoMedia {
+	return client.conn(KinesisVideoMedia, func(sess *session.Session) interface{} {
+		return kinesisvideomedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[KinesisVideoMedia])}))
+	}).(*kinesisvideomedia.KinesisVideoMedia)
+}
+
+func (client *AWSClient) KinesisVideoSignalingChannelsConn() *kinesisvideosignalingchannels.KinesisVideoSignalingChannels {
+	return client.conn(KinesisVideoSignalingChannels, func(sess *session.Session) interface{} {
+		return kinesisvideosignalingchannels.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[KinesisVideoSignalingChannels])}))
+	}).(*kinesisvideosignalingchannels.KinesisVideoSignalingChannels)
+}
+
+func (client *AWSClient) KMSConn() *kms.KMS {
+	return client.conn(KMS, func(sess *session.Session) interface{} {
+		return kms.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[KMS])}))
+	}).(*kms.KMS)
+}
+
+func (client *AWSClient) LakeFormationConn() *lakeformation.LakeFormation {
+	return client.conn(LakeFormation, func(sess *session.Session) interface{} {
+		return lakeformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[LakeFormation])}))
+	}).(*lakeformation.LakeFormation)
+}
+
+func (client *AWSClient) LambdaConn() *lambda.Lambda {
+	return client.conn(Lambda, func(sess *session.Session) interface{} {
+		return lambda.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Lambda])}))
+	}).(*lambda.Lambda)
+}
+
+func (client *AWSClient) LexModelsConn() *lexmodelbuildingservice.LexModelBuildingService {
+	return client.conn(LexModels, func(sess *session.Session) interface{} {
+		return lexmodelbuildingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[LexModels])}))
+	}).(*lexmodelbuildingservice.LexModelBuildingService)
+}
+
+func (client *AWSClient) LexModelsV2Conn() *lexmodelsv2.LexModelsV2 {
+	return client.conn(LexModelsV2, func(sess *session.Session) interface{} {
+		return lexmodelsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[LexModelsV2])}))
+	}).(*lexmodelsv2.LexModelsV2)
+}
+
+func (client *AWSClient) LexRuntimeConn() *lexruntimeservice.LexRuntimeService {
+	return client.conn(LexRuntime, func(sess *session.Session) interface{} {
+		return lexruntimeservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[LexRuntime])}))
+	}).(*lexruntimeservice.LexRuntimeService)
+}
+
+func (client *AWSClient) LexRuntimeV2Conn() *lexruntimev2.LexRuntimeV2 {
+	return client.conn(LexRuntimeV2, func(sess *session.Session) interface{} {
+		return lexruntimev2.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[LexRuntimeV2])}))
+	}).(*lexruntimev2.LexRuntimeV2)
+}
+
+func (client *AWSClient) LicenseManagerConn() *licensemanager.LicenseManager {
+	return client.conn(LicenseManager, func(sess *session.Session) interface{} {
+		return licensemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[LicenseManager])}))
+	}).(*licensemanager.LicenseManager)
+}
+
+func (client *AWSClient) LightsailConn() *lightsail.Lightsail {
+	return client.conn(Lightsail, func(sess *session.Session) interface{} {
+		return lightsail.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Lightsail])}))
+	}).(*lightsail.Lightsail)
+}
+
+func (client *AWSClient) LocationConn() *locationservice.LocationService {
+	return client.conn(Location, func(sess *session.Session) interface{} {
+		return locationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Location])}))
+	}).(*locationservice.LocationService)
+}
+
+func (client *AWSClient) LookoutEquipmentConn() *lookoutequipment.LookoutEquipment {
+	return client.conn(LookoutEquipment, func(sess *session.Session) interface{} {
+		return lookoutequipment.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[LookoutEquipment])}))
+	}).(*lookoutequipment.LookoutEquipment)
+}
+
+func (client *AWSClient) LookoutForVisionConn() *lookoutforvision.LookoutForVision {
+	return client.conn(LookoutForVision, func(sess *session.Session) interface{} {
+		return lookoutforvision.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[LookoutForVision])}))
+	}).(*lookoutforvision.LookoutForVision)
+}
+
+func (client *AWSClient) LookoutMetricsConn() *lookoutmetrics.LookoutMetrics {
+	return client.conn(LookoutMetrics, func(sess *session.Session) interface{} {
+		return lookoutmetrics.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[LookoutMetrics])}))
+	}).(*lookoutmetrics.LookoutMetrics)
+}
+
+func (client *AWSClient) MachineLearningConn() *machinelearning.MachineLearning {
+	return client.conn(MachineLearning, func(sess *session.Session) interface{} {
+		return machinelearning.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[MachineLearning])}))
+	}).(*machinelearning.MachineLearning)
+}
+
+func (client *AWSClient) Macie2Conn() *macie2.Macie2 {
+	return client.conn(Macie2, func(sess *session.Session) interface{} {
+		return macie2.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Macie2])}))
+	}).(*macie2.Macie2)
+}
+
+func (client *AWSClient) MacieConn() *macie.Macie {
+	return client.conn(Macie, func(sess *session.Session) interface{} {
+		return macie.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Macie])}))
+	}).(*macie.Macie)
+}
+
+func (client *AWSClient) ManagedBlockchainConn() *managedblockchain.ManagedBlockchain {
+	return client.conn(ManagedBlockchain, func(sess *session.Session) interface{} {
+		return managedblockchain.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ManagedBlockchain])}))
+	}).(*managedblockchain.ManagedBlockchain)
+}
+
+func (client *AWSClient) MarketplaceCatalogConn() *marketplacecatalog.MarketplaceCatalog {
+	return client.conn(MarketplaceCatalog, func(sess *session.Session) interface{} {
+		return marketplacecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[MarketplaceCatalog])}))
+	}).(*marketplacecatalog.MarketplaceCatalog)
+}
+
+func (client *AWSClient) MarketplaceCommerceAnalyticsConn() *marketplacecommerceanalytics.MarketplaceCommerceAnalytics {
+	return client.conn(MarketplaceCommerceAnalytics, func(sess *session.Session) interface{} {
+		return marketplacecommerceanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[MarketplaceCommerceAnalytics])}))
+	}).(*marketplacecommerceanalytics.MarketplaceCommerceAnalytics)
+}
+
+func (client *AWSClient) MarketplaceEntitlementConn() *marketplaceentitlementservice.MarketplaceEntitlementService {
+	return client.conn(MarketplaceEntitlement, func(sess *session.Session) interface{} {
+		return marketplaceentitlementservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[MarketplaceEntitlement])}))
+	}).(*marketplaceentitlementservice.MarketplaceEntitlementService)
+}
+
+func (client *AWSClient) MarketplaceMeteringConn() *marketplacemetering.MarketplaceMetering {
+	return client.conn(MarketplaceMetering, func(sess *session.Session) interface{} {
+		return marketplacemetering.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[MarketplaceMetering])}))
+	}).(*marketplacemetering.MarketplaceMetering)
+}
+
+func (client *AWSClient) MediaConnectConn() *mediaconnect.MediaConnect {
+	return client.conn(MediaConnect, func(sess *session.Session) interface{} {
+		return mediaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[MediaConnect])}))
+	}).(*mediaconnect.MediaConnect)
+}
+
+func (client *AWSClient) MediaConvertConn() *mediaconvert.MediaConvert {
+	return client.conn(MediaConvert, func(sess *session.Session) interface{} {
+		return mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[MediaConvert])}))
+	}).(*mediaconvert.MediaConvert)
+}
+
+func (client *AWSClient) MediaLiveConn() *medialive.MediaLive {
+	return client.conn(MediaLive, func(sess *session.Session) interface{} {
+		return medialive.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[MediaLive])}))
+	}).(*medialive.MediaLive)
+}
+
+func (client *AWSClient) MediaPackageConn() *mediapackage.MediaPackage {
+	return client.conn(MediaPackage, func(sess *session.Session) interface{} {
+		return mediapackage.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[MediaPackage])}))
+	}).(*mediapackage.MediaPackage)
+}
+
+func (client *AWSClient) MediaPackageVODConn() *mediapackagevod.MediaPackageVod {
+	return client.conn(MediaPackageVOD, func(sess *session.Session) interface{} {
+		return mediapackagevod.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[MediaPackageVOD])}))
+	}).(*mediapackagevod.MediaPackageVod)
+}
+
+func (client *AWSClient) MediaStoreConn() *mediastore.MediaStore {
+	return client.conn(MediaStore, func(sess *session.Session) interface{} {
+		return mediastore.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[MediaStore])}))
+	}).(*mediastore.MediaStore)
+}
+
+func (client *AWSClient) MediaStoreDataConn() *mediastoredata.MediaStoreData {
+	return client.conn(MediaStoreData, func(sess *session.Session) interface{} {
+		return mediastoredata.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[MediaStoreData])}))
+	}).(*mediastoredata.MediaStoreData)
+}
+
+func (client *AWSClient) MediaTailorConn() *mediatailor.MediaTailor {
+	return client.conn(MediaTailor, func(sess *session.Session) interface{} {
+		return mediatailor.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[MediaTailor])}))
+	}).(*mediatailor.MediaTailor)
+}
+
+func (client *AWSClient) MemoryDBConn() *memorydb.MemoryDB {
+	return client.conn(MemoryDB, func(sess *session.Session) interface{} {
+		return memorydb.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[MemoryDB])}))
+	}).(*memorydb.MemoryDB)
+}
+
+func (client *AWSClient) MgnConn() *mgn.Mgn {
+	return client.conn(Mgn, func(sess *session.Session) interface{} {
+		return mgn.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Mgn])}))
+	}).(*mgn.Mgn)
+}
+
+func (client *AWSClient) MigrationHubConfigConn() *migrationhubconfig.MigrationHubConfig {
+	return client.conn(MigrationHubConfig, func(sess *session.Session) interface{} {
+		return migrationhubconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[MigrationHubConfig])}))
+	}).(*migrationhubconfig.MigrationHubConfig)
+}
+
+func (client *AWSClient) MigrationHubConn() *migrationhub.MigrationHub {
+	return client.conn(MigrationHub, func(sess *session.Session) interface{} {
+		return migrationhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[MigrationHub])}))
+	}).(*migrationhub.MigrationHub)
+}
+
+func (client *AWSClient) MobileAnalyticsConn() *mobileanalytics.MobileAnalytics {
+	return client.conn(MobileAnalytics, func(sess *session.Session) interface{} {
+		return mobileanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[MobileAnalytics])}))
+	}).(*mobileanalytics.MobileAnalytics)
+}
+
+func (client *AWSClient) MobileConn() *mobile.Mobile {
+	return client.conn(Mobile, func(sess *session.Session) interface{} {
+		return mobile.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Mobile])}))
+	}).(*mobile.Mobile)
+}
+
+func (client *AWSClient) MQConn() *mq.MQ {
+	return client.conn(MQ, func(sess *session.Session) interface{} {
+		return mq.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[MQ])}))
+	}).(*mq.MQ)
+}
+
+func (client *AWSClient) MTurkConn() *mturk.MTurk {
+	return client.conn(MTurk, func(sess *session.Session) interface{} {
+		return mturk.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[MTurk])}))
+	}).(*mturk.MTurk)
+}
+
+func (client *AWSClient) MWAAConn() *mwaa.MWAA {
+	return client.conn(MWAA, func(sess *session.Session) interface{} {
+		return mwaa.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[MWAA])}))
+	}).(*mwaa.MWAA)
+}
+
+func (client *AWSClient) NeptuneConn() *neptune.Neptune {
+	return client.conn(Neptune, func(sess *session.Session) interface{} {
+		return neptune.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Neptune])}))
+	}).(*neptune.Neptune)
+}
+
+func (client *AWSClient) NetworkFirewallConn() *networkfirewall.NetworkFirewall {
+	return client.conn(NetworkFirewall, func(sess *session.Session) interface{} {
+		return networkfirewall.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[NetworkFirewall])}))
+	}).(*networkfirewall.NetworkFirewall)
+}
+
+func (client *AWSClient) NetworkManagerConn() *networkmanager.NetworkManager {
+	return client.conn(NetworkManager, func(sess *session.Session) interface{} {
+		return networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[NetworkManager])}))
+	}).(*networkmanager.NetworkManager)
+}
+
+func (client *AWSClient) NimbleStudioConn() *nimblestudio.NimbleStudio {
+	return client.conn(NimbleStudio, func(sess *session.Session) interface{} {
+		return nimblestudio.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[NimbleStudio])}))
+	}).(*nimblestudio.NimbleStudio)
+}
+
+func (client *AWSClient) OpsWorksCMConn() *opsworkscm.OpsWorksCM {
+	return client.conn(OpsWorksCM, func(sess *session.Session) interface{} {
+		return opsworkscm.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[OpsWorksCM])}))
+	}).(*opsworkscm.OpsWorksCM)
+}
+
+func (client *AWSClient) OpsWorksConn() *opsworks.OpsWorks {
+	return client.conn(OpsWorks, func(sess *session.Session) interface{} {
+		return opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[OpsWorks])}))
+	}).(*opsworks.OpsWorks)
+}
+
+func (client *AWSClient) OrganizationsConn() *organizations.Organizations {
+	return client.conn(Organizations, func(sess *session.Session) interface{} {
+		conn := organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Organizations])}))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// Retry on the following error:
+			// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
+			if tfawserr.ErrMessageContains(r.Error, organizations.ErrCodeConcurrentModificationException, "Try again later") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*organizations.Organizations)
+}
+
+func (client *AWSClient) OutpostsConn() *outposts.Outposts {
+	return client.conn(Outposts, func(sess *session.Session) interface{} {
+		return outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Outposts])}))
+	}).(*outposts.Outposts)
+}
+
+func (client *AWSClient) PersonalizeConn() *personalize.Personalize {
+	return client.conn(Personalize, func(sess *session.Session) interface{} {
+		return personalize.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Personalize])}))
+	}).(*personalize.Personalize)
+}
+
+func (client *AWSClient) PersonalizeEventsConn() *personalizeevents.PersonalizeEvents {
+	return client.conn(PersonalizeEvents, func(sess *session.Session) interface{} {
+		return personalizeevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[PersonalizeEvents])}))
+	}).(*personalizeevents.PersonalizeEvents)
+}
+
+func (client *AWSClient) PersonalizeRuntimeConn() *personalizeruntime.PersonalizeRuntime {
+	return client.conn(PersonalizeRuntime, func(sess *session.Session) interface{} {
+		return personalizeruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[PersonalizeRuntime])}))
+	}).(*personalizeruntime.PersonalizeRuntime)
+}
+
+func (client *AWSClient) PIConn() *pi.PI {
+	return client.conn(PI, func(sess *session.Session) interface{} {
+		return pi.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[PI])}))
+	}).(*pi.PI)
+}
+
+func (client *AWSClient) PinpointConn() *pinpoint.Pinpoint {
+	return client.conn(Pinpoint, func(sess *session.Session) interface{} {
+		return pinpoint.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Pinpoint])}))
+	}).(*pinpoint.Pinpoint)
+}
+
+func (client *AWSClient) PinpointEmailConn() *pinpointemail.PinpointEmail {
+	return client.conn(PinpointEmail, func(sess *session.Session) interface{} {
+		return pinpointemail.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[PinpointEmail])}))
+	}).(*pinpointemail.PinpointEmail)
+}
+
+func (client *AWSClient) PinpointSMSVoiceConn() *pinpointsmsvoice.PinpointSMSVoice {
+	return client.conn(PinpointSMSVoice, func(sess *session.Session) interface{} {
+		return pinpointsmsvoice.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[PinpointSMSVoice])}))
+	}).(*pinpointsmsvoice.PinpointSMSVoice)
+}
+
+func (client *AWSClient) PollyConn() *polly.Polly {
+	return client.conn(Polly, func(sess *session.Session) interface{} {
+		return polly.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Polly])}))
+	}).(*polly.Polly)
+}
+
+func (client *AWSClient) PricingConn() *pricing.Pricing {
+	return client.conn(Pricing, func(sess *session.Session) interface{} {
+		return pricing.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Pricing])}))
+	}).(*pricing.Pricing)
+}
+
+func (client *AWSClient) ProtonConn() *proton.Proton {
+	return client.conn(Proton, func(sess *session.Session) interface{} {
+		return proton.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Proton])}))
+	}).(*proton.Proton)
+}
+
+func (client *AWSClient) QLDBConn() *qldb.QLDB {
+	return client.conn(QLDB, func(sess *session.Session) interface{} {
+		return qldb.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[QLDB])}))
+	}).(*qldb.QLDB)
+}
+
+func (client *AWSClient) QLDBSessionConn() *qldbsession.QLDBSession {
+	return client.conn(QLDBSession, func(sess *session.Session) interface{} {
+		return qldbsession.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[QLDBSession])}))
+	}).(*qldbsession.QLDBSession)
+}
+
+func (client *AWSClient) QuickSightConn() *quicksight.QuickSight {
+	return client.conn(QuickSight, func(sess *session.Session) interface{} {
+		return quicksight.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[QuickSight])}))
+	}).(*quicksight.QuickSight)
+}
+
+func (client *AWSClient) RAMConn() *ram.RAM {
+	return client.conn(RAM, func(sess *session.Session) interface{} {
+		return ram.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[RAM])}))
+	}).(*ram.RAM)
+}
+
+func (client *AWSClient) RDSConn() *rds.RDS {
+	return client.conn(RDS, func(sess *session.Session) interface{} {
+		return rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[RDS])}))
+	}).(*rds.RDS)
+}
+
+func (client *AWSClient) RDSDataConn() *rdsdataservice.RDSDataService {
+	return client.conn(RDSData, func(sess *session.Session) interface{} {
+		return rdsdataservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[RDSData])}))
+	}).(*rdsdataservice.RDSDataService)
+}
+
+func (client *AWSClient) RedshiftConn() *redshift.Redshift {
+	return client.conn(Redshift, func(sess *session.Session) interface{} {
+		return redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Redshift])}))
+	}).(*redshift.Redshift)
+}
+
+func (client *AWSClient) RedshiftDataConn() *redshiftdataapiservice.RedshiftDataAPIService {
+	return client.conn(RedshiftData, func(sess *session.Session) interface{} {
+		return redshiftdataapiservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[RedshiftData])}))
+	}).(*redshiftdataapiservice.RedshiftDataAPIService)
+}
+
+func (client *AWSClient) RekognitionConn() *rekognition.Rekognition {
+	return client.conn(Rekognition, func(sess *session.Session) interface{} {
+		return rekognition.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Rekognition])}))
+	}).(*rekognition.Rekognition)
+}
+
+func (client *AWSClient) ResourceGroupsConn() *resourcegroups.ResourceGroups {
+	return client.conn(ResourceGroups, func(sess *session.Session) interface{} {
+		return resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ResourceGroups])}))
+	}).(*resourcegroups.ResourceGroups)
+}
+
+func (client *AWSClient) ResourceGroupsTaggingAPIConn() *resourcegroupstaggingapi.ResourceGroupsTaggingAPI {
+	return client.conn(ResourceGroupsTaggingAPI, func(sess *session.Session) interface{} {
+		return resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ResourceGroupsTaggingAPI])}))
+	}).(*resourcegroupstaggingapi.ResourceGroupsTaggingAPI)
+}
+
+func (client *AWSClient) RoboMakerConn() *robomaker.RoboMaker {
+	return client.conn(RoboMaker, func(sess *session.Session) interface{} {
+		return robomaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[RoboMaker])}))
+	}).(*robomaker.RoboMaker)
+}
+
+func (client *AWSClient) Route53Conn() *route53.Route53 {
+	return client.conn(Route53, func(sess *session.Session) interface{} {
+		config := &aws.Config{Endpoint: aws.String(client.endpoints[Route53])}
+
+		// Force "global" services to correct regions
+		switch client.Partition {
+		case endpoints.AwsPartitionID:
+			config.Region = aws.String(endpoints.UsEast1RegionID)
+		case endpoints.AwsCnPartitionID:
+			// The AWS Go SDK is missing endpoint information for Route 53 in the AWS China partition.
+			// This can likely be removed in the future.
+			if aws.StringValue(config.Endpoint) == "" {
+				config.Endpoint = aws.String("https://api.route53.cn")
+			}
+			config.Region = aws.String(endpoints.CnNorthwest1RegionID)
+		case endpoints.AwsUsGovPartitionID:
+			config.Region = aws.String(endpoints.UsGovWest1RegionID)
+		}
+
+		return route53.New(sess.Copy(config))
+	}).(*route53.Route53)
+}
+
+func (client *AWSClient) Route53DomainsConn() *route53domains.Route53Domains {
+	return client.conn(Route53Domains, func(sess *session.Session) interface{} {
+		return route53domains.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Route53Domains])}))
+	}).(*route53domains.Route53Domains)
+}
+
+func (client *AWSClient) Route53RecoveryControlConfigConn() *route53recoverycontrolconfig.Route53RecoveryControlConfig {
+	return client.conn(Route53RecoveryControlConfig, func(sess *session.Session) interface{} {
+		config := &aws.Config{Endpoint: aws.String(client.endpoints[Route53RecoveryControlConfig])}
+
+		// Force "global" services to correct regions
+		if client.Partition == endpoints.AwsPartitionID {
+			config.Region = aws.String(endpoints.UsWest2RegionID)
+		}
+
+		return route53recoverycontrolconfig.New(sess.Copy(config))
+	}).(*route53recoverycontrolconfig.Route53RecoveryControlConfig)
+}
+
+func (client *AWSClient) Route53RecoveryReadinessConn() *route53recoveryreadiness.Route53RecoveryReadiness {
+	return client.conn(Route53RecoveryReadiness, func(sess *session.Session) interface{} {
+		config := &aws.Config{Endpoint: aws.String(client.endpoints[Route53RecoveryReadiness])}
+
+		// Force "global" services to correct regions
+		if client.Partition == endpoints.AwsPartitionID {
+			config.Region = aws.String(endpoints.UsWest2RegionID)
+		}
+
+		return route53recoveryreadiness.New(sess.Copy(config))
+	}).(*route53recoveryreadiness.Route53RecoveryReadiness)
+}
+
+func (client *AWSClient) Route53ResolverConn() *route53resolver.Route53Resolver {
+	return client.conn(Route53Resolver, func(sess *session.Session) interface{} {
+		return route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Route53Resolver])}))
+	}).(*route53resolver.Route53Resolver)
+}
+
+func (client *AWSClient) S3Conn() *s3.S3 {
+	return client.conn(S3, func(sess *session.Session) interface{} {
+		conn := s3.New(sess.Copy(client.s3Config()))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if tfawserr.ErrMessageContains(r.Error, "OperationAborted", "A conflicting conditional operation is currently in progress against this resource. Please try again.") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*s3.S3)
+}
+
+func (client *AWSClient) S3ConnURICleaningDisabled() *s3.S3 {
+	return client.conn(s3URICleaningDisabled, func(sess *session.Session) interface{} {
+		config := client.s3Config()
+		config.DisableRestProtocolURICleaning = aws.Bool(true)
+
+		return s3.New(sess.Copy(config))
+	}).(*s3.S3)
+}
+
+func (client *AWSClient) S3ControlConn() *s3control.S3Control {
+	return client.conn(S3Control, func(sess *session.Session) interface{} {
+		return s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[S3Control])}))
+	}).(*s3control.S3Control)
+}
+
+func (client *AWSClient) S3OutpostsConn() *s3outposts.S3Outposts {
+	return client.conn(S3Outposts, func(sess *session.Session) interface{} {
+		return s3outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[S3Outposts])}))
+	}).(*s3outposts.S3Outposts)
+}
+
+func (client *AWSClient) SageMakerConn() *sagemaker.SageMaker {
+	return client.conn(SageMaker, func(sess *session.Session) interface{} {
+		return sagemaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[SageMaker])}))
+	}).(*sagemaker.SageMaker)
+}
+
+func (client *AWSClient) SageMakerEdgeManagerConn() *sagemakeredgemanager.SagemakerEdgeManager {
+	return client.conn(SageMakerEdgeManager, func(sess *session.Session) interface{} {
+		return sagemakeredgemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[SageMakerEdgeManager])}))
+	}).(*sagemakeredgemanager.SagemakerEdgeManager)
+}
+
+func (client *AWSClient) SageMakerFeatureStoreRuntimeConn() *sagemakerfeaturestoreruntime.SageMakerFeatureStoreRuntime {
+	return client.conn(SageMakerFeatureStoreRuntime, func(sess *session.Session) interface{} {
+		return sagemakerfeaturestoreruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[SageMakerFeatureStoreRuntime])}))
+	}).(*sagemakerfeaturestoreruntime.SageMakerFeatureStoreRuntime)
+}
+
+func (client *AWSClient) SageMakerRuntimeConn() *sagemakerruntime.SageMakerRuntime {
+	return client.conn(SageMakerRuntime, func(sess *session.Session) interface{} {
+		return sagemakerruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[SageMakerRuntime])}))
+	}).(*sagemakerruntime.SageMakerRuntime)
+}
+
+func (client *AWSClient) SavingsPlansConn() *savingsplans.SavingsPlans {
+	return client.conn(SavingsPlans, func(sess *session.Session) interface{} {
+		return savingsplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[SavingsPlans])}))
+	}).(*savingsplans.SavingsPlans)
+}
+
+func (client *AWSClient) SchemasConn() *schemas.Schemas {
+	return client.conn(Schemas, func(sess *session.Session) interface{} {
+		return schemas.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Schemas])}))
+	}).(*schemas.Schemas)
+}
+
+func (client *AWSClient) SecretsManagerConn() *secretsmanager.SecretsManager {
+	return client.conn(SecretsManager, func(sess *session.Session) interface{} {
+		return secretsmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[SecretsManager])}))
+	}).(*secretsmanager.SecretsManager)
+}
+
+func (client *AWSClient) SecurityHubConn() *securityhub.SecurityHub {
+	return client.conn(SecurityHub, func(sess *session.Session) interface{} {
+		conn := securityhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[SecurityHub])}))
+
+		// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/17996
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			switch r.Operation.Name {
+			case "EnableOrganizationAdminAccount":
+				if tfawserr.ErrCodeEquals(r.Error, securityhub.ErrCodeResourceConflictException) {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*securityhub.SecurityHub)
+}
+
+func (client *AWSClient) ServerlessRepoConn() *serverlessapplicationrepository.ServerlessApplicationRepository {
+	return client.conn(ServerlessRepo, func(sess *session.Session) interface{} {
+		return serverlessapplicationrepository.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ServerlessRepo])}))
+	}).(*serverlessapplicationrepository.ServerlessApplicationRepository)
+}
+
+func (client *AWSClient) ServiceCatalogConn() *servicecatalog.ServiceCatalog {
+	return client.conn(ServiceCatalog, func(sess *session.Session) interface{} {
+		return servicecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ServiceCatalog])}))
+	}).(*servicecatalog.ServiceCatalog)
+}
+
+func (client *AWSClient) ServiceDiscoveryConn() *servicediscovery.ServiceDiscovery {
+	return client.conn(ServiceDiscovery, func(sess *session.Session) interface{} {
+		return servicediscovery.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ServiceDiscovery])}))
+	}).(*servicediscovery.ServiceDiscovery)
+}
+
+func (client *AWSClient) ServiceQuotasConn() *servicequotas.ServiceQuotas {
+	return client.conn(ServiceQuotas, func(sess *session.Session) interface{} {
+		return servicequotas.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[ServiceQuotas])}))
+	}).(*servicequotas.ServiceQuotas)
+}
+
+func (client *AWSClient) SESConn() *ses.SES {
+	return client.conn(SES, func(sess *session.Session) interface{} {
+		return ses.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[SES])}))
+	}).(*ses.SES)
+}
+
+func (client *AWSClient) SESV2Conn() *sesv2.SESV2 {
+	return client.conn(SESV2, func(sess *session.Session) interface{} {
+		return sesv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[SESV2])}))
+	}).(*sesv2.SESV2)
+}
+
+func (client *AWSClient) SFNConn() *sfn.SFN {
+	return client.conn(SFN, func(sess *session.Session) interface{} {
+		return sfn.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[SFN])}))
+	}).(*sfn.SFN)
+}
+
+func (client *AWSClient) ShieldConn() *shield.Shield {
+	return client.conn(Shield, func(sess *session.Session) interface{} {
+		config := &aws.Config{Endpoint: aws.String(client.endpoints[Shield])}
+
+		// Force "global" services to correct regions
+		if client.Partition == endpoints.AwsPartitionID {
+			config.Region = aws.String(endpoints.UsEast1RegionID)
+		}
+
+		return shield.New(sess.Copy(config))
+	}).(*shield.Shield)
+}
+
+func (client *AWSClient) SignerConn() *signer.Signer {
+	return client.conn(Signer, func(sess *session.Session) interface{} {
+		return signer.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Signer])}))
+	}).(*signer.Signer)
+}
+
+func (client *AWSClient) SimpleDBConn() *simpledb.SimpleDB {
+	return client.conn(SimpleDB, func(sess *session.Session) interface{} {
+		return simpledb.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[SimpleDB])}))
+	}).(*simpledb.SimpleDB)
+}
+
+func (client *AWSClient) SMSConn() *sms.SMS {
+	return client.conn(SMS, func(sess *session.Session) interface{} {
+		return sms.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[SMS])}))
+	}).(*sms.SMS)
+}
+
+func (client *AWSClient) SnowballConn() *snowball.Snowball {
+	return client.conn(Snowball, func(sess *session.Session) interface{} {
+		return snowball.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Snowball])}))
+	}).(*snowball.Snowball)
+}
+
+func (client *AWSClient) SNSConn() *sns.SNS {
+	return client.conn(SNS, func(sess *session.Session) interface{} {
+		return sns.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[SNS])}))
+	}).(*sns.SNS)
+}
+
+func (client *AWSClient) SQSConn() *sqs.SQS {
+	return client.conn(SQS, func(sess *session.Session) interface{} {
+		return sqs.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[SQS])}))
+	}).(*sqs.SQS)
+}
+
+func (client *AWSClient) SSMConn() *ssm.SSM {
+	return client.conn(SSM, func(sess *session.Session) interface{} {
+		return ssm.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[SSM])}))
+	}).(*ssm.SSM)
+}
+
+func (client *AWSClient) SSMContactsConn() *ssmcontacts.SSMContacts {
+	return client.conn(SSMContacts, func(sess *session.Session) interface{} {
+		return ssmcontacts.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[SSMContacts])}))
+	}).(*ssmcontacts.SSMContacts)
+}
+
+func (client *AWSClient) SSMIncidentsConn() *ssmincidents.SSMIncidents {
+	return client.conn(SSMIncidents, func(sess *session.Session) interface{} {
+		return ssmincidents.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[SSMIncidents])}))
+	}).(*ssmincidents.SSMIncidents)
+}
+
+func (client *AWSClient) SSOAdminConn() *ssoadmin.SSOAdmin {
+	return client.conn(SSOAdmin, func(sess *session.Session) interface{} {
+		conn := ssoadmin.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[SSOAdmin])}))
+
+		// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19215
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "AttachManagedPolicyToPermissionSet" || r.Operation.Name == "DetachManagedPolicyFromPermissionSet" {
+				if tfawserr.ErrCodeEquals(r.Error, ssoadmin.ErrCodeConflictException) {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*ssoadmin.SSOAdmin)
+}
+
+func (client *AWSClient) SSOConn() *sso.SSO {
+	return client.conn(SSO, func(sess *session.Session) interface{} {
+		return sso.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[SSO])}))
+	}).(*sso.SSO)
+}
+
+func (client *AWSClient) SSOOIDCConn() *ssooidc.SSOOIDC {
+	return client.conn(SSOOIDC, func(sess *session.Session) interface{} {
+		return ssooidc.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[SSOOIDC])}))
+	}).(*ssooidc.SSOOIDC)
+}
+
+func (client *AWSClient) StorageGatewayConn() *storagegateway.StorageGateway {
+	return client.conn(StorageGateway, func(sess *session.Session) interface{} {
+		conn := storagegateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[StorageGateway])}))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
+			if tfawserr.ErrMessageContains(r.Error, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway proxy network connection is busy") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*storagegateway.StorageGateway)
+}
+
+func (client *AWSClient) STSConn() *sts.STS {
+	return client.conn(STS, func(sess *session.Session) interface{} {
+		return sts.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[STS])}))
+	}).(*sts.STS)
+}
+
+func (client *AWSClient) SupportConn() *support.Support {
+	return client.conn(Support, func(sess *session.Session) interface{} {
+		return support.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Support])}))
+	}).(*support.Support)
+}
+
+func (client *AWSClient) SWFConn() *swf.SWF {
+	return client.conn(SWF, func(sess *session.Session) interface{} {
+		return swf.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[SWF])}))
+	}).(*swf.SWF)
+}
+
+func (client *AWSClient) SyntheticsConn() *synthetics.Synthetics {
+	return client.conn(Synthetics, func(sess *session.Session) interface{} {
+		return synthetics.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Synthetics])}))
+	}).(*synthetics.Synthetics)
+}
+
+func (client *AWSClient) TextractConn() *textract.Textract {
+	return client.conn(Textract, func(sess *session.Session) interface{} {
+		return textract.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Textract])}))
+	}).(*textract.Textract)
+}
+
+func (client *AWSClient) TimestreamQueryConn() *timestreamquery.TimestreamQuery {
+	return client.conn(TimestreamQuery, func(sess *session.Session) interface{} {
+		return timestreamquery.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[TimestreamQuery])}))
+	}).(*timestreamquery.TimestreamQuery)
+}
+
+func (client *AWSClient) TimestreamWriteConn() *timestreamwrite.TimestreamWrite {
+	return client.conn(TimestreamWrite, func(sess *session.Session) interface{} {
+		return timestreamwrite.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[TimestreamWrite])}))
+	}).(*timestreamwrite.TimestreamWrite)
+}
+
+func (client *AWSClient) TranscribeConn() *transcribeservice.TranscribeService {
+	return client.conn(Transcribe, func(sess *session.Session) interface{} {
+		return transcribeservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Transcribe])}))
+	}).(*transcribeservice.TranscribeService)
+}
+
+func (client *AWSClient) TranscribeStreamingConn() *transcribestreamingservice.TranscribeStreamingService {
+	return client.conn(TranscribeStreaming, func(sess *session.Session) interface{} {
+		return transcribestreamingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[TranscribeStreaming])}))
+	}).(*transcribestreamingservice.TranscribeStreamingService)
+}
+
+func (client *AWSClient) TransferConn() *transfer.Transfer {
+	return client.conn(Transfer, func(sess *session.Session) interface{} {
+		return transfer.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Transfer])}))
+	}).(*transfer.Transfer)
+}
+
+func (client *AWSClient) TranslateConn() *translate.Translate {
+	return client.conn(Translate, func(sess *session.Session) interface{} {
+		return translate.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[Translate])}))
+	}).(*translate.Translate)
+}
+
+func (client *AWSClient) WAFConn() *waf.WAF {
+	return client.conn(WAF, func(sess *session.Session) interface{} {
+		return waf.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[WAF])}))
+	}).(*waf.WAF)
+}
+
+func (client *AWSClient) WAFRegionalConn() *wafregional.WAFRegional {
+	return client.conn(WAFRegional, func(sess *session.Session) interface{} {
+		return wafregional.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[WAFRegional])}))
+	}).(*wafregional.WAFRegional)
+}
+
+func (client *AWSClient) WAFV2Conn() *wafv2.WAFV2 {
+	return client.conn(WAFV2, func(sess *session.Session) interface{} {
+		conn := wafv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[WAFV2])}))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFInternalErrorException, "Retry your request") {
+				r.Retryable = aws.Bool(true)
+			}
+
+			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFServiceLinkedRoleErrorException, "Retry") {
+				r.Retryable = aws.Bool(true)
+			}
+
+			if r.Operation.Name == "CreateIPSet" || r.Operation.Name == "CreateRegexPatternSet" ||
+				r.Operation.Name == "CreateRuleGroup" || r.Operation.Name == "CreateWebACL" {
+				// WAFv2 supports tag on create which can result in the below error codes according to the documentation
+				if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
+					r.Retryable = aws.Bool(true)
+				}
+				if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*wafv2.WAFV2)
+}
+
+func (client *AWSClient) WellArchitectedConn() *wellarchitected.WellArchitected {
+	return client.conn(WellArchitected, func(sess *session.Session) interface{} {
+		return wellarchitected.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[WellArchitected])}))
+	}).(*wellarchitected.WellArchitected)
+}
+
+func (client *AWSClient) WorkDocsConn() *workdocs.WorkDocs {
+	return client.conn(WorkDocs, func(sess *session.Session) interface{} {
+		return workdocs.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[WorkDocs])}))
+	}).(*workdocs.WorkDocs)
+}
+
+func (client *AWSClient) WorkLinkConn() *worklink.WorkLink {
+	return client.conn(WorkLink, func(sess *session.Session) interface{} {
+		return worklink.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[WorkLink])}))
+	}).(*worklink.WorkLink)
+}
+
+func (client *AWSClient) WorkMailConn() *workmail.WorkMail {
+	return client.conn(WorkMail, func(sess *session.Session) interface{} {
+		return workmail.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[WorkMail])}))
+	}).(*workmail.WorkMail)
+}
+
+func (client *AWSClient) WorkMailMessageFlowConn() *workmailmessageflow.WorkMailMessageFlow {
+	return client.conn(WorkMailMessageFlow, func(sess *session.Session) interface{} {
+		return workmailmessageflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[WorkMailMessageFlow])}))
+	}).(*workmailmessageflow.WorkMailMessageFlow)
+}
+
+func (client *AWSClient) WorkSpacesConn() *workspaces.WorkSpaces {
+	return client.conn(WorkSpaces, func(sess *session.Session) interface{} {
+		return workspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[WorkSpaces])}))
+	}).(*workspaces.WorkSpaces)
+}
+
+func (client *AWSClient) XRayConn() *xray.XRay {
+	return client.conn(XRay, func(sess *session.Session) interface{} {
+		return xray.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.endpoints[XRay])}))
+	}).(*xray.XRay)
+}
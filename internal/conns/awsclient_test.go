@@ -0,0 +1,159 @@
+package conns
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func testAWSClient(t *testing.T, c *Config) *AWSClient {
+	t.Helper()
+
+	c.AccessKey = "mock_access_key"
+	if c.Region == "" {
+		c.Region = "us-west-2" //lintignore:AWSAT003
+	}
+	c.SecretKey = "mock_secret_key"
+	c.SkipCredsValidation = true
+	c.SkipGetEC2Platforms = true
+	c.SkipMetadataApiCheck = true
+	c.SkipRequestingAccountId = true
+
+	raw, err := c.Client()
+
+	if err != nil {
+		t.Fatalf("error configuring client: %s", err)
+	}
+
+	return raw.(*AWSClient)
+}
+
+func TestAWSClientConnLazy(t *testing.T) {
+	client := testAWSClient(t, &Config{})
+
+	if got := len(client.conns); got != 0 {
+		t.Fatalf("got %d service clients after configuration, expected 0", got)
+	}
+
+	conn := client.SQSConn()
+
+	if got, want := len(client.conns), 1; got != want {
+		t.Errorf("got %d service clients, expected %d", got, want)
+	}
+
+	if client.SQSConn() != conn {
+		t.Error("expected cached service client")
+	}
+
+	var wg sync.WaitGroup
+	conns := make([]*ec2.EC2, 10)
+
+	for i := range conns {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			conns[i] = client.EC2Conn()
+		}(i)
+	}
+	wg.Wait()
+
+	for _, conn := range conns {
+		if conn != conns[0] {
+			t.Fatal("expected a single EC2 service client")
+		}
+	}
+}
+
+func TestAWSClientConnConfig(t *testing.T) {
+	client := testAWSClient(t, &Config{
+		Endpoints: map[string]string{
+			S3:  "http://localhost:4566",
+			SQS: "http://localhost:4576",
+		},
+		S3ForcePathStyle: true,
+	})
+
+	if got, want := client.SQSConn().Endpoint, "http://localhost:4576"; got != want {
+		t.Errorf("got SQS endpoint %s, expected %s", got, want)
+	}
+
+	s3Conn := client.S3Conn()
+
+	if got, want := s3Conn.Endpoint, "http://localhost:4566"; got != want {
+		t.Errorf("got S3 endpoint %s, expected %s", got, want)
+	}
+
+	if !aws.BoolValue(s3Conn.Config.S3ForcePathStyle) {
+		t.Error("expected S3 path-style addressing")
+	}
+
+	if aws.BoolValue(s3Conn.Config.DisableRestProtocolURICleaning) {
+		t.Error("expected S3 URI cleaning")
+	}
+
+	s3ConnURICleaningDisabled := client.S3ConnURICleaningDisabled()
+
+	if s3ConnURICleaningDisabled == s3Conn {
+		t.Fatal("expected separate S3 service client with URI cleaning disabled")
+	}
+
+	if !aws.BoolValue(s3ConnURICleaningDisabled.Config.S3ForcePathStyle) || !aws.BoolValue(s3ConnURICleaningDisabled.Config.DisableRestProtocolURICleaning) {
+		t.Error("expected S3 path-style addressing with URI cleaning disabled")
+	}
+
+	if got, want := aws.StringValue(client.Route53Conn().Config.Region), "us-east-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("got Route 53 region %s, expected %s", got, want)
+	}
+}
+
+func TestAWSClientConnGlobalRegion(t *testing.T) {
+	client := testAWSClient(t, &Config{
+		Region: "eu-west-1", //lintignore:AWSAT003
+	})
+
+	testCases := []struct {
+		Name           string
+		Region         *string
+		ExpectedRegion string
+	}{
+		{
+			Name:           "Global Accelerator",
+			Region:         client.GlobalAcceleratorConn().Config.Region,
+			ExpectedRegion: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name:           "Route 53",
+			Region:         client.Route53Conn().Config.Region,
+			ExpectedRegion: "us-east-1", //lintignore:AWSAT003
+		},
+		{
+			Name:           "Route 53 Recovery Control Config",
+			Region:         client.Route53RecoveryControlConfigConn().Config.Region,
+			ExpectedRegion: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name:           "Route 53 Recovery Readiness",
+			Region:         client.Route53RecoveryReadinessConn().Config.Region,
+			ExpectedRegion: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name:           "Shield",
+			Region:         client.ShieldConn().Config.Region,
+			ExpectedRegion: "us-east-1", //lintignore:AWSAT003
+		},
+		{
+			Name:           "SQS",
+			Region:         client.SQSConn().Config.Region,
+			ExpectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		if got := aws.StringValue(testCase.Region); got != testCase.ExpectedRegion {
+			t.Errorf("got %s region %s, expected %s", testCase.Name, got, testCase.ExpectedRegion)
+		}
+	}
+}
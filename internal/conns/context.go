@@ -0,0 +1,23 @@
+package conns
+
+import (
+	"context"
+)
+
+type contextKey int
+
+const (
+	resourceTypeNameContextKey contextKey = iota
+)
+
+// NewResourceContext returns a context that carries the Terraform type name of the resource being operated on.
+func NewResourceContext(ctx context.Context, typeName string) context.Context {
+	return context.WithValue(ctx, resourceTypeNameContextKey, typeName)
+}
+
+// ResourceTypeNameFromContext returns the Terraform type name of the resource being operated on, if known.
+func ResourceTypeNameFromContext(ctx context.Context) (string, bool) {
+	typeName, ok := ctx.Value(resourceTypeNameContextKey).(string)
+
+	return typeName, ok
+}
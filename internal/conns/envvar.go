@@ -72,6 +72,16 @@ const (
 	EnvVarAssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used for recording and replaying AWS API traffic
+const (
+	// The recorder mode, either "record" or "replay".
+	// Recording is disabled when empty.
+	EnvVarRecorderMode = "TF_AWS_RECORDER_MODE"
+
+	// The path to the cassette file the AWS API traffic is recorded to or replayed from
+	EnvVarRecorderCassette = "TF_AWS_RECORDER_CASSETTE"
+)
+
 // GetEnvVarWithDefault gets an environment variable value if non-empty or returns the default.
 func GetEnvVarWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -0,0 +1,186 @@
+package conns
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	rateLimitAcquireHandlerName = "terraform-provider-aws.RateLimitAcquire"
+	rateLimitReleaseHandlerName = "terraform-provider-aws.RateLimitRelease"
+)
+
+// RateLimitConfig configures client-side throttling of API requests to a single service.
+type RateLimitConfig struct {
+	// RequestsPerSecond is the sustained request rate. Zero means unlimited.
+	RequestsPerSecond float64
+	// Burst is the maximum number of requests that may be sent at once.
+	// Defaults to RequestsPerSecond rounded up.
+	Burst int
+	// MaxConcurrentRequests is the maximum number of requests in flight. Zero means unlimited.
+	MaxConcurrentRequests int
+}
+
+// tokenBucket is a token bucket rate limiter.
+// The bucket holds at most burst tokens and is refilled at rate tokens per second.
+type tokenBucket struct {
+	burst  float64
+	last   time.Time
+	mutex  sync.Mutex
+	now    func() time.Time
+	rate   float64
+	tokens float64
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst <= 0 {
+		burst = int(math.Ceil(rate))
+	}
+
+	b := &tokenBucket{
+		burst: float64(burst),
+		now:   time.Now,
+		rate:  rate,
+	}
+	b.tokens = b.burst
+	b.last = b.now()
+
+	return b
+}
+
+// reserve takes a token from the bucket and returns how long the caller must wait before using it.
+// Tokens may be borrowed from the future, so concurrent callers are queued fairly.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	now := b.now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// serviceRateLimiter enforces a RateLimitConfig for all clients of a service.
+type serviceRateLimiter struct {
+	acquired  sync.Map // requests holding a concurrency slot
+	bucket    *tokenBucket
+	semaphore chan struct{}
+	service   string
+}
+
+func newServiceRateLimiter(service string, config *RateLimitConfig) *serviceRateLimiter {
+	l := &serviceRateLimiter{
+		service: service,
+	}
+
+	if config.RequestsPerSecond > 0 {
+		l.bucket = newTokenBucket(config.RequestsPerSecond, config.Burst)
+	}
+
+	if config.MaxConcurrentRequests > 0 {
+		l.semaphore = make(chan struct{}, config.MaxConcurrentRequests)
+	}
+
+	return l
+}
+
+// acquire blocks until the request may be sent or its context is done.
+// The rate limit token is taken before the concurrency slot, so a slot is not held while waiting for a token.
+func (l *serviceRateLimiter) acquire(r *request.Request) {
+	if l.bucket != nil {
+		if delay := l.bucket.reserve(); delay > 0 {
+			log.Printf("[DEBUG] Delaying %s %s request by %s due to rate_limits configuration", l.service, r.Operation.Name, delay)
+
+			if err := aws.SleepWithContext(r.Context(), delay); err != nil {
+				r.Error = err
+				return
+			}
+		}
+	}
+
+	if l.semaphore == nil {
+		return
+	}
+
+	select {
+	case l.semaphore <- struct{}{}:
+		l.acquired.Store(r, struct{}{})
+	case <-r.Context().Done():
+		r.Error = awserr.New(request.CanceledErrorCode, "request context canceled while waiting for rate_limits concurrency", r.Context().Err())
+	}
+}
+
+// release marks the request as no longer in flight.
+func (l *serviceRateLimiter) release(r *request.Request) {
+	if _, ok := l.acquired.LoadAndDelete(r); ok {
+		<-l.semaphore
+	}
+}
+
+// RateLimiters enforces per-service rate limits and concurrency caps on AWS API requests.
+// Limits are shared by all requests to a service made through the handlers it is added to.
+type RateLimiters struct {
+	limiters map[string]*serviceRateLimiter // keyed by AWS SDK service ID
+}
+
+// NewRateLimiters returns rate limiters for the specified services, keyed by service key (e.g. conns.EC2).
+func NewRateLimiters(configs map[string]*RateLimitConfig) (*RateLimiters, error) {
+	limiters := &RateLimiters{
+		limiters: make(map[string]*serviceRateLimiter),
+	}
+
+	for service, config := range configs {
+		sd, ok := serviceData[service]
+
+		if !ok {
+			return nil, fmt.Errorf("no service data found for %s", service)
+		}
+
+		if config.RequestsPerSecond < 0 {
+			return nil, fmt.Errorf("rate limit requests per second for %s must not be negative", service)
+		}
+
+		limiters.limiters[sd.AWSServiceID] = newServiceRateLimiter(service, config)
+	}
+
+	return limiters, nil
+}
+
+// AddHandlers adds the request handlers enforcing rate limits.
+// The concurrency slot is held only while each request attempt is being sent,
+// so retries and their backoff delays are subject to the limits too.
+func (l *RateLimiters) AddHandlers(handlers *request.Handlers) {
+	if len(l.limiters) == 0 {
+		return
+	}
+
+	handlers.Send.PushFrontNamed(request.NamedHandler{
+		Name: rateLimitAcquireHandlerName,
+		Fn: func(r *request.Request) {
+			if limiter, ok := l.limiters[r.ClientInfo.ServiceID]; ok {
+				limiter.acquire(r)
+			}
+		},
+	})
+	handlers.Send.PushBackNamed(request.NamedHandler{
+		Name: rateLimitReleaseHandlerName,
+		Fn: func(r *request.Request) {
+			if limiter, ok := l.limiters[r.ClientInfo.ServiceID]; ok {
+				limiter.release(r)
+			}
+		},
+	})
+}
@@ -0,0 +1,194 @@
+package conns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+func TestTokenBucketReserve(t *testing.T) {
+	now := time.Date(2021, time.December, 1, 0, 0, 0, 0, time.UTC)
+	b := newTokenBucket(2, 3)
+	b.now = func() time.Time { return now }
+	b.last = now
+
+	testCases := []struct {
+		Name     string
+		Advance  time.Duration
+		Expected time.Duration
+	}{
+		{Name: "burst 1", Expected: 0},
+		{Name: "burst 2", Expected: 0},
+		{Name: "burst 3", Expected: 0},
+		{Name: "empty", Expected: 500 * time.Millisecond},
+		{Name: "queued", Expected: time.Second},
+		{Name: "refilled", Advance: 2 * time.Second, Expected: 0},
+		{Name: "capped at burst", Advance: time.Hour, Expected: 0},
+		{Name: "capped at burst 2", Expected: 0},
+		{Name: "capped at burst 3", Expected: 0},
+		{Name: "capped at burst empty", Expected: 500 * time.Millisecond},
+	}
+
+	for _, testCase := range testCases {
+		now = now.Add(testCase.Advance)
+
+		if got, want := b.reserve(), testCase.Expected; got != want {
+			t.Errorf("%s: got delay %s, expected %s", testCase.Name, got, want)
+		}
+	}
+}
+
+func TestTokenBucketDefaultBurst(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Rate     float64
+		Burst    int
+		Expected float64
+	}{
+		{Name: "explicit", Rate: 10, Burst: 20, Expected: 20},
+		{Name: "default", Rate: 10, Expected: 10},
+		{Name: "default fractional", Rate: 0.5, Expected: 1},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := newTokenBucket(testCase.Rate, testCase.Burst).burst, testCase.Expected; got != want {
+			t.Errorf("%s: got burst %v, expected %v", testCase.Name, got, want)
+		}
+	}
+}
+
+func TestNewRateLimitersInvalid(t *testing.T) {
+	if _, err := NewRateLimiters(map[string]*RateLimitConfig{"nosuchservice": {RequestsPerSecond: 1}}); err == nil {
+		t.Error("expected error for unknown service")
+	}
+
+	if _, err := NewRateLimiters(map[string]*RateLimitConfig{SQS: {RequestsPerSecond: -1}}); err == nil {
+		t.Error("expected error for negative rate")
+	}
+}
+
+func TestRateLimitersAddHandlers(t *testing.T) {
+	var inFlight, maxInFlight, requests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+
+		atomic.AddInt32(&requests, 1)
+		time.Sleep(20 * time.Millisecond)
+
+		w.Header().Set("Content-Type", "text/xml")
+		w.Write([]byte(`<ListQueuesResponse><ListQueuesResult></ListQueuesResult></ListQueuesResponse>`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("mock_access_key", "mock_secret_key", ""),
+		Endpoint:    aws.String(server.URL),
+		Region:      aws.String("us-east-1"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	limiters, err := NewRateLimiters(map[string]*RateLimitConfig{
+		SQS: {
+			RequestsPerSecond:     20,
+			Burst:                 5,
+			MaxConcurrentRequests: 2,
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("error creating rate limiters: %s", err)
+	}
+
+	limiters.AddHandlers(&sess.Handlers)
+
+	conn := sqs.New(sess)
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, err := conn.ListQueues(&sqs.ListQueuesInput{}); err != nil {
+				t.Errorf("error listing queues: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := atomic.LoadInt32(&requests), int32(10); got != want {
+		t.Errorf("got %d requests, expected %d", got, want)
+	}
+
+	if got, want := atomic.LoadInt32(&maxInFlight), int32(2); got > want {
+		t.Errorf("got %d concurrent requests, expected at most %d", got, want)
+	}
+
+	// 5 requests are allowed immediately by the burst and the remaining 5 at 20 per second.
+	if got, want := time.Since(start), 250*time.Millisecond; got < want {
+		t.Errorf("requests completed in %s, expected at least %s", got, want)
+	}
+}
+
+func TestServiceRateLimiterAcquireCanceled(t *testing.T) {
+	limiter := newServiceRateLimiter(SQS, &RateLimitConfig{
+		MaxConcurrentRequests: 1,
+	})
+	limiter.semaphore <- struct{}{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	r := &request.Request{
+		HTTPRequest: &http.Request{},
+		Operation:   &request.Operation{Name: "ListQueues"},
+	}
+	r.SetContext(ctx)
+
+	done := make(chan struct{})
+	go func() {
+		limiter.acquire(r)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("acquire did not return when the request context was canceled")
+	}
+
+	if !tfawserr.ErrCodeEquals(r.Error, request.CanceledErrorCode) {
+		t.Errorf("expected %s error, got %v", request.CanceledErrorCode, r.Error)
+	}
+
+	// The request did not get a slot, so releasing it must not free the slot held by another request.
+	limiter.release(r)
+
+	if got, want := len(limiter.semaphore), 1; got != want {
+		t.Errorf("got %d slots in use, expected %d", got, want)
+	}
+}
@@ -0,0 +1,456 @@
+package conns
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/corehandlers"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// RecorderModeRecord sends requests to AWS and captures every request/response pair into the cassette.
+	RecorderModeRecord = "record"
+
+	// RecorderModeReplay serves responses from the cassette without sending any request to AWS.
+	RecorderModeReplay = "replay"
+)
+
+const (
+	// ErrCodeRecorderInteractionNotFound is returned in replay mode when no recorded interaction matches a request.
+	ErrCodeRecorderInteractionNotFound = "RecorderInteractionNotFound"
+
+	recorderSendHandlerName = "terraform-provider-aws.RecorderSendHandler"
+)
+
+// volatileRequestFields are request body fields whose values differ between runs
+// (e.g. SDK auto-filled idempotency tokens) and are removed before matching.
+var volatileRequestFields = []string{
+	"ClientRequestToken",
+	"ClientToken",
+	"IdempotencyToken",
+}
+
+// Interaction is a single recorded AWS API request/response pair.
+type Interaction struct {
+	ServiceID      string      `json:"service_id"`
+	Operation      string      `json:"operation"`
+	Method         string      `json:"method"`
+	Path           string      `json:"path"`
+	RequestBody    string      `json:"request_body"`
+	StatusCode     int         `json:"status_code"`
+	ResponseHeader http.Header `json:"response_header"`
+	ResponseBody   string      `json:"response_body"`
+}
+
+// Cassette is recorded AWS API traffic.
+type Cassette struct {
+	AccountID    string         `json:"account_id"`
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// cassetteRecord is a single line of a cassette file.
+// Cassette files are appended to as traffic is recorded, so each line holds either the account ID or one interaction.
+type cassetteRecord struct {
+	AccountID   string       `json:"account_id,omitempty"`
+	Interaction *Interaction `json:"interaction,omitempty"`
+}
+
+// Recorder captures AWS API traffic into, or replays it from, a cassette file.
+type Recorder struct {
+	Mode string
+	Path string
+
+	cassette *Cassette
+	file     *os.File
+	mutex    sync.Mutex
+	used     []bool
+}
+
+var (
+	recorders      = make(map[string]*Recorder)
+	recordersMutex sync.Mutex
+)
+
+// RecorderFromEnv returns the Recorder configured via the TF_AWS_RECORDER_MODE and
+// TF_AWS_RECORDER_CASSETTE environment variables or nil if recording is not enabled.
+// Provider instances configured with the same cassette share a single Recorder.
+func RecorderFromEnv() (*Recorder, error) {
+	mode := os.Getenv(EnvVarRecorderMode)
+
+	if mode == "" {
+		return nil, nil
+	}
+
+	path, err := RequireEnvVar(EnvVarRecorderCassette, "path to the AWS API traffic cassette file")
+
+	if err != nil {
+		return nil, err
+	}
+
+	recordersMutex.Lock()
+	defer recordersMutex.Unlock()
+
+	if recorder, ok := recorders[path]; ok {
+		if recorder.Mode != mode {
+			return nil, fmt.Errorf("cassette (%s) already opened in %s mode", path, recorder.Mode)
+		}
+
+		return recorder, nil
+	}
+
+	recorder, err := NewRecorder(mode, path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	recorders[path] = recorder
+
+	return recorder, nil
+}
+
+// NewRecorder returns a new Recorder for the specified mode and cassette file.
+// In replay mode the cassette file must already exist.
+func NewRecorder(mode, path string) (*Recorder, error) {
+	recorder := &Recorder{
+		Mode:     mode,
+		Path:     path,
+		cassette: &Cassette{},
+	}
+
+	switch mode {
+	case RecorderModeRecord:
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return nil, fmt.Errorf("error creating cassette (%s): %w", path, err)
+		}
+
+		file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+
+		if err != nil {
+			return nil, fmt.Errorf("error creating cassette (%s): %w", path, err)
+		}
+
+		recorder.file = file
+	case RecorderModeReplay:
+		cassette, err := readCassette(path)
+
+		if err != nil {
+			return nil, err
+		}
+
+		recorder.cassette = cassette
+		recorder.used = make([]bool, len(recorder.cassette.Interactions))
+	default:
+		return nil, fmt.Errorf("unsupported recorder mode (%s), expected %s or %s", mode, RecorderModeRecord, RecorderModeReplay)
+	}
+
+	return recorder, nil
+}
+
+// AccountID returns the AWS account ID stored in the cassette.
+func (rec *Recorder) AccountID() string {
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	return rec.cassette.AccountID
+}
+
+// SetAccountID stores the AWS account ID in the cassette so that it is available during replay.
+func (rec *Recorder) SetAccountID(accountID string) error {
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	if rec.cassette.AccountID != "" {
+		return nil
+	}
+
+	rec.cassette.AccountID = accountID
+
+	return rec.write(&cassetteRecord{AccountID: accountID})
+}
+
+// Close closes the cassette file.
+func (rec *Recorder) Close() error {
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	if rec.file == nil {
+		return nil
+	}
+
+	err := rec.file.Close()
+	rec.file = nil
+
+	return err
+}
+
+// AddHandlers replaces the default send handler with one that records or replays AWS API traffic.
+func (rec *Recorder) AddHandlers(handlers *request.Handlers) {
+	var fn func(*request.Request)
+
+	switch rec.Mode {
+	case RecorderModeRecord:
+		fn = rec.record
+	case RecorderModeReplay:
+		fn = rec.replay
+	}
+
+	handlers.Send.Swap(corehandlers.SendHandler.Name, request.NamedHandler{Name: recorderSendHandlerName, Fn: fn})
+}
+
+func (rec *Recorder) record(r *request.Request) {
+	body, err := requestBody(r)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to read %s request body for recording: %s", r.Operation.Name, err)
+	}
+
+	corehandlers.SendHandler.Fn(r)
+
+	if r.HTTPResponse == nil {
+		return
+	}
+
+	interaction := newInteraction(r, body)
+	interaction.StatusCode = r.HTTPResponse.StatusCode
+	interaction.ResponseHeader = r.HTTPResponse.Header
+
+	if r.HTTPResponse.Body != nil {
+		b, err := ioutil.ReadAll(r.HTTPResponse.Body)
+		r.HTTPResponse.Body.Close()
+
+		if err != nil {
+			r.Error = awserr.New(request.ErrCodeSerialization, "failed to read response body for recording", err)
+
+			return
+		}
+
+		interaction.ResponseBody = string(b)
+		r.HTTPResponse.Body = ioutil.NopCloser(bytes.NewReader(b))
+	}
+
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	rec.cassette.Interactions = append(rec.cassette.Interactions, interaction)
+
+	if err := rec.write(&cassetteRecord{Interaction: interaction}); err != nil {
+		log.Printf("[WARN] Unable to write cassette (%s): %s", rec.Path, err)
+	}
+}
+
+func (rec *Recorder) replay(r *request.Request) {
+	body, err := requestBody(r)
+
+	if err != nil {
+		r.Error = awserr.New(request.ErrCodeRequestError, "failed to read request body for replay", err)
+		r.Retryable = aws.Bool(false)
+
+		return
+	}
+
+	interaction := rec.match(newInteraction(r, body))
+
+	if interaction == nil {
+		r.Error = awserr.New(ErrCodeRecorderInteractionNotFound, fmt.Sprintf("no recorded interaction for %s %s in cassette (%s)", r.ClientInfo.ServiceID, r.Operation.Name, rec.Path), nil)
+		r.Retryable = aws.Bool(false)
+
+		return
+	}
+
+	header := http.Header{}
+	for k, v := range interaction.ResponseHeader {
+		header[k] = append([]string(nil), v...)
+	}
+
+	r.HTTPResponse = &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.StatusCode, http.StatusText(interaction.StatusCode)),
+		StatusCode:    interaction.StatusCode,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(interaction.ResponseBody)),
+		ContentLength: int64(len(interaction.ResponseBody)),
+		Request:       r.HTTPRequest,
+	}
+}
+
+// match returns the first unused interaction matching the request.
+// Once all matching interactions have been used, the last one is returned again
+// so that polling requests (e.g. waiters) stay deterministic.
+func (rec *Recorder) match(want *Interaction) *Interaction {
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	var last *Interaction
+
+	for i, got := range rec.cassette.Interactions {
+		if got.ServiceID != want.ServiceID || got.Operation != want.Operation || got.Method != want.Method || got.Path != want.Path || got.RequestBody != want.RequestBody {
+			continue
+		}
+
+		if !rec.used[i] {
+			rec.used[i] = true
+
+			return got
+		}
+
+		last = got
+	}
+
+	return last
+}
+
+// write appends a record to the cassette file, so that an interrupted run keeps everything recorded so far.
+// The caller must hold the mutex.
+func (rec *Recorder) write(record *cassetteRecord) error {
+	if rec.file == nil {
+		return nil
+	}
+
+	b, err := json.Marshal(record)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = rec.file.Write(append(b, '\n'))
+
+	return err
+}
+
+// readCassette reads a cassette file.
+// A truncated final record, left by an interrupted recording, is ignored.
+func readCassette(path string) (*Cassette, error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading cassette (%s): %w", path, err)
+	}
+
+	defer file.Close()
+
+	cassette := &Cassette{}
+	decoder := json.NewDecoder(file)
+
+	for {
+		var record cassetteRecord
+
+		err := decoder.Decode(&record)
+
+		if err == io.EOF {
+			break
+		}
+
+		if err == io.ErrUnexpectedEOF {
+			log.Printf("[WARN] Ignoring truncated record at end of cassette (%s)", path)
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing cassette (%s): %w", path, err)
+		}
+
+		if record.AccountID != "" {
+			cassette.AccountID = record.AccountID
+		}
+
+		if record.Interaction != nil {
+			cassette.Interactions = append(cassette.Interactions, record.Interaction)
+		}
+	}
+
+	return cassette, nil
+}
+
+func newInteraction(r *request.Request, body []byte) *Interaction {
+	interaction := &Interaction{
+		ServiceID:   r.ClientInfo.ServiceID,
+		Operation:   r.Operation.Name,
+		Method:      r.HTTPRequest.Method,
+		RequestBody: normalizeRequestBody(r.HTTPRequest.Header.Get("Content-Type"), body),
+	}
+
+	if u := r.HTTPRequest.URL; u != nil {
+		interaction.Path = u.EscapedPath()
+
+		if u.RawQuery != "" {
+			interaction.Path += "?" + u.Query().Encode()
+		}
+	}
+
+	return interaction
+}
+
+// requestBody returns the request body, leaving the body positioned for sending.
+func requestBody(r *request.Request) ([]byte, error) {
+	if r.Body == nil {
+		return nil, nil
+	}
+
+	if _, err := r.Body.Seek(r.BodyStart, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	b, err := ioutil.ReadAll(r.Body)
+
+	if _, err := r.Body.Seek(r.BodyStart, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	return b, err
+}
+
+// normalizeRequestBody returns a canonical form of JSON and query protocol request bodies
+// so that field ordering and volatile fields do not affect matching.
+func normalizeRequestBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	switch {
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		values, err := url.ParseQuery(string(body))
+
+		if err != nil {
+			break
+		}
+
+		for _, field := range volatileRequestFields {
+			values.Del(field)
+		}
+
+		return values.Encode()
+	case strings.Contains(contentType, "json"):
+		var v map[string]interface{}
+
+		if err := json.Unmarshal(body, &v); err != nil {
+			break
+		}
+
+		for _, field := range volatileRequestFields {
+			delete(v, field)
+		}
+
+		b, err := json.Marshal(v)
+
+		if err != nil {
+			break
+		}
+
+		return string(b)
+	}
+
+	return string(body)
+}
@@ -0,0 +1,168 @@
+package conns
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		r.ParseForm()
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintf(w, `<GetQueueUrlResponse><GetQueueUrlResult><QueueUrl>https://queue.amazonaws.com/123456789012/%s</QueueUrl></GetQueueUrlResult></GetQueueUrlResponse>`, r.Form.Get("QueueName"))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	newConn := func(recorder *Recorder) *sqs.SQS {
+		sess := session.Must(session.NewSession(&aws.Config{
+			Credentials: credentials.NewStaticCredentials("mock_access_key", "mock_secret_key", ""),
+			Endpoint:    aws.String(server.URL),
+			MaxRetries:  aws.Int(0),
+			Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+		}))
+		recorder.AddHandlers(&sess.Handlers)
+
+		return sqs.New(sess)
+	}
+
+	recorder, err := NewRecorder(RecorderModeRecord, path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := recorder.SetAccountID("123456789012"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	conn := newConn(recorder)
+	for _, name := range []string{"queue1", "queue2"} {
+		if _, err := conn.GetQueueUrl(&sqs.GetQueueUrlInput{QueueName: aws.String(name)}); err != nil {
+			t.Fatalf("unexpected error recording %s: %s", name, err)
+		}
+	}
+
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, got %d", requests)
+	}
+
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	recorder, err = NewRecorder(RecorderModeReplay, path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := recorder.AccountID(), "123456789012"; got != expected {
+		t.Errorf("got account ID %s, expected %s", got, expected)
+	}
+
+	conn = newConn(recorder)
+	for _, name := range []string{"queue2", "queue1", "queue1"} {
+		output, err := conn.GetQueueUrl(&sqs.GetQueueUrlInput{QueueName: aws.String(name)})
+
+		if err != nil {
+			t.Fatalf("unexpected error replaying %s: %s", name, err)
+		}
+
+		if got, expected := aws.StringValue(output.QueueUrl), "https://queue.amazonaws.com/123456789012/"+name; got != expected {
+			t.Errorf("got %s, expected %s", got, expected)
+		}
+	}
+
+	if requests != 2 {
+		t.Errorf("expected no requests during replay, got %d", requests-2)
+	}
+
+	_, err = conn.GetQueueUrl(&sqs.GetQueueUrlInput{QueueName: aws.String("queue3")})
+
+	if !tfawserr.ErrCodeEquals(err, ErrCodeRecorderInteractionNotFound) {
+		t.Errorf("expected %s error, got %v", ErrCodeRecorderInteractionNotFound, err)
+	}
+}
+
+func TestReadCassetteTruncated(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	content := `{"account_id":"123456789012"}
+{"interaction":{"service_id":"SQS","operation":"ListQueues","method":"POST","path":"/","request_body":"","status_code":200,"response_header":null,"response_body":""}}
+{"interaction":{"service_id":"SQS","operation":"GetQu`
+
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cassette, err := readCassette(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := cassette.AccountID, "123456789012"; got != expected {
+		t.Errorf("got account ID %s, expected %s", got, expected)
+	}
+
+	if got, expected := len(cassette.Interactions), 1; got != expected {
+		t.Fatalf("got %d interactions, expected %d", got, expected)
+	}
+
+	if got, expected := cassette.Interactions[0].Operation, "ListQueues"; got != expected {
+		t.Errorf("got operation %s, expected %s", got, expected)
+	}
+}
+
+func TestNormalizeRequestBody(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		ContentType string
+		Body        string
+		Expected    string
+	}{
+		{
+			Name:     "empty",
+			Expected: "",
+		},
+		{
+			Name:        "query",
+			ContentType: "application/x-www-form-urlencoded; charset=utf-8",
+			Body:        "Version=2012-11-05&Action=GetQueueUrl&QueueName=test",
+			Expected:    "Action=GetQueueUrl&QueueName=test&Version=2012-11-05",
+		},
+		{
+			Name:        "json",
+			ContentType: "application/x-amz-json-1.0",
+			Body:        `{"TableName":"test","ClientRequestToken":"abc","AttributeDefinitions":[]}`,
+			Expected:    `{"AttributeDefinitions":[],"TableName":"test"}`,
+		},
+		{
+			Name:        "xml",
+			ContentType: "application/xml",
+			Body:        "<Test/>",
+			Expected:    "<Test/>",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := normalizeRequestBody(testCase.ContentType, []byte(testCase.Body))
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package conns
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+// regionalClients lazily creates and caches the AWSClients for each region used by a provider configuration.
+// All clients share the provider's credentials, endpoints and request handlers.
+type regionalClients struct {
+	clients map[string]*AWSClient
+	config  *Config
+	mutex   sync.Mutex
+	session *session.Session
+}
+
+func newRegionalClients(c *Config, sess *session.Session, client *AWSClient) *regionalClients {
+	return &regionalClients{
+		clients: map[string]*AWSClient{client.Region: client},
+		config:  c,
+		session: sess,
+	}
+}
+
+// get returns the AWSClient for the specified region, creating it on first use.
+func (rc *regionalClients) get(region string, provider *AWSClient) (*AWSClient, error) {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+
+	if client, ok := rc.clients[region]; ok {
+		return client, nil
+	}
+
+	if !rc.config.SkipRegionValidation {
+		if err := awsbase.ValidateRegion(region); err != nil {
+			return nil, err
+		}
+	}
+
+	// Credentials are only valid within a single partition.
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok && p.ID() != provider.Partition {
+		return nil, fmt.Errorf("region (%s) is in partition (%s), expected partition (%s)", region, p.ID(), provider.Partition)
+	}
+
+	log.Printf("[DEBUG] Creating AWS client for region: %s", region)
+
+	client := rc.config.newClient(rc.session.Copy(&aws.Config{Region: aws.String(region)}), provider.AccountID, provider.Partition, provider.AssumedRoleARNs)
+	client.regionalClients = rc
+	rc.clients[region] = client
+
+	return client, nil
+}
+
+// RegionalClient returns an AWSClient for the specified region that shares this client's
+// credentials and configuration. Clients for other regions are created on first use and cached.
+// An empty region returns this client.
+func (client *AWSClient) RegionalClient(region string) (*AWSClient, error) {
+	if region == "" || region == client.Region {
+		return client, nil
+	}
+
+	if client.regionalClients == nil {
+		return nil, fmt.Errorf("AWS client for region (%s) is not available", region)
+	}
+
+	return client.regionalClients.get(region, client)
+}
@@ -0,0 +1,65 @@
+package conns
+
+import (
+	"testing"
+)
+
+func TestAWSClientRegionalClient(t *testing.T) {
+	c := &Config{
+		AccessKey:               "mock_access_key",
+		Region:                  "us-east-1", //lintignore:AWSAT003
+		SecretKey:               "mock_secret_key",
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipMetadataApiCheck:    true,
+		SkipRequestingAccountId: true,
+	}
+
+	raw, err := c.Client()
+
+	if err != nil {
+		t.Fatalf("error configuring client: %s", err)
+	}
+
+	client := raw.(*AWSClient)
+
+	for _, region := range []string{"", client.Region} {
+		if got, err := client.RegionalClient(region); err != nil || got != client {
+			t.Errorf("region %q: expected provider client, got %p, %v", region, got, err)
+		}
+	}
+
+	westClient, err := client.RegionalClient("us-west-2") //lintignore:AWSAT003
+
+	if err != nil {
+		t.Fatalf("error getting regional client: %s", err)
+	}
+
+	if got, want := westClient.Region, "us-west-2"; got != want { //lintignore:AWSAT003
+		t.Errorf("got region %s, expected %s", got, want)
+	}
+
+	if got, want := *westClient.SQSConn().Config.Region, "us-west-2"; got != want { //lintignore:AWSAT003
+		t.Errorf("got SQS client region %s, expected %s", got, want)
+	}
+
+	if got, want := westClient.DNSSuffix, client.DNSSuffix; got != want {
+		t.Errorf("got DNS suffix %s, expected %s", got, want)
+	}
+
+	if got, _ := client.RegionalClient("us-west-2"); got != westClient { //lintignore:AWSAT003
+		t.Error("expected cached regional client")
+	}
+
+	if got, _ := westClient.RegionalClient(client.Region); got != client {
+		t.Error("expected provider client from regional client")
+	}
+
+	if _, err := client.RegionalClient("cn-north-1"); err == nil { //lintignore:AWSAT003
+		t.Error("expected error for region in another partition")
+	}
+
+	if _, err := client.RegionalClient("nosuchregion"); err == nil {
+		t.Error("expected error for invalid region")
+	}
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/docscheck"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+const (
+	defaultAllowlist = "website/docscheck-allowlist.txt"
+	defaultDocsDir   = "website/docs/r"
+)
+
+var (
+	allowlistFile  = flag.String("allowlist", defaultAllowlist, "file of known issues that are not reported")
+	docsDir        = flag.String("docs", defaultDocsDir, "resource documentation directory")
+	writeAllowlist = flag.Bool("write-allowlist", false, "write all current issues to the allowlist file instead of reporting them")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tgo run ./internal/docscheck/cmd [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	resources := provider.Provider().ResourcesMap
+
+	if *writeAllowlist {
+		issues, err := docscheck.Check(resources, *docsDir, nil)
+
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err := writeIssues(*allowlistFile, issues); err != nil {
+			log.Fatal(err)
+		}
+
+		log.Printf("wrote %d issues to %s", len(issues), *allowlistFile)
+
+		return
+	}
+
+	allowlist, err := docscheck.ReadAllowlist(*allowlistFile)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	issues, err := docscheck.Check(resources, *docsDir, allowlist)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, issue := range issues {
+		log.Print(issue)
+	}
+
+	if len(issues) > 0 {
+		log.Fatalf("\n%d resource documentation issues found. Fix the documentation or schema, or add known exceptions to %s.", len(issues), *allowlistFile)
+	}
+}
+
+func writeIssues(filename string, issues []docscheck.Issue) error {
+	var keys []string
+
+	for _, issue := range issues {
+		keys = append(keys, issue.Key())
+	}
+
+	sort.Strings(keys)
+
+	f, err := os.Create(filename)
+
+	if err != nil {
+		return fmt.Errorf("error creating allowlist: %w", err)
+	}
+
+	defer f.Close()
+
+	fmt.Fprintln(f, "# Known differences between resource schemas and website/docs/r, checked by `make docscheck`.")
+	fmt.Fprintln(f, "# Each line is \"<resource type> <name> <kind>\". <name> and <kind> may be \"*\".")
+
+	for _, key := range keys {
+		fmt.Fprintln(f, key)
+	}
+
+	return nil
+}
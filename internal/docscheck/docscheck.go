@@ -0,0 +1,506 @@
+// Package docscheck cross-checks resource schemas against the resource documentation
+// under website/docs/r.
+package docscheck
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// IssueMissingPage indicates that a resource has no documentation page.
+	IssueMissingPage = "missing-page"
+	// IssueMissingArgument indicates that an argument is not documented.
+	IssueMissingArgument = "missing-argument"
+	// IssueMissingAttribute indicates that a computed-only attribute is not documented.
+	IssueMissingAttribute = "missing-attribute"
+	// IssueExtra indicates that a documented name is not in the schema.
+	IssueExtra = "extra"
+	// IssueRequired indicates that an argument is documented as Optional but is Required in the schema.
+	IssueRequired = "required"
+	// IssueOptional indicates that an argument is documented as Required but is Optional in the schema.
+	IssueOptional = "optional"
+)
+
+// ProviderAttributes are the names of the top-level arguments added to every resource by the provider,
+// e.g. the per-resource region override. They are documented once on the provider page and are only
+// checked if a resource page documents them as arguments.
+var ProviderAttributes = []string{
+	"region",
+}
+
+// implicitAttributes are documented but never declared in a resource schema.
+var implicitAttributes = []string{
+	"id",
+}
+
+var timeoutNames = []string{
+	schema.TimeoutCreate,
+	schema.TimeoutRead,
+	schema.TimeoutUpdate,
+	schema.TimeoutDelete,
+	schema.TimeoutDefault,
+}
+
+// Issue is a difference between a resource schema and its documentation.
+type Issue struct {
+	TypeName string
+	Name     string
+	Kind     string
+	Path     string
+}
+
+// Key returns the issue's allowlist entry.
+func (i Issue) Key() string {
+	name := i.Name
+
+	if name == "" {
+		name = "*"
+	}
+
+	return fmt.Sprintf("%s %s %s", i.TypeName, name, i.Kind)
+}
+
+func (i Issue) String() string {
+	switch i.Kind {
+	case IssueMissingPage:
+		return fmt.Sprintf("%s: documentation page %s not found", i.TypeName, i.Path)
+	case IssueMissingArgument:
+		return fmt.Sprintf("%s: argument %q not documented in %s", i.TypeName, i.Name, i.Path)
+	case IssueMissingAttribute:
+		return fmt.Sprintf("%s: attribute %q not documented in %s", i.TypeName, i.Name, i.Path)
+	case IssueExtra:
+		return fmt.Sprintf("%s: %q documented in %s but not in schema", i.TypeName, i.Name, i.Path)
+	case IssueRequired:
+		return fmt.Sprintf("%s: argument %q is Required but documented as Optional in %s", i.TypeName, i.Name, i.Path)
+	case IssueOptional:
+		return fmt.Sprintf("%s: argument %q is Optional but documented as Required in %s", i.TypeName, i.Name, i.Path)
+	}
+
+	return i.Key()
+}
+
+// Allowlist is a set of known issues that are not reported.
+//
+// Each non-empty line of an allowlist file is "<resource type> <name> <kind>", where
+// <name> and <kind> may be "*" to match any value. Lines beginning with "#" are comments.
+type Allowlist struct {
+	entries map[string]struct{}
+}
+
+// NewAllowlist returns an empty Allowlist.
+func NewAllowlist() *Allowlist {
+	return &Allowlist{
+		entries: make(map[string]struct{}),
+	}
+}
+
+// ReadAllowlist reads an Allowlist from the named file.
+func ReadAllowlist(filename string) (*Allowlist, error) {
+	f, err := os.Open(filename)
+
+	if err != nil {
+		return nil, fmt.Errorf("error opening allowlist: %w", err)
+	}
+
+	defer f.Close()
+
+	allowlist, err := ParseAllowlist(f)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading allowlist (%s): %w", filename, err)
+	}
+
+	return allowlist, nil
+}
+
+// ParseAllowlist parses an Allowlist.
+func ParseAllowlist(r io.Reader) (*Allowlist, error) {
+	allowlist := NewAllowlist()
+	scanner := bufio.NewScanner(r)
+
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("line %d: expected \"<resource type> <name> <kind>\", got %q", n, line)
+		}
+
+		allowlist.entries[strings.Join(fields, " ")] = struct{}{}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return allowlist, nil
+}
+
+// Allows returns whether the issue is in the allowlist.
+func (a *Allowlist) Allows(issue Issue) bool {
+	if a == nil {
+		return false
+	}
+
+	name := issue.Name
+
+	if name == "" {
+		name = "*"
+	}
+
+	for _, key := range []string{
+		fmt.Sprintf("%s %s %s", issue.TypeName, name, issue.Kind),
+		fmt.Sprintf("%s %s *", issue.TypeName, name),
+		fmt.Sprintf("%s * %s", issue.TypeName, issue.Kind),
+		fmt.Sprintf("%s * *", issue.TypeName),
+	} {
+		if _, ok := a.entries[key]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Check compares each resource's schema with its documentation page in docsDir
+// and returns the issues not in the allowlist, sorted by resource type.
+func Check(resources map[string]*schema.Resource, docsDir string, allowlist *Allowlist) ([]Issue, error) {
+	var typeNames []string
+
+	for typeName := range resources {
+		typeNames = append(typeNames, typeName)
+	}
+
+	sort.Strings(typeNames)
+
+	var issues []Issue
+
+	for _, typeName := range typeNames {
+		resourceIssues, err := CheckResource(typeName, resources[typeName], docsDir)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, issue := range resourceIssues {
+			if allowlist.Allows(issue) {
+				continue
+			}
+
+			issues = append(issues, issue)
+		}
+	}
+
+	return issues, nil
+}
+
+// CheckResource compares a resource's schema with its documentation page in docsDir.
+func CheckResource(typeName string, r *schema.Resource, docsDir string) ([]Issue, error) {
+	path, ok := docPath(typeName, docsDir)
+
+	if !ok {
+		return []Issue{{TypeName: typeName, Kind: IssueMissingPage, Path: path}}, nil
+	}
+
+	f, err := os.Open(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error opening %s documentation: %w", typeName, err)
+	}
+
+	defer f.Close()
+
+	doc, err := ParseDoc(f)
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s documentation (%s): %w", typeName, path, err)
+	}
+
+	return compare(typeName, r, doc, path), nil
+}
+
+func compare(typeName string, r *schema.Resource, doc *Doc, path string) []Issue {
+	var issues []Issue
+
+	newIssue := func(name, kind string) {
+		issues = append(issues, Issue{TypeName: typeName, Name: name, Kind: kind, Path: path})
+	}
+
+	// Names declared at any depth, as nested blocks are documented in the same sections.
+	schemaNames := make(map[string]struct{})
+	addSchemaNames(r.Schema, schemaNames)
+
+	// Timeouts are documented in a list like arguments.
+	if r.Timeouts != nil {
+		for _, name := range timeoutNames {
+			schemaNames[name] = struct{}{}
+		}
+	}
+
+	nestedNames := make(map[string]struct{})
+	for _, s := range r.Schema {
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			addSchemaNames(elem.Schema, nestedNames)
+		}
+	}
+
+	var names []string
+
+	for name := range r.Schema {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		s := r.Schema[name]
+
+		if isProviderAttribute(name) {
+			if _, ok := doc.Arguments[name]; !ok {
+				continue
+			}
+		}
+
+		if s.Required || s.Optional {
+			argument, ok := doc.Arguments[name]
+
+			if !ok {
+				// Optional and Computed arguments such as tags_all may be documented as attributes.
+				if s.Computed && doc.Documents(name) {
+					continue
+				}
+
+				if s.Deprecated == "" {
+					newIssue(name, IssueMissingArgument)
+				}
+
+				continue
+			}
+
+			// The first occurrence of a name that is also nested may not be the top-level one.
+			if _, ok := nestedNames[name]; ok {
+				continue
+			}
+
+			if s.Required && argument.Optional {
+				newIssue(name, IssueRequired)
+			} else if s.Optional && argument.Required {
+				newIssue(name, IssueOptional)
+			}
+
+			continue
+		}
+
+		if !doc.Documents(name) && s.Deprecated == "" {
+			newIssue(name, IssueMissingAttribute)
+		}
+	}
+
+	for _, name := range doc.Names() {
+		if _, ok := schemaNames[name]; ok {
+			continue
+		}
+
+		if isImplicitAttribute(name) {
+			continue
+		}
+
+		// References to other resources, e.g. in notes, are not attributes.
+		if strings.HasPrefix(name, "aws_") {
+			continue
+		}
+
+		newIssue(name, IssueExtra)
+	}
+
+	return issues
+}
+
+func addSchemaNames(m map[string]*schema.Schema, names map[string]struct{}) {
+	for name, s := range m {
+		names[name] = struct{}{}
+
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			addSchemaNames(elem.Schema, names)
+		}
+	}
+}
+
+// docPath returns the path of the resource's documentation page and whether it exists.
+func docPath(typeName, docsDir string) (string, bool) {
+	name := strings.TrimPrefix(typeName, "aws_")
+
+	for _, ext := range []string{".html.markdown", ".markdown"} {
+		path := filepath.Join(docsDir, name+ext)
+
+		if _, err := os.Stat(path); err == nil {
+			return path, true
+		}
+	}
+
+	return filepath.Join(docsDir, name+".html.markdown"), false
+}
+
+func isProviderAttribute(name string) bool {
+	for _, v := range ProviderAttributes {
+		if v == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isImplicitAttribute(name string) bool {
+	for _, v := range implicitAttributes {
+		if v == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DocArgument is an argument documented in the Argument Reference section.
+type DocArgument struct {
+	Required bool
+	Optional bool
+}
+
+// Doc is the parsed Argument Reference and Attributes Reference sections of a documentation page.
+// Names documented in nested block subsections are included.
+type Doc struct {
+	Arguments  map[string]DocArgument
+	Attributes map[string]struct{}
+}
+
+// Documents returns whether the name is documented in either section.
+func (d *Doc) Documents(name string) bool {
+	if _, ok := d.Arguments[name]; ok {
+		return true
+	}
+
+	_, ok := d.Attributes[name]
+
+	return ok
+}
+
+// Names returns the sorted names documented in either section.
+func (d *Doc) Names() []string {
+	var names []string
+
+	for name := range d.Arguments {
+		names = append(names, name)
+	}
+
+	for name := range d.Attributes {
+		if _, ok := d.Arguments[name]; ok {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+var (
+	// e.g. "* `name` - (Optional) The name of the queue."
+	docListItemRegexp = regexp.MustCompile("^\\s*[*-]\\s+`([a-z0-9_]+)`")
+	docRequiredRegexp = regexp.MustCompile(`\((Required|Optional)\b`)
+)
+
+const (
+	docSectionNone = iota
+	docSectionArguments
+	docSectionAttributes
+)
+
+// ParseDoc parses the Argument Reference and Attributes Reference sections of a documentation page.
+func ParseDoc(r io.Reader) (*Doc, error) {
+	doc := &Doc{
+		Arguments:  make(map[string]DocArgument),
+		Attributes: make(map[string]struct{}),
+	}
+	section := docSectionNone
+	inCodeBlock := false
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCodeBlock = !inCodeBlock
+			continue
+		}
+
+		if inCodeBlock {
+			continue
+		}
+
+		if strings.HasPrefix(line, "## ") {
+			heading := strings.ToLower(strings.TrimSpace(strings.TrimPrefix(line, "## ")))
+
+			switch {
+			case strings.HasPrefix(heading, "argument"):
+				section = docSectionArguments
+			case strings.HasPrefix(heading, "attribute"):
+				section = docSectionAttributes
+			default:
+				section = docSectionNone
+			}
+
+			continue
+		}
+
+		m := docListItemRegexp.FindStringSubmatch(line)
+
+		if m == nil {
+			continue
+		}
+
+		name := m[1]
+
+		switch section {
+		case docSectionArguments:
+			// Keep the first occurrence, which is normally the top-level argument.
+			if _, ok := doc.Arguments[name]; ok {
+				continue
+			}
+
+			var argument DocArgument
+
+			if m := docRequiredRegexp.FindStringSubmatch(line); m != nil {
+				argument.Required = m[1] == "Required"
+				argument.Optional = m[1] == "Optional"
+			}
+
+			doc.Arguments[name] = argument
+		case docSectionAttributes:
+			doc.Attributes[name] = struct{}{}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
@@ -0,0 +1,233 @@
+package docscheck
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const testDoc = `---
+subcategory: "Example"
+layout: "aws"
+page_title: "AWS: aws_example_thing"
+---
+
+# Resource: aws_example_thing
+
+## Example Usage
+
+` + "```terraform" + `
+resource "aws_example_thing" "example" {
+  * ` + "`not_an_argument`" + `
+}
+` + "```" + `
+
+## Argument Reference
+
+The following arguments are supported:
+
+* ` + "`name`" + ` - (Required) Name of the thing.
+* ` + "`description`" + ` - (Required) Description of the thing.
+* ` + "`size`" + ` - (Optional) Size of the thing.
+* ` + "`removed`" + ` - (Optional) No longer supported.
+* ` + "`config`" + ` - (Optional) Configuration block. Detailed below.
+
+### config
+
+* ` + "`name`" + ` - (Optional) Name of the configuration.
+
+## Attributes Reference
+
+In addition to all arguments above, the following attributes are exported:
+
+* ` + "`id`" + ` - ID of the thing.
+* ` + "`arn`" + ` - ARN of the thing.
+* ` + "`tags_all`" + ` - Map of tags, including those inherited from the provider.
+
+## Import
+
+* ` + "`not_an_attribute`" + `
+`
+
+func TestParseDoc(t *testing.T) {
+	doc, err := ParseDoc(strings.NewReader(testDoc))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedArguments := map[string]DocArgument{
+		"name":        {Required: true},
+		"description": {Required: true},
+		"size":        {Optional: true},
+		"removed":     {Optional: true},
+		"config":      {Optional: true},
+	}
+
+	if !reflect.DeepEqual(doc.Arguments, expectedArguments) {
+		t.Errorf("got arguments %v, expected %v", doc.Arguments, expectedArguments)
+	}
+
+	expectedAttributes := map[string]struct{}{
+		"id":       {},
+		"arn":      {},
+		"tags_all": {},
+	}
+
+	if !reflect.DeepEqual(doc.Attributes, expectedAttributes) {
+		t.Errorf("got attributes %v, expected %v", doc.Attributes, expectedAttributes)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	doc, err := ParseDoc(strings.NewReader(testDoc))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"tags_all": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+
+	got := compare("aws_example_thing", r, doc, "example_thing.html.markdown")
+
+	var gotKeys []string
+
+	for _, issue := range got {
+		gotKeys = append(gotKeys, issue.Key())
+	}
+
+	expected := []string{
+		"aws_example_thing description optional",
+		"aws_example_thing owner missing-attribute",
+		"aws_example_thing size required",
+		"aws_example_thing type missing-argument",
+		"aws_example_thing removed extra",
+	}
+
+	if !reflect.DeepEqual(gotKeys, expected) {
+		t.Errorf("got %v, expected %v", gotKeys, expected)
+	}
+}
+
+func TestAllowlist(t *testing.T) {
+	allowlist, err := ParseAllowlist(strings.NewReader(`
+# Comment
+aws_example_thing size required
+aws_example_thing owner *
+aws_example_thing * extra
+aws_other_thing * *
+`))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		Name     string
+		Issue    Issue
+		Expected bool
+	}{
+		{
+			Name:     "exact",
+			Issue:    Issue{TypeName: "aws_example_thing", Name: "size", Kind: IssueRequired},
+			Expected: true,
+		},
+		{
+			Name:     "other kind",
+			Issue:    Issue{TypeName: "aws_example_thing", Name: "size", Kind: IssueOptional},
+			Expected: false,
+		},
+		{
+			Name:     "any kind",
+			Issue:    Issue{TypeName: "aws_example_thing", Name: "owner", Kind: IssueMissingAttribute},
+			Expected: true,
+		},
+		{
+			Name:     "any name",
+			Issue:    Issue{TypeName: "aws_example_thing", Name: "removed", Kind: IssueExtra},
+			Expected: true,
+		},
+		{
+			Name:     "any name and kind",
+			Issue:    Issue{TypeName: "aws_other_thing", Kind: IssueMissingPage},
+			Expected: true,
+		},
+		{
+			Name:     "other resource",
+			Issue:    Issue{TypeName: "aws_third_thing", Name: "size", Kind: IssueRequired},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := allowlist.Allows(testCase.Issue)
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestParseAllowlist_invalid(t *testing.T) {
+	_, err := ParseAllowlist(strings.NewReader("aws_example_thing size\n"))
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
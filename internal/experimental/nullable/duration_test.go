@@ -0,0 +1,86 @@
+package nullable
+
+import (
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestNullableDuration(t *testing.T) {
+	runDurationTestCases(t, []durationTestCase{
+		{
+			val:           "30s",
+			expectNull:    false,
+			expectedValue: 30 * time.Second,
+		},
+		{
+			val:           "1h30m",
+			expectNull:    false,
+			expectedValue: 90 * time.Minute,
+		},
+		{
+			val:           "0s",
+			expectNull:    false,
+			expectedValue: 0,
+		},
+		{
+			val:           "",
+			expectNull:    true,
+			expectedValue: 0,
+		},
+		{
+			val:           "A",
+			expectNull:    false,
+			expectedValue: 0,
+			expectedErr:   regexp.MustCompile(`invalid duration`),
+		},
+	})
+}
+
+func TestNewDuration(t *testing.T) {
+	if v := NewDuration(90 * time.Minute); v != "1h30m0s" {
+		t.Fatalf("expected NewDuration to return \"1h30m0s\", got %q", v)
+	}
+}
+
+func TestValidationDuration(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "30s",
+			f:   ValidateTypeStringNullableDuration,
+		},
+		{
+			val:         "A",
+			f:           ValidateTypeStringNullableDuration,
+			expectedErr: regexp.MustCompile(`[\w]+: cannot parse 'A' as duration: .*`),
+		},
+		{
+			val:         30,
+			f:           ValidateTypeStringNullableDuration,
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
+
+func TestValidationDurationBetween(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "30s",
+			f:   ValidateTypeStringNullableDurationBetween(0, time.Minute),
+		},
+		{
+			val: "",
+			f:   ValidateTypeStringNullableDurationBetween(0, time.Minute),
+		},
+		{
+			val:         "2m",
+			f:           ValidateTypeStringNullableDurationBetween(0, time.Minute),
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be between \(0s\) and \(1m0s\), got 2m0s`),
+		},
+		{
+			val:         "A",
+			f:           ValidateTypeStringNullableDurationBetween(0, time.Minute),
+			expectedErr: regexp.MustCompile(`[\w]+: cannot parse 'A' as duration: .*`),
+		},
+	})
+}
@@ -0,0 +1,110 @@
+package nullable
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestNullableFloat(t *testing.T) {
+	runFloatTestCases(t, []floatTestCase{
+		{
+			val:           "1",
+			expectNull:    false,
+			expectedValue: 1,
+		},
+		{
+			val:           "1.5",
+			expectNull:    false,
+			expectedValue: 1.5,
+		},
+		{
+			val:           "0",
+			expectNull:    false,
+			expectedValue: 0,
+		},
+		{
+			val:           "",
+			expectNull:    true,
+			expectedValue: 0,
+		},
+		{
+			val:           "A",
+			expectNull:    false,
+			expectedValue: 0,
+			expectedErr:   strconv.ErrSyntax,
+		},
+	})
+}
+
+func TestNewFloat(t *testing.T) {
+	cases := []struct {
+		val      float64
+		expected Float
+	}{
+		{
+			val:      0,
+			expected: "0",
+		},
+		{
+			val:      1.5,
+			expected: "1.5",
+		},
+		{
+			val:      -100,
+			expected: "-100",
+		},
+	}
+
+	for i, tc := range cases {
+		if v := NewFloat(tc.val); v != tc.expected {
+			t.Fatalf("expected test case %d NewFloat to return %q, got %q", i, tc.expected, v)
+		}
+	}
+}
+
+func TestValidationFloat(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "1.5",
+			f:   ValidateTypeStringNullableFloat,
+		},
+		{
+			val: "",
+			f:   ValidateTypeStringNullableFloat,
+		},
+		{
+			val:         "A",
+			f:           ValidateTypeStringNullableFloat,
+			expectedErr: regexp.MustCompile(`[\w]+: cannot parse 'A' as float: .*`),
+		},
+		{
+			val:         1.5,
+			f:           ValidateTypeStringNullableFloat,
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
+
+func TestValidationFloatBetween(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "0.5",
+			f:   ValidateTypeStringNullableFloatBetween(0, 1),
+		},
+		{
+			val: "",
+			f:   ValidateTypeStringNullableFloatBetween(0, 1),
+		},
+		{
+			val:         "1.5",
+			f:           ValidateTypeStringNullableFloatBetween(0, 1),
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be between \(0\.000000\) and \(1\.000000\), got 1\.500000`),
+		},
+		{
+			val:         "A",
+			f:           ValidateTypeStringNullableFloatBetween(0, 1),
+			expectedErr: regexp.MustCompile(`[\w]+: cannot parse 'A' as float: .*`),
+		},
+	})
+}
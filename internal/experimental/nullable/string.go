@@ -0,0 +1,98 @@
+package nullable
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	TypeNullableString = schema.TypeString
+)
+
+// String is a string argument which distinguishes an empty string from an unset argument.
+// Unlike the other nullable types, the value of a TypeNullableString argument cannot represent
+// null, so use GetString to read it with its presence in the configuration.
+type String struct {
+	value string
+	null  bool
+}
+
+func (s String) IsNull() bool {
+	return s.null
+}
+
+// Value returns the string and whether it is null. The error is always nil and is
+// returned for consistency with the other nullable types.
+func (s String) Value() (string, bool, error) {
+	if s.IsNull() {
+		return "", true, nil
+	}
+
+	return s.value, false, nil
+}
+
+// ValueStringPointer returns a pointer to the string, which may be empty, or nil if it is null.
+func (s String) ValueStringPointer() *string {
+	if s.IsNull() {
+		return nil
+	}
+
+	v := s.value
+	return &v
+}
+
+func NewString(v *string) String {
+	if v == nil {
+		return String{null: true}
+	}
+
+	return String{value: *v}
+}
+
+type rawConfigGetter interface {
+	GetRawConfig() cty.Value
+}
+
+// GetString returns the top-level string argument k of a *schema.ResourceData or *schema.ResourceDiff.
+// The argument is null if it is not configured. The raw configuration is only available during plan
+// and apply, so the argument is always null in Read and during import, and values not known until
+// apply are null during plan.
+func GetString(d rawConfigGetter, k string) String {
+	config := d.GetRawConfig()
+
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute(k) {
+		return String{null: true}
+	}
+
+	v := config.GetAttr(k)
+
+	if v.IsNull() || !v.IsKnown() || !v.Type().Equals(cty.String) {
+		return String{null: true}
+	}
+
+	return String{value: v.AsString()}
+}
+
+// ValidateTypeStringNullableStringLenBetween provides custom error messaging for TypeString strings
+// Some arguments require a string of length between min and max or an unspecified, empty field.
+func ValidateTypeStringNullableStringLenBetween(min int, max int) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (ws []string, es []error) {
+		value, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if value == "" {
+			return
+		}
+
+		if len(value) < min || len(value) > max {
+			es = append(es, fmt.Errorf("expected length of %s to be in the range (%d - %d), got %s", k, min, max, value))
+		}
+
+		return
+	}
+}
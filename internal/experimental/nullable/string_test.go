@@ -0,0 +1,96 @@
+package nullable
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestNullableString(t *testing.T) {
+	runStringTestCases(t, []stringTestCase{
+		{
+			val:           NewString(aws.String("A")),
+			expectNull:    false,
+			expectedValue: "A",
+		},
+		{
+			val:           NewString(aws.String("")),
+			expectNull:    false,
+			expectedValue: "",
+		},
+		{
+			val:           NewString(nil),
+			expectNull:    true,
+			expectedValue: "",
+		},
+	})
+}
+
+type testRawConfig cty.Value
+
+func (c testRawConfig) GetRawConfig() cty.Value {
+	return cty.Value(c)
+}
+
+func TestGetString(t *testing.T) {
+	config := testRawConfig(cty.ObjectVal(map[string]cty.Value{
+		"set":     cty.StringVal("A"),
+		"empty":   cty.StringVal(""),
+		"unset":   cty.NullVal(cty.String),
+		"unknown": cty.UnknownVal(cty.String),
+	}))
+
+	runStringTestCases(t, []stringTestCase{
+		{
+			val:           GetString(config, "set"),
+			expectNull:    false,
+			expectedValue: "A",
+		},
+		{
+			val:           GetString(config, "empty"),
+			expectNull:    false,
+			expectedValue: "",
+		},
+		{
+			val:        GetString(config, "unset"),
+			expectNull: true,
+		},
+		{
+			val:        GetString(config, "unknown"),
+			expectNull: true,
+		},
+		{
+			val:        GetString(config, "missing"),
+			expectNull: true,
+		},
+		{
+			val:        GetString(testRawConfig(cty.NullVal(cty.EmptyObject)), "set"),
+			expectNull: true,
+		},
+	})
+}
+
+func TestValidationStringLenBetween(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "AB",
+			f:   ValidateTypeStringNullableStringLenBetween(1, 2),
+		},
+		{
+			val: "",
+			f:   ValidateTypeStringNullableStringLenBetween(1, 2),
+		},
+		{
+			val:         "ABC",
+			f:           ValidateTypeStringNullableStringLenBetween(1, 2),
+			expectedErr: regexp.MustCompile(`expected length of [\w]+ to be in the range \(1 - 2\), got ABC`),
+		},
+		{
+			val:         1,
+			f:           ValidateTypeStringNullableStringLenBetween(1, 2),
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
@@ -1,8 +1,10 @@
 package nullable
 
 import (
+	"errors"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -43,3 +45,107 @@ func runTestCases(t *testing.T, cases []testCase) {
 		}
 	}
 }
+
+type floatTestCase struct {
+	val           string
+	expectNull    bool
+	expectedValue float64
+	expectedErr   error
+}
+
+func runFloatTestCases(t *testing.T, cases []floatTestCase) {
+	t.Helper()
+
+	for i, tc := range cases {
+		v := Float(tc.val)
+
+		if null := v.IsNull(); null != tc.expectNull {
+			t.Fatalf("expected test case %d IsNull to return %t, got %t", i, tc.expectNull, null)
+		}
+
+		value, null, err := v.Value()
+		if value != tc.expectedValue {
+			t.Fatalf("expected test case %d Value to be %f, got %f", i, tc.expectedValue, value)
+		}
+		if null != tc.expectNull {
+			t.Fatalf("expected test case %d Value null flag to be %t, got %t", i, tc.expectNull, null)
+		}
+		if tc.expectedErr == nil && err != nil {
+			t.Fatalf("expected test case %d to succeed, got error %s", i, err)
+		}
+		if tc.expectedErr != nil {
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected test case %d to have error matching \"%s\", got %s", i, tc.expectedErr, err)
+			}
+		}
+	}
+}
+
+type durationTestCase struct {
+	val           string
+	expectNull    bool
+	expectedValue time.Duration
+	expectedErr   *regexp.Regexp
+}
+
+func runDurationTestCases(t *testing.T, cases []durationTestCase) {
+	t.Helper()
+
+	for i, tc := range cases {
+		v := Duration(tc.val)
+
+		if null := v.IsNull(); null != tc.expectNull {
+			t.Fatalf("expected test case %d IsNull to return %t, got %t", i, tc.expectNull, null)
+		}
+
+		value, null, err := v.Value()
+		if value != tc.expectedValue {
+			t.Fatalf("expected test case %d Value to be %s, got %s", i, tc.expectedValue, value)
+		}
+		if null != tc.expectNull {
+			t.Fatalf("expected test case %d Value null flag to be %t, got %t", i, tc.expectNull, null)
+		}
+		if tc.expectedErr == nil && err != nil {
+			t.Fatalf("expected test case %d to succeed, got error %s", i, err)
+		}
+		if tc.expectedErr != nil {
+			if err == nil || !tc.expectedErr.MatchString(err.Error()) {
+				t.Fatalf("expected test case %d to have error matching \"%s\", got %s", i, tc.expectedErr, err)
+			}
+		}
+	}
+}
+
+type stringTestCase struct {
+	val           String
+	expectNull    bool
+	expectedValue string
+}
+
+func runStringTestCases(t *testing.T, cases []stringTestCase) {
+	t.Helper()
+
+	for i, tc := range cases {
+		v := tc.val
+
+		if null := v.IsNull(); null != tc.expectNull {
+			t.Fatalf("expected test case %d IsNull to return %t, got %t", i, tc.expectNull, null)
+		}
+
+		value, null, err := v.Value()
+		if value != tc.expectedValue {
+			t.Fatalf("expected test case %d Value to be %q, got %q", i, tc.expectedValue, value)
+		}
+		if null != tc.expectNull {
+			t.Fatalf("expected test case %d Value null flag to be %t, got %t", i, tc.expectNull, null)
+		}
+		if err != nil {
+			t.Fatalf("expected test case %d to succeed, got error %s", i, err)
+		}
+
+		p := v.ValueStringPointer()
+		if (p == nil) != tc.expectNull || (p != nil && *p != tc.expectedValue) {
+			t.Fatalf("expected test case %d ValueStringPointer to point to %q, got %v", i, tc.expectedValue, p)
+		}
+	}
+}
@@ -0,0 +1,593 @@
+package flex
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// StructOptions customizes ExpandStruct and FlattenStruct for one configuration block.
+// A nil *StructOptions uses the naming convention for every attribute.
+type StructOptions struct {
+	// FieldNames maps attribute names to AWS Go SDK field names that do not follow the
+	// snake_case to PascalCase naming convention, e.g. "arn" to "ResourceArn".
+	FieldNames map[string]string
+
+	// Skip lists attribute names that are neither expanded nor flattened, e.g. virtual
+	// attributes or attributes handled separately by the caller.
+	Skip []string
+
+	// Expanders are custom expand functions keyed by attribute name.
+	// The returned value must be assignable to the AWS Go SDK field. A nil value leaves the field unset.
+	Expanders map[string]func(interface{}) (interface{}, error)
+
+	// Flatteners are custom flatten functions keyed by attribute name.
+	// They receive the AWS Go SDK field value. A nil value omits the attribute.
+	Flatteners map[string]func(interface{}) (interface{}, error)
+
+	// Blocks are the options for nested configuration blocks keyed by attribute name.
+	Blocks map[string]*StructOptions
+}
+
+func (o *StructOptions) skips(name string) bool {
+	if o == nil {
+		return false
+	}
+
+	for _, v := range o.Skip {
+		if v == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (o *StructOptions) fieldName(name string) string {
+	if o != nil {
+		if v, ok := o.FieldNames[name]; ok {
+			return v
+		}
+	}
+
+	return PascalCase(name)
+}
+
+func (o *StructOptions) attributeName(fieldName string) string {
+	if o != nil {
+		for k, v := range o.FieldNames {
+			if v == fieldName {
+				return k
+			}
+		}
+	}
+
+	return SnakeCase(fieldName)
+}
+
+func (o *StructOptions) expander(name string) func(interface{}) (interface{}, error) {
+	if o == nil {
+		return nil
+	}
+
+	return o.Expanders[name]
+}
+
+func (o *StructOptions) flattener(name string) func(interface{}) (interface{}, error) {
+	if o == nil {
+		return nil
+	}
+
+	return o.Flatteners[name]
+}
+
+func (o *StructOptions) block(name string) *StructOptions {
+	if o == nil {
+		return nil
+	}
+
+	return o.Blocks[name]
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// ExpandStruct sets the fields of the AWS Go SDK structure pointed to by apiObject
+// from the attributes of a configuration block.
+//
+// Each attribute is set on the field whose name is the attribute name converted to PascalCase,
+// ignoring case so that initialisms such as "SSLPolicy" match:
+//   - TypeString to *string, including enumerations, with empty strings left unset
+//   - TypeString to *time.Time, parsed as RFC3339, with empty strings left unset
+//   - TypeInt to *int64 and TypeFloat to *float64, with zero values left unset
+//   - TypeBool to *bool
+//   - TypeList and TypeSet of primitives to slices of pointers, with empty strings removed
+//   - TypeMap to maps of pointers
+//   - TypeList with MaxItems 1 of Resource to a pointer to a structure
+//   - TypeList and TypeSet of Resource to a slice of pointers to structures
+//
+// An error is returned for attributes without a corresponding field. Use StructOptions to
+// map, skip or customize attributes.
+func ExpandStruct(tfMap map[string]interface{}, apiObject interface{}, opts *StructOptions) error {
+	v := reflect.ValueOf(apiObject)
+
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("expected a pointer to a structure, got %T", apiObject)
+	}
+
+	return expandStruct(tfMap, v.Elem(), opts)
+}
+
+func expandStruct(tfMap map[string]interface{}, to reflect.Value, opts *StructOptions) error {
+	var names []string
+
+	for name := range tfMap {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if opts.skips(name) {
+			continue
+		}
+
+		fieldName := opts.fieldName(name)
+		field := to.FieldByName(fieldName)
+
+		// Match initialisms, e.g. "DBInstanceIdentifier" for "db_instance_identifier".
+		if !field.IsValid() {
+			field = to.FieldByNameFunc(func(s string) bool {
+				return strings.EqualFold(s, fieldName)
+			})
+		}
+
+		if !field.IsValid() || !field.CanSet() {
+			return fmt.Errorf("%s: no field %s in %s", name, fieldName, to.Type())
+		}
+
+		if f := opts.expander(name); f != nil {
+			v, err := f(tfMap[name])
+
+			if err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+
+			if v == nil {
+				continue
+			}
+
+			value := reflect.ValueOf(v)
+
+			if !value.Type().AssignableTo(field.Type()) {
+				return fmt.Errorf("%s: cannot assign %s to %s.%s (%s)", name, value.Type(), to.Type(), fieldName, field.Type())
+			}
+
+			field.Set(value)
+
+			continue
+		}
+
+		if err := expandValue(tfMap[name], field, opts.block(name)); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func expandValue(tfValue interface{}, to reflect.Value, opts *StructOptions) error {
+	if v, ok := tfValue.(*schema.Set); ok {
+		tfValue = v.List()
+	}
+
+	switch to.Kind() {
+	case reflect.Ptr:
+		if to.Type().Elem().Kind() == reflect.Struct && to.Type().Elem() != timeType {
+			tfList, ok := tfValue.([]interface{})
+
+			if !ok || len(tfList) == 0 || tfList[0] == nil {
+				return nil
+			}
+
+			tfMap, ok := tfList[0].(map[string]interface{})
+
+			if !ok {
+				return fmt.Errorf("expected a configuration block, got %T", tfList[0])
+			}
+
+			apiObject := reflect.New(to.Type().Elem())
+
+			if err := expandStruct(tfMap, apiObject.Elem(), opts); err != nil {
+				return err
+			}
+
+			to.Set(apiObject)
+
+			return nil
+		}
+
+		value, err := expandPrimitive(tfValue, to.Type(), true)
+
+		if err != nil {
+			return err
+		}
+
+		if value.IsValid() {
+			to.Set(value)
+		}
+
+	case reflect.Slice:
+		tfList, ok := tfValue.([]interface{})
+
+		if !ok || len(tfList) == 0 {
+			return nil
+		}
+
+		elemType := to.Type().Elem()
+		apiObjects := reflect.MakeSlice(to.Type(), 0, len(tfList))
+
+		for _, tfElem := range tfList {
+			if tfElem == nil {
+				continue
+			}
+
+			elem := reflect.New(elemType).Elem()
+
+			if elemType.Kind() == reflect.Ptr && elemType.Elem().Kind() == reflect.Struct && elemType.Elem() != timeType {
+				if err := expandValue([]interface{}{tfElem}, elem, opts); err != nil {
+					return err
+				}
+			} else {
+				value, err := expandPrimitive(tfElem, elemType, true)
+
+				if err != nil {
+					return err
+				}
+
+				if !value.IsValid() {
+					continue
+				}
+
+				elem.Set(value)
+			}
+
+			apiObjects = reflect.Append(apiObjects, elem)
+		}
+
+		if apiObjects.Len() > 0 {
+			to.Set(apiObjects)
+		}
+
+	case reflect.Map:
+		tfMap, ok := tfValue.(map[string]interface{})
+
+		if !ok || len(tfMap) == 0 {
+			return nil
+		}
+
+		if to.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("unsupported map key type %s", to.Type().Key())
+		}
+
+		apiObjects := reflect.MakeMapWithSize(to.Type(), len(tfMap))
+
+		for k, v := range tfMap {
+			value, err := expandPrimitive(v, to.Type().Elem(), false)
+
+			if err != nil {
+				return err
+			}
+
+			if value.IsValid() {
+				apiObjects.SetMapIndex(reflect.ValueOf(k).Convert(to.Type().Key()), value)
+			}
+		}
+
+		to.Set(apiObjects)
+
+	default:
+		return fmt.Errorf("unsupported type %s", to.Type())
+	}
+
+	return nil
+}
+
+// expandPrimitive returns a pointer of type t to the value, or the zero Value if the value is not set.
+func expandPrimitive(tfValue interface{}, t reflect.Type, omitZero bool) (reflect.Value, error) {
+	if t.Kind() != reflect.Ptr {
+		return reflect.Value{}, fmt.Errorf("unsupported type %s", t)
+	}
+
+	if tfValue == nil {
+		return reflect.Value{}, nil
+	}
+
+	value := reflect.New(t.Elem())
+
+	if t.Elem() == timeType {
+		v, ok := tfValue.(string)
+
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("expected string, got %T", tfValue)
+		}
+
+		if v == "" {
+			return reflect.Value{}, nil
+		}
+
+		tm, err := time.Parse(time.RFC3339, v)
+
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		value.Elem().Set(reflect.ValueOf(tm))
+
+		return value, nil
+	}
+
+	switch t.Elem().Kind() {
+	case reflect.String:
+		v, ok := tfValue.(string)
+
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("expected string, got %T", tfValue)
+		}
+
+		if omitZero && v == "" {
+			return reflect.Value{}, nil
+		}
+
+		value.Elem().SetString(v)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, ok := tfValue.(int)
+
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("expected int, got %T", tfValue)
+		}
+
+		if omitZero && v == 0 {
+			return reflect.Value{}, nil
+		}
+
+		value.Elem().SetInt(int64(v))
+
+	case reflect.Float32, reflect.Float64:
+		var v float64
+
+		switch tfValue := tfValue.(type) {
+		case float64:
+			v = tfValue
+		case int:
+			v = float64(tfValue)
+		default:
+			return reflect.Value{}, fmt.Errorf("expected float64, got %T", tfValue)
+		}
+
+		if omitZero && v == 0 {
+			return reflect.Value{}, nil
+		}
+
+		value.Elem().SetFloat(v)
+
+	case reflect.Bool:
+		v, ok := tfValue.(bool)
+
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("expected bool, got %T", tfValue)
+		}
+
+		value.Elem().SetBool(v)
+
+	default:
+		return reflect.Value{}, fmt.Errorf("unsupported type %s", t)
+	}
+
+	return value, nil
+}
+
+// FlattenStruct returns a configuration block for an AWS Go SDK structure, or a pointer to one.
+//
+// Each field is flattened to the attribute whose name is the field name converted to snake_case,
+// using the reverse of the ExpandStruct conversions. Nil fields are omitted. Nested structures are
+// flattened to a list of one configuration block, suitable for both TypeList and TypeSet.
+func FlattenStruct(apiObject interface{}, opts *StructOptions) (map[string]interface{}, error) {
+	v := reflect.ValueOf(apiObject)
+
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected a structure, got %T", apiObject)
+	}
+
+	return flattenStruct(v, opts)
+}
+
+func flattenStruct(from reflect.Value, opts *StructOptions) (map[string]interface{}, error) {
+	tfMap := map[string]interface{}{}
+
+	for i := 0; i < from.NumField(); i++ {
+		field := from.Type().Field(i)
+
+		// Skip unexported fields, including the AWS Go SDK's "_ struct{}" metadata.
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := opts.attributeName(field.Name)
+
+		if opts.skips(name) {
+			continue
+		}
+
+		if f := opts.flattener(name); f != nil {
+			v, err := f(from.Field(i).Interface())
+
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+
+			if v != nil {
+				tfMap[name] = v
+			}
+
+			continue
+		}
+
+		v, ok, err := flattenValue(from.Field(i), opts.block(name))
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		if ok {
+			tfMap[name] = v
+		}
+	}
+
+	return tfMap, nil
+}
+
+// flattenValue returns the Terraform value and whether it is set.
+func flattenValue(from reflect.Value, opts *StructOptions) (interface{}, bool, error) {
+	switch from.Kind() {
+	case reflect.Ptr:
+		if from.IsNil() {
+			return nil, false, nil
+		}
+
+		if from.Type().Elem().Kind() == reflect.Struct && from.Type().Elem() != timeType {
+			tfMap, err := flattenStruct(from.Elem(), opts)
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			return []interface{}{tfMap}, true, nil
+		}
+
+		return flattenPrimitive(from.Elem())
+
+	case reflect.Slice:
+		if from.IsNil() {
+			return nil, false, nil
+		}
+
+		tfList := make([]interface{}, 0, from.Len())
+
+		for i := 0; i < from.Len(); i++ {
+			v, ok, err := flattenValue(from.Index(i), opts)
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			if !ok {
+				continue
+			}
+
+			// Structures in lists are not wrapped in a list of their own.
+			if block, isBlock := v.([]interface{}); isBlock && from.Type().Elem().Kind() == reflect.Ptr && from.Type().Elem().Elem().Kind() == reflect.Struct {
+				v = block[0]
+			}
+
+			tfList = append(tfList, v)
+		}
+
+		return tfList, true, nil
+
+	case reflect.Map:
+		if from.IsNil() {
+			return nil, false, nil
+		}
+
+		tfMap := make(map[string]interface{}, from.Len())
+		iter := from.MapRange()
+
+		for iter.Next() {
+			v, ok, err := flattenValue(iter.Value(), opts)
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			if ok {
+				tfMap[iter.Key().String()] = v
+			}
+		}
+
+		return tfMap, true, nil
+	}
+
+	return nil, false, fmt.Errorf("unsupported type %s", from.Type())
+}
+
+func flattenPrimitive(from reflect.Value) (interface{}, bool, error) {
+	if from.Type() == timeType {
+		return from.Interface().(time.Time).Format(time.RFC3339), true, nil
+	}
+
+	switch from.Kind() {
+	case reflect.String:
+		return from.String(), true, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(from.Int()), true, nil
+	case reflect.Float32, reflect.Float64:
+		return from.Float(), true, nil
+	case reflect.Bool:
+		return from.Bool(), true, nil
+	}
+
+	return nil, false, fmt.Errorf("unsupported type %s", from.Type())
+}
+
+// PascalCase converts a snake_case attribute name to an AWS Go SDK field name, e.g. "kms_key_id" to "KmsKeyId".
+func PascalCase(s string) string {
+	var sb strings.Builder
+
+	for _, word := range strings.Split(s, "_") {
+		if word == "" {
+			continue
+		}
+
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		sb.WriteString(string(runes))
+	}
+
+	return sb.String()
+}
+
+// SnakeCase converts an AWS Go SDK field name to a snake_case attribute name, e.g. "KmsKeyId" to "kms_key_id"
+// and "DBInstanceIdentifier" to "db_instance_identifier".
+func SnakeCase(s string) string {
+	var sb strings.Builder
+
+	runes := []rune(s)
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				sb.WriteRune('_')
+			}
+		}
+
+		sb.WriteRune(unicode.ToLower(r))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,537 @@
+package flex
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type testStructThing struct {
+	_ struct{} `type:"structure"`
+
+	Config               *testStructConfig
+	CreatedAt            *time.Time
+	DBInstanceIdentifier *string
+	Enabled              *bool
+	Ipv6CidrBlock        *string
+	KmsKeyId             *string
+	Rules                []*testStructRule
+	SSLPolicy            *string
+	Size                 *int64
+	SubnetIds            []*string
+	Tags                 map[string]*string
+	Weight               *float64
+}
+
+type testStructConfig struct {
+	_ struct{} `type:"structure"`
+
+	Mode  *string
+	Ports []*int64
+}
+
+type testStructRule struct {
+	_ struct{} `type:"structure"`
+
+	Name     *string
+	Priority *int64
+}
+
+func TestPascalCase(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected string
+	}{
+		{
+			Name:     "empty",
+			Input:    "",
+			Expected: "",
+		},
+		{
+			Name:     "single word",
+			Input:    "arn",
+			Expected: "Arn",
+		},
+		{
+			Name:     "multiple words",
+			Input:    "kms_key_id",
+			Expected: "KmsKeyId",
+		},
+		{
+			Name:     "digits",
+			Input:    "ipv6_cidr_block",
+			Expected: "Ipv6CidrBlock",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := PascalCase(testCase.Input); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected string
+	}{
+		{
+			Name:     "empty",
+			Input:    "",
+			Expected: "",
+		},
+		{
+			Name:     "single word",
+			Input:    "Arn",
+			Expected: "arn",
+		},
+		{
+			Name:     "multiple words",
+			Input:    "KmsKeyId",
+			Expected: "kms_key_id",
+		},
+		{
+			Name:     "digits",
+			Input:    "Ipv6CidrBlock",
+			Expected: "ipv6_cidr_block",
+		},
+		{
+			Name:     "initialism",
+			Input:    "DBInstanceIdentifier",
+			Expected: "db_instance_identifier",
+		},
+		{
+			Name:     "trailing initialism",
+			Input:    "ClientVPN",
+			Expected: "client_vpn",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := SnakeCase(testCase.Input); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestExpandStruct(t *testing.T) {
+	createdAt := time.Date(2021, 12, 1, 10, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name          string
+		TFMap         map[string]interface{}
+		Options       *StructOptions
+		Expected      *testStructThing
+		ExpectedError string
+	}{
+		{
+			Name:     "empty",
+			TFMap:    map[string]interface{}{},
+			Expected: &testStructThing{},
+		},
+		{
+			Name: "zero values",
+			TFMap: map[string]interface{}{
+				"config":                 []interface{}{},
+				"created_at":             "",
+				"db_instance_identifier": "",
+				"enabled":                false,
+				"rules":                  []interface{}{},
+				"size":                   0,
+				"subnet_ids":             schema.NewSet(schema.HashString, nil),
+				"tags":                   map[string]interface{}{},
+				"weight":                 0.0,
+			},
+			Expected: &testStructThing{
+				Enabled: aws.Bool(false),
+			},
+		},
+		{
+			Name: "primitives",
+			TFMap: map[string]interface{}{
+				"created_at":      "2021-12-01T10:30:00Z",
+				"enabled":         true,
+				"ipv6_cidr_block": "::/0",
+				"kms_key_id":      "key",
+				"size":            10,
+				"ssl_policy":      "policy",
+				"weight":          1.5,
+			},
+			Expected: &testStructThing{
+				CreatedAt:     aws.Time(createdAt),
+				Enabled:       aws.Bool(true),
+				Ipv6CidrBlock: aws.String("::/0"),
+				KmsKeyId:      aws.String("key"),
+				Size:          aws.Int64(10),
+				SSLPolicy:     aws.String("policy"),
+				Weight:        aws.Float64(1.5),
+			},
+		},
+		{
+			Name: "lists sets and maps",
+			TFMap: map[string]interface{}{
+				"subnet_ids": schema.NewSet(schema.HashString, []interface{}{"subnet-1", ""}),
+				"tags":       map[string]interface{}{"Name": "test", "Empty": ""},
+			},
+			Expected: &testStructThing{
+				SubnetIds: aws.StringSlice([]string{"subnet-1"}),
+				Tags:      aws.StringMap(map[string]string{"Name": "test", "Empty": ""}),
+			},
+		},
+		{
+			Name: "nested blocks",
+			TFMap: map[string]interface{}{
+				"config": []interface{}{
+					map[string]interface{}{
+						"mode":  "ACTIVE",
+						"ports": []interface{}{80, 443},
+					},
+				},
+				"rules": []interface{}{
+					map[string]interface{}{
+						"name":     "first",
+						"priority": 1,
+					},
+					map[string]interface{}{
+						"name":     "second",
+						"priority": 2,
+					},
+				},
+			},
+			Expected: &testStructThing{
+				Config: &testStructConfig{
+					Mode:  aws.String("ACTIVE"),
+					Ports: aws.Int64Slice([]int64{80, 443}),
+				},
+				Rules: []*testStructRule{
+					{
+						Name:     aws.String("first"),
+						Priority: aws.Int64(1),
+					},
+					{
+						Name:     aws.String("second"),
+						Priority: aws.Int64(2),
+					},
+				},
+			},
+		},
+		{
+			Name: "options",
+			TFMap: map[string]interface{}{
+				"config": []interface{}{
+					map[string]interface{}{
+						"mode":    "active",
+						"virtual": true,
+					},
+				},
+				"policy": "policy",
+				"size":   10,
+			},
+			Options: &StructOptions{
+				FieldNames: map[string]string{"policy": "SSLPolicy"},
+				Expanders: map[string]func(interface{}) (interface{}, error){
+					"size": func(v interface{}) (interface{}, error) {
+						return aws.Int64(int64(v.(int)) * 1024), nil
+					},
+				},
+				Blocks: map[string]*StructOptions{
+					"config": {
+						Skip: []string{"virtual"},
+						Expanders: map[string]func(interface{}) (interface{}, error){
+							"mode": func(v interface{}) (interface{}, error) {
+								return aws.String(strings.ToUpper(v.(string))), nil
+							},
+						},
+					},
+				},
+			},
+			Expected: &testStructThing{
+				Config: &testStructConfig{
+					Mode: aws.String("ACTIVE"),
+				},
+				SSLPolicy: aws.String("policy"),
+				Size:      aws.Int64(10240),
+			},
+		},
+		{
+			Name: "unknown attribute",
+			TFMap: map[string]interface{}{
+				"unknown": "value",
+			},
+			ExpectedError: "unknown: no field Unknown",
+		},
+		{
+			Name: "invalid timestamp",
+			TFMap: map[string]interface{}{
+				"created_at": "yesterday",
+			},
+			ExpectedError: "created_at: parsing time",
+		},
+		{
+			Name: "wrong type",
+			TFMap: map[string]interface{}{
+				"size": "10",
+			},
+			ExpectedError: "size: expected int, got string",
+		},
+		{
+			Name: "expander error",
+			TFMap: map[string]interface{}{
+				"size": 10,
+			},
+			Options: &StructOptions{
+				Expanders: map[string]func(interface{}) (interface{}, error){
+					"size": func(v interface{}) (interface{}, error) {
+						return nil, errors.New("invalid size")
+					},
+				},
+			},
+			ExpectedError: "size: invalid size",
+		},
+		{
+			Name: "expander wrong type",
+			TFMap: map[string]interface{}{
+				"size": 10,
+			},
+			Options: &StructOptions{
+				Expanders: map[string]func(interface{}) (interface{}, error){
+					"size": func(v interface{}) (interface{}, error) {
+						return v, nil
+					},
+				},
+			},
+			ExpectedError: "size: cannot assign int",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := &testStructThing{}
+			err := ExpandStruct(testCase.TFMap, got, testCase.Options)
+
+			if testCase.ExpectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error containing %q", testCase.ExpectedError)
+				}
+
+				if !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("got error %q, expected error containing %q", err, testCase.ExpectedError)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestExpandStruct_notPointer(t *testing.T) {
+	err := ExpandStruct(map[string]interface{}{}, testStructThing{}, nil)
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestFlattenStruct(t *testing.T) {
+	createdAt := time.Date(2021, 12, 1, 10, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name      string
+		APIObject interface{}
+		Options   *StructOptions
+		Expected  map[string]interface{}
+	}{
+		{
+			Name:      "nil",
+			APIObject: (*testStructThing)(nil),
+			Expected:  nil,
+		},
+		{
+			Name:      "empty",
+			APIObject: &testStructThing{},
+			Expected:  map[string]interface{}{},
+		},
+		{
+			Name: "primitives",
+			APIObject: &testStructThing{
+				CreatedAt:            aws.Time(createdAt),
+				DBInstanceIdentifier: aws.String("db"),
+				Enabled:              aws.Bool(false),
+				Size:                 aws.Int64(10),
+				Weight:               aws.Float64(1.5),
+			},
+			Expected: map[string]interface{}{
+				"created_at":             "2021-12-01T10:30:00Z",
+				"db_instance_identifier": "db",
+				"enabled":                false,
+				"size":                   10,
+				"weight":                 1.5,
+			},
+		},
+		{
+			Name: "lists and maps",
+			APIObject: testStructThing{
+				SubnetIds: aws.StringSlice([]string{"subnet-1", "subnet-2"}),
+				Tags:      aws.StringMap(map[string]string{"Name": "test"}),
+			},
+			Expected: map[string]interface{}{
+				"subnet_ids": []interface{}{"subnet-1", "subnet-2"},
+				"tags":       map[string]interface{}{"Name": "test"},
+			},
+		},
+		{
+			Name: "nested blocks",
+			APIObject: &testStructThing{
+				Config: &testStructConfig{
+					Mode:  aws.String("ACTIVE"),
+					Ports: aws.Int64Slice([]int64{80}),
+				},
+				Rules: []*testStructRule{
+					{
+						Name: aws.String("first"),
+					},
+					nil,
+					{
+						Name:     aws.String("second"),
+						Priority: aws.Int64(2),
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"config": []interface{}{
+					map[string]interface{}{
+						"mode":  "ACTIVE",
+						"ports": []interface{}{80},
+					},
+				},
+				"rules": []interface{}{
+					map[string]interface{}{
+						"name": "first",
+					},
+					map[string]interface{}{
+						"name":     "second",
+						"priority": 2,
+					},
+				},
+			},
+		},
+		{
+			Name: "options",
+			APIObject: &testStructThing{
+				Config: &testStructConfig{
+					Mode: aws.String("ACTIVE"),
+				},
+				KmsKeyId:  aws.String("key"),
+				SSLPolicy: aws.String("policy"),
+				Size:      aws.Int64(10240),
+			},
+			Options: &StructOptions{
+				FieldNames: map[string]string{"policy": "SSLPolicy"},
+				Skip:       []string{"kms_key_id"},
+				Flatteners: map[string]func(interface{}) (interface{}, error){
+					"size": func(v interface{}) (interface{}, error) {
+						return int(aws.Int64Value(v.(*int64)) / 1024), nil
+					},
+				},
+				Blocks: map[string]*StructOptions{
+					"config": {
+						Flatteners: map[string]func(interface{}) (interface{}, error){
+							"mode": func(v interface{}) (interface{}, error) {
+								return strings.ToLower(aws.StringValue(v.(*string))), nil
+							},
+						},
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"config": []interface{}{
+					map[string]interface{}{
+						"mode": "active",
+					},
+				},
+				"policy": "policy",
+				"size":   10,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := FlattenStruct(testCase.APIObject, testCase.Options)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestExpandFlattenStruct_roundTrip(t *testing.T) {
+	expected := &testStructThing{
+		Config: &testStructConfig{
+			Mode:  aws.String("ACTIVE"),
+			Ports: aws.Int64Slice([]int64{80, 443}),
+		},
+		CreatedAt:            aws.Time(time.Date(2021, 12, 1, 10, 30, 0, 0, time.UTC)),
+		DBInstanceIdentifier: aws.String("db"),
+		Enabled:              aws.Bool(true),
+		Rules: []*testStructRule{
+			{
+				Name:     aws.String("first"),
+				Priority: aws.Int64(1),
+			},
+		},
+		SSLPolicy: aws.String("policy"),
+		Size:      aws.Int64(10),
+		SubnetIds: aws.StringSlice([]string{"subnet-1"}),
+		Tags:      aws.StringMap(map[string]string{"Name": "test"}),
+		Weight:    aws.Float64(1.5),
+	}
+	tfMap, err := FlattenStruct(expected, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error flattening: %s", err)
+	}
+
+	got := &testStructThing{}
+
+	if err := ExpandStruct(tfMap, got, nil); err != nil {
+		t.Fatalf("unexpected error expanding: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", got, expected)
+	}
+}
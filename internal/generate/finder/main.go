@@ -0,0 +1,546 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"golang.org/x/tools/go/packages"
+)
+
+var (
+	op             = flag.String("Op", "", "name of the AWS SDK operation which lists the resources")
+	name           = flag.String("Name", "", "name of the resource, used in function names, e.g. Volume")
+	pluralName     = flag.String("PluralName", "", "plural name of the resource, if not Name followed by \"s\"")
+	listField      = flag.String("ListField", "", "name of the output field containing the resources, if not the only list of structures")
+	idField        = flag.String("IDField", "", "name of the input field used to find a resource by ID")
+	notFoundCodes  = flag.String("NotFoundCodes", "", "comma separated list of AWS error codes meaning that the resource is not found")
+	paginator      = flag.String("Paginator", "NextToken", "name of the pagination token field")
+	dataSourceType = flag.String("DataSourceType", "", "human-readable resource type used in singular data source errors, e.g. \"FSx Volume\"")
+	withContext    = flag.Bool("Context", false, "whether the finders take a context")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+type TemplateData struct {
+	Parameters         string
+	DestinationPackage string
+	SourcePackage      string
+
+	Context        bool
+	DataSourceType string
+	IDField        string
+	IDSlice        bool
+	ItemType       string
+	ListField      string
+	Name           string
+	PluralName     string
+	NotFoundCodes  []string
+	Op             string
+	OutputType     string
+	Pages          bool // The AWS SDK defines <Op>Pages.
+	Paginator      string
+	InputType      string
+	RecvType       string
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	if *op == "" || *name == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	wd, err := os.Getwd()
+
+	if err != nil {
+		log.Fatalf("unable to get working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+	pkg, err := loadAWSServicePackage(servicePackage)
+
+	if err != nil {
+		log.Fatalf("encountered: %s", err)
+	}
+
+	templateData := TemplateData{
+		Parameters:         parameters(os.Args[1:]),
+		DestinationPackage: servicePackage,
+		SourcePackage:      pkg.path,
+		Context:            *withContext,
+		DataSourceType:     *dataSourceType,
+		IDField:            *idField,
+		Name:               *name,
+		PluralName:         *pluralName,
+		Op:                 *op,
+	}
+
+	if templateData.PluralName == "" {
+		templateData.PluralName = templateData.Name + "s"
+	}
+
+	if err := templateData.resolve(pkg); err != nil {
+		log.Fatalf("error generating finders for %s: %s", *op, err)
+	}
+
+	var buf bytes.Buffer
+
+	if err := template.Must(template.New("finder").Parse(finderTemplate)).Execute(&buf, templateData); err != nil {
+		log.Fatalf("error executing template: %s", err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+
+	if err != nil {
+		log.Printf("warning: internal error: invalid Go generated: %s", err)
+		log.Printf("warning: compile the package to analyze the error")
+		src = buf.Bytes()
+	}
+
+	filename := fmt.Sprintf("find_%s_gen.go", snakeCase(*name))
+
+	if err := os.WriteFile(filename, src, 0644); err != nil {
+		log.Fatalf("error writing output: %s", err)
+	}
+}
+
+// resolve fills in the template data from the declarations of the AWS SDK operation.
+func (d *TemplateData) resolve(pkg *Package) error {
+	function := pkg.method(d.Op)
+
+	if function == nil {
+		return fmt.Errorf("operation not found in %s", d.SourcePackage)
+	}
+
+	if function.Type.Params.NumFields() != 1 || function.Type.Results.NumFields() != 2 {
+		return fmt.Errorf("unexpected signature")
+	}
+
+	d.RecvType = pkg.expandType(function.Recv.List[0].Type)
+	d.InputType = pkg.expandType(function.Type.Params.List[0].Type)
+	d.OutputType = pkg.expandType(function.Type.Results.List[0].Type)
+	d.Pages = pkg.method(d.Op+"Pages") != nil
+
+	input := pkg.structOf(function.Type.Params.List[0].Type)
+	output := pkg.structOf(function.Type.Results.List[0].Type)
+
+	if input == nil || output == nil {
+		return fmt.Errorf("unexpected signature")
+	}
+
+	if !d.Pages {
+		if fieldByName(input, *paginator) != nil && fieldByName(output, *paginator) != nil {
+			d.Paginator = *paginator
+		}
+	}
+
+	for _, field := range output.Fields.List {
+		if len(field.Names) != 1 {
+			continue
+		}
+
+		fieldName := field.Names[0].Name
+
+		if *listField != "" && fieldName != *listField {
+			continue
+		}
+
+		array, ok := field.Type.(*ast.ArrayType)
+
+		if !ok || pkg.structOf(array.Elt) == nil {
+			continue
+		}
+
+		if d.ListField != "" {
+			return fmt.Errorf("output has more than one list of structures (%s, %s), use -ListField", d.ListField, fieldName)
+		}
+
+		d.ListField = fieldName
+		d.ItemType = pkg.expandType(array.Elt)
+	}
+
+	if d.ListField == "" {
+		return fmt.Errorf("output has no list of structures")
+	}
+
+	if d.IDField != "" {
+		field := fieldByName(input, d.IDField)
+
+		if field == nil {
+			return fmt.Errorf("input has no field %s", d.IDField)
+		}
+
+		switch t := pkg.expandType(field.Type); t {
+		case "*string":
+		case "[]*string":
+			d.IDSlice = true
+		default:
+			return fmt.Errorf("input field %s has unsupported type %s", d.IDField, t)
+		}
+	}
+
+	if *notFoundCodes != "" {
+		for _, code := range strings.Split(*notFoundCodes, ",") {
+			d.NotFoundCodes = append(d.NotFoundCodes, pkg.errorCodeExpr(code))
+		}
+	}
+
+	return nil
+}
+
+type Package struct {
+	name  string
+	path  string
+	files []*ast.File
+}
+
+// method returns the declaration of the named method of the AWS SDK client.
+func (p *Package) method(name string) *ast.FuncDecl {
+	for _, file := range p.files {
+		for _, decl := range file.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Recv != nil && funcDecl.Name.Name == name {
+				return funcDecl
+			}
+		}
+	}
+
+	return nil
+}
+
+// structOf returns the declaration of the package's structure type, or of the structure pointed to.
+func (p *Package) structOf(expr ast.Expr) *ast.StructType {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+
+	ident, ok := expr.(*ast.Ident)
+
+	if !ok {
+		return nil
+	}
+
+	for _, file := range p.files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				if typeSpec := spec.(*ast.TypeSpec); typeSpec.Name.Name == ident.Name {
+					structType, _ := typeSpec.Type.(*ast.StructType)
+
+					return structType
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// expandType returns the type expression as used outside the package.
+func (p *Package) expandType(expr ast.Expr) string {
+	switch v := expr.(type) {
+	case *ast.StarExpr:
+		return "*" + p.expandType(v.X)
+	case *ast.ArrayType:
+		return "[]" + p.expandType(v.Elt)
+	case *ast.Ident:
+		if ast.IsExported(v.Name) {
+			return fmt.Sprintf("%s.%s", p.name, v.Name)
+		}
+
+		return v.Name
+	}
+
+	log.Fatalf("Unexpected type expression: (%[1]T) %[1]v", expr)
+	return ""
+}
+
+// errorCodeExpr returns the AWS SDK ErrCode constant with the code's value, or the quoted code.
+func (p *Package) errorCodeExpr(code string) string {
+	quoted := strconv.Quote(code)
+
+	for _, file := range p.files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+
+			if !ok || genDecl.Tok != token.CONST {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				valueSpec := spec.(*ast.ValueSpec)
+
+				for i, ident := range valueSpec.Names {
+					if !strings.HasPrefix(ident.Name, "ErrCode") || i >= len(valueSpec.Values) {
+						continue
+					}
+
+					if lit, ok := valueSpec.Values[i].(*ast.BasicLit); ok && lit.Value == quoted {
+						return fmt.Sprintf("%s.%s", p.name, ident.Name)
+					}
+				}
+			}
+		}
+	}
+
+	return quoted
+}
+
+func fieldByName(s *ast.StructType, name string) *ast.Field {
+	for _, field := range s.Fields.List {
+		for _, ident := range field.Names {
+			if ident.Name == name {
+				return field
+			}
+		}
+	}
+
+	return nil
+}
+
+func loadAWSServicePackage(servicePackage string) (*Package, error) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedSyntax,
+	}
+
+	for _, name := range awsServiceNameCandidates(servicePackage) {
+		path := fmt.Sprintf("github.com/aws/aws-sdk-go/service/%s", name)
+		pkgs, err := packages.Load(cfg, path)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(pkgs) == 1 && len(pkgs[0].Errors) == 0 && len(pkgs[0].Syntax) > 0 {
+			return &Package{
+				name:  pkgs[0].Name,
+				path:  path,
+				files: pkgs[0].Syntax,
+			}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unable to find AWS service package for %s", servicePackage)
+}
+
+func awsServiceNameCandidates(s string) []string {
+	s = strings.ToLower(s)
+
+	switch s {
+	case "amp":
+		return []string{"prometheusservice"}
+	case "cloudcontrol":
+		return []string{"cloudcontrolapi"}
+	case "cognitoidp":
+		return []string{"cognitoidentityprovider"}
+	case "dms":
+		return []string{"databasemigrationservice"}
+	case "ds":
+		return []string{"directoryservice"}
+	case "events":
+		return []string{"eventbridge"}
+	case "lexmodels":
+		return []string{"lexmodelbuildingservice"}
+	case "serverlessrepo":
+		return []string{"serverlessapplicationrepository"}
+	}
+
+	return []string{s, fmt.Sprintf("%sservice", s)}
+}
+
+// parameters returns the command line arguments, quoting any containing spaces.
+func parameters(args []string) string {
+	for i, arg := range args {
+		if strings.Contains(arg, " ") {
+			if parts := strings.SplitN(arg, "=", 2); len(parts) == 2 {
+				args[i] = fmt.Sprintf("%s=%q", parts[0], parts[1])
+			}
+		}
+	}
+
+	return strings.Join(args, " ")
+}
+
+var snakeCaseRegexp = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+func snakeCase(s string) string {
+	return strings.ToLower(snakeCaseRegexp.ReplaceAllString(s, "${1}_${2}"))
+}
+
+const finderTemplate = `// Code generated by "internal/generate/finder/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .DestinationPackage }}
+
+import (
+{{- if .Context }}
+	"context"
+
+{{ end }}
+{{- if or .IDField .Paginator }}
+	"github.com/aws/aws-sdk-go/aws"
+{{- end }}
+	"{{ .SourcePackage }}"
+{{- if .NotFoundCodes }}
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+{{- end }}
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+{{- $ctxParam := "" }}
+{{- $ctxArg := "" }}
+{{- $withContext := "" }}
+{{- if .Context }}
+{{- $ctxParam = "ctx context.Context, " }}
+{{- $ctxArg = "ctx, " }}
+{{- $withContext = "WithContext" }}
+{{- end }}
+
+{{ if .IDField }}
+// Find{{ .Name }}ByID returns the {{ .Name }} with the specified ID.
+func Find{{ .Name }}ByID({{ $ctxParam }}conn {{ .RecvType }}, id string) ({{ .ItemType }}, error) {
+	input := &{{ slice .InputType 1 }}{
+	{{- if .IDSlice }}
+		{{ .IDField }}: aws.StringSlice([]string{id}),
+	{{- else }}
+		{{ .IDField }}: aws.String(id),
+	{{- end }}
+	}
+
+	return Find{{ .Name }}({{ $ctxArg }}conn, input)
+}
+{{ end }}
+
+// Find{{ .Name }} returns the single {{ .Name }} matching the input.
+// A NotFoundError is returned if there is no match or more than one.
+func Find{{ .Name }}({{ $ctxParam }}conn {{ .RecvType }}, input {{ .InputType }}) ({{ .ItemType }}, error) {
+	output, err := Find{{ .PluralName }}({{ $ctxArg }}conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+// Find{{ .PluralName }} returns all {{ .PluralName }} matching the input.
+func Find{{ .PluralName }}({{ $ctxParam }}conn {{ .RecvType }}, input {{ .InputType }}) ([]{{ .ItemType }}, error) {
+	var output []{{ .ItemType }}
+
+{{- if .Pages }}
+
+	err := conn.{{ .Op }}Pages{{ $withContext }}({{ $ctxArg }}input, func(page {{ .OutputType }}, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.{{ .ListField }} {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+{{- else if .Paginator }}
+
+	var err error
+
+	for {
+		var page {{ .OutputType }}
+
+		page, err = conn.{{ .Op }}{{ $withContext }}({{ $ctxArg }}input)
+
+		if err != nil {
+			break
+		}
+
+		for _, v := range page.{{ .ListField }} {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if aws.StringValue(page.{{ .Paginator }}) == "" {
+			break
+		}
+
+		input.{{ .Paginator }} = page.{{ .Paginator }}
+	}
+{{- else }}
+
+	page, err := conn.{{ .Op }}{{ $withContext }}({{ $ctxArg }}input)
+
+	if err == nil && page != nil {
+		for _, v := range page.{{ .ListField }} {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+	}
+{{- end }}
+
+{{ if .NotFoundCodes }}
+	if tfawserr.ErrCodeEquals(err, {{ range $i, $code := .NotFoundCodes }}{{ if $i }}, {{ end }}{{ $code }}{{ end }}) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+{{ end }}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+{{ if .DataSourceType }}
+// find{{ .Name }}ForDataSource returns the single {{ .Name }} matching the input,
+// with the standard error messages of a singular data source.
+func find{{ .Name }}ForDataSource({{ $ctxParam }}conn {{ .RecvType }}, input {{ .InputType }}) ({{ .ItemType }}, error) {
+	output, err := Find{{ .Name }}({{ $ctxArg }}conn, input)
+
+	if err != nil {
+		return nil, tfresource.SingularDataSourceFindError("{{ .DataSourceType }}", err)
+	}
+
+	return output, nil
+}
+{{ end }}
+`
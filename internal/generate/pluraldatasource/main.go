@@ -0,0 +1,682 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	connsPackage = "github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+var (
+	op           = flag.String("Op", "", "name of the AWS SDK operation which lists the resources")
+	name         = flag.String("Name", "", "plural name of the resource, used in function names, e.g. Functions")
+	typeName     = flag.String("TypeName", "", "Terraform data source type name, e.g. aws_lambda_functions")
+	humanName    = flag.String("HumanName", "", "human-readable plural name of the resource used in error messages, e.g. \"Lambda Functions\"")
+	listField    = flag.String("ListField", "", "name of the output field containing the resources, if not the only list of structures")
+	arnField     = flag.String("ARNField", "", "name of the resource field whose values are returned in the arns attribute")
+	idField      = flag.String("IDField", "", "name of the resource field whose values are returned in the ids attribute")
+	nameField    = flag.String("NameField", "", "name of the resource field whose values are returned in the names attribute")
+	filters      = flag.Bool("Filters", false, "whether to add filter configuration blocks")
+	filtersField = flag.String("FiltersField", "Filters", "name of the input field containing filters")
+	tags         = flag.Bool("Tags", false, "whether to add a tags argument which resources must match")
+	tagsField    = flag.String("TagsField", "", "name of the resource field containing tags, if not listed with ListTags")
+	paginator    = flag.String("Paginator", "NextToken", "name of the pagination token field")
+	connName     = flag.String("ConnName", "", "name of the AWSClient method returning the service client, if it cannot be determined")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+type Attribute struct {
+	Name  string // Terraform attribute name, also used as the Go variable name, e.g. "arns".
+	Field string // AWS SDK field name, e.g. "FunctionArn".
+}
+
+type TemplateData struct {
+	Parameters         string
+	DestinationPackage string
+	SourcePackage      string
+
+	Attributes     []Attribute
+	ConnName       string
+	Filters        bool
+	FiltersField   string
+	FiltersFunc    string
+	HumanName      string
+	InputType      string
+	ItemType       string
+	ListField      string
+	Name           string
+	Op             string
+	OutputType     string
+	Pages          bool // The AWS SDK defines <Op>Pages.
+	Paginator      string
+	SDKPackageName string
+	ServicePrefix  string
+	Tags           bool
+	TagsARNField   string
+	TagsField      string
+	TypeName       string
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	if *op == "" || *name == "" || *typeName == "" || *humanName == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if *arnField == "" && *idField == "" && *nameField == "" {
+		log.Fatalf("at least one of -ARNField, -IDField or -NameField must be set")
+	}
+
+	wd, err := os.Getwd()
+
+	if err != nil {
+		log.Fatalf("unable to get working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+	pkg, err := loadAWSServicePackage(servicePackage)
+
+	if err != nil {
+		log.Fatalf("encountered: %s", err)
+	}
+
+	templateData := TemplateData{
+		Parameters:         parameters(os.Args[1:]),
+		DestinationPackage: servicePackage,
+		SourcePackage:      pkg.path,
+		ConnName:           *connName,
+		Filters:            *filters,
+		FiltersField:       *filtersField,
+		HumanName:          *humanName,
+		Name:               *name,
+		Op:                 *op,
+		SDKPackageName:     pkg.name,
+		Tags:               *tags,
+		TagsField:          *tagsField,
+		TypeName:           *typeName,
+	}
+
+	if err := templateData.resolve(pkg); err != nil {
+		log.Fatalf("error generating data source for %s: %s", *op, err)
+	}
+
+	filename := fmt.Sprintf("%s_data_source_gen.go", snakeCase(*name))
+
+	if err := writeTemplate(filename, dataSourceTemplate, templateData); err != nil {
+		log.Fatalf("error writing %s: %s", filename, err)
+	}
+
+	// The acceptance test is a starting point to be completed by hand, so is never overwritten.
+	testFilename := fmt.Sprintf("%s_data_source_test.go", snakeCase(*name))
+
+	if _, err := os.Stat(testFilename); os.IsNotExist(err) {
+		if err := writeTemplate(testFilename, testTemplate, templateData); err != nil {
+			log.Fatalf("error writing %s: %s", testFilename, err)
+		}
+	}
+}
+
+// resolve fills in the template data from the declarations of the AWS SDK operation.
+func (d *TemplateData) resolve(pkg *Package) error {
+	function := pkg.method(d.Op)
+
+	if function == nil {
+		return fmt.Errorf("operation not found in %s", d.SourcePackage)
+	}
+
+	if function.Type.Params.NumFields() != 1 || function.Type.Results.NumFields() != 2 {
+		return fmt.Errorf("unexpected signature")
+	}
+
+	d.InputType = pkg.expandType(function.Type.Params.List[0].Type)
+	d.OutputType = pkg.expandType(function.Type.Results.List[0].Type)
+	d.Pages = pkg.method(d.Op+"Pages") != nil
+
+	input := pkg.structOf(function.Type.Params.List[0].Type)
+	output := pkg.structOf(function.Type.Results.List[0].Type)
+
+	if input == nil || output == nil {
+		return fmt.Errorf("unexpected signature")
+	}
+
+	if !d.Pages {
+		if fieldByName(input, *paginator) != nil && fieldByName(output, *paginator) != nil {
+			d.Paginator = *paginator
+		}
+	}
+
+	var item *ast.StructType
+
+	for _, field := range output.Fields.List {
+		if len(field.Names) != 1 {
+			continue
+		}
+
+		fieldName := field.Names[0].Name
+
+		if *listField != "" && fieldName != *listField {
+			continue
+		}
+
+		array, ok := field.Type.(*ast.ArrayType)
+
+		if !ok || pkg.structOf(array.Elt) == nil {
+			continue
+		}
+
+		if d.ListField != "" {
+			return fmt.Errorf("output has more than one list of structures (%s, %s), use -ListField", d.ListField, fieldName)
+		}
+
+		d.ListField = fieldName
+		d.ItemType = pkg.expandType(array.Elt)
+		item = pkg.structOf(array.Elt)
+	}
+
+	if d.ListField == "" {
+		return fmt.Errorf("output has no list of structures")
+	}
+
+	for _, v := range []struct {
+		attribute, field string
+	}{
+		{"arns", *arnField},
+		{"ids", *idField},
+		{"names", *nameField},
+	} {
+		if v.field == "" {
+			continue
+		}
+
+		if field := fieldByName(item, v.field); field == nil || pkg.expandType(field.Type) != "*string" {
+			return fmt.Errorf("%s has no string field %s", d.ItemType, v.field)
+		}
+
+		d.Attributes = append(d.Attributes, Attribute{Name: v.attribute, Field: v.field})
+	}
+
+	if d.Filters {
+		if fieldByName(input, d.FiltersField) == nil {
+			return fmt.Errorf("input has no field %s", d.FiltersField)
+		}
+
+		// Matches the function names generated by namevaluesfilters/generators/servicefilters.
+		d.FiltersFunc = fmt.Sprintf("%s%sFilters", strings.ToUpper(pkg.name[:1]), pkg.name[1:])
+	}
+
+	if d.Tags {
+		if d.TagsField != "" {
+			if fieldByName(item, d.TagsField) == nil {
+				return fmt.Errorf("%s has no field %s", d.ItemType, d.TagsField)
+			}
+		} else if *arnField == "" {
+			return fmt.Errorf("-Tags without -TagsField requires -ARNField to list tags")
+		} else {
+			d.TagsARNField = *arnField
+		}
+	}
+
+	if d.ConnName == "" {
+		client := pkg.expandType(function.Recv.List[0].Type)
+		conn, err := findConnName(client)
+
+		if err != nil {
+			return err
+		}
+
+		d.ConnName = conn
+	}
+
+	d.ServicePrefix = strings.TrimSuffix(d.ConnName, "Conn")
+
+	return nil
+}
+
+type Package struct {
+	name  string
+	path  string
+	files []*ast.File
+}
+
+// method returns the declaration of the named method of the AWS SDK client.
+func (p *Package) method(name string) *ast.FuncDecl {
+	for _, file := range p.files {
+		for _, decl := range file.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Recv != nil && funcDecl.Name.Name == name {
+				return funcDecl
+			}
+		}
+	}
+
+	return nil
+}
+
+// structOf returns the declaration of the package's structure type, or of the structure pointed to.
+func (p *Package) structOf(expr ast.Expr) *ast.StructType {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+
+	ident, ok := expr.(*ast.Ident)
+
+	if !ok {
+		return nil
+	}
+
+	for _, file := range p.files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				if typeSpec := spec.(*ast.TypeSpec); typeSpec.Name.Name == ident.Name {
+					structType, _ := typeSpec.Type.(*ast.StructType)
+
+					return structType
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// expandType returns the type expression as used outside the package.
+func (p *Package) expandType(expr ast.Expr) string {
+	switch v := expr.(type) {
+	case *ast.StarExpr:
+		return "*" + p.expandType(v.X)
+	case *ast.ArrayType:
+		return "[]" + p.expandType(v.Elt)
+	case *ast.MapType:
+		return fmt.Sprintf("map[%s]%s", p.expandType(v.Key), p.expandType(v.Value))
+	case *ast.Ident:
+		if ast.IsExported(v.Name) {
+			return fmt.Sprintf("%s.%s", p.name, v.Name)
+		}
+
+		return v.Name
+	}
+
+	log.Fatalf("Unexpected type expression: (%[1]T) %[1]v", expr)
+	return ""
+}
+
+func fieldByName(s *ast.StructType, name string) *ast.Field {
+	for _, field := range s.Fields.List {
+		for _, ident := range field.Names {
+			if ident.Name == name {
+				return field
+			}
+		}
+	}
+
+	return nil
+}
+
+// findConnName returns the name of the AWSClient method returning the AWS SDK client type, e.g. "LambdaConn".
+func findConnName(client string) (string, error) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedSyntax,
+	}
+
+	pkgs, err := packages.Load(cfg, connsPackage)
+
+	if err != nil {
+		return "", err
+	}
+
+	if len(pkgs) != 1 {
+		return "", fmt.Errorf("%d packages found for %s", len(pkgs), connsPackage)
+	}
+
+	for _, file := range pkgs[0].Syntax {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+
+			if !ok || funcDecl.Recv == nil || !strings.HasSuffix(funcDecl.Name.Name, "Conn") {
+				continue
+			}
+
+			if funcDecl.Type.Results.NumFields() != 1 {
+				continue
+			}
+
+			star, ok := funcDecl.Type.Results.List[0].Type.(*ast.StarExpr)
+
+			if !ok {
+				continue
+			}
+
+			if sel, ok := star.X.(*ast.SelectorExpr); ok && fmt.Sprintf("*%s.%s", sel.X, sel.Sel.Name) == client {
+				return funcDecl.Name.Name, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("no AWSClient method returns %s, use -ConnName", client)
+}
+
+func loadAWSServicePackage(servicePackage string) (*Package, error) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedSyntax,
+	}
+
+	for _, name := range awsServiceNameCandidates(servicePackage) {
+		path := fmt.Sprintf("github.com/aws/aws-sdk-go/service/%s", name)
+		pkgs, err := packages.Load(cfg, path)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(pkgs) == 1 && len(pkgs[0].Errors) == 0 && len(pkgs[0].Syntax) > 0 {
+			return &Package{
+				name:  pkgs[0].Name,
+				path:  path,
+				files: pkgs[0].Syntax,
+			}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unable to find AWS service package for %s", servicePackage)
+}
+
+func awsServiceNameCandidates(s string) []string {
+	s = strings.ToLower(s)
+
+	switch s {
+	case "amp":
+		return []string{"prometheusservice"}
+	case "cloudcontrol":
+		return []string{"cloudcontrolapi"}
+	case "cognitoidp":
+		return []string{"cognitoidentityprovider"}
+	case "dms":
+		return []string{"databasemigrationservice"}
+	case "ds":
+		return []string{"directoryservice"}
+	case "events":
+		return []string{"eventbridge"}
+	case "lexmodels":
+		return []string{"lexmodelbuildingservice"}
+	case "serverlessrepo":
+		return []string{"serverlessapplicationrepository"}
+	}
+
+	return []string{s, fmt.Sprintf("%sservice", s)}
+}
+
+// parameters returns the command line arguments, quoting any containing spaces.
+func parameters(args []string) string {
+	for i, arg := range args {
+		if strings.Contains(arg, " ") {
+			if parts := strings.SplitN(arg, "=", 2); len(parts) == 2 {
+				args[i] = fmt.Sprintf("%s=%q", parts[0], parts[1])
+			}
+		}
+	}
+
+	return strings.Join(args, " ")
+}
+
+var snakeCaseRegexp = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+func snakeCase(s string) string {
+	return strings.ToLower(snakeCaseRegexp.ReplaceAllString(s, "${1}_${2}"))
+}
+
+func writeTemplate(filename, body string, data TemplateData) error {
+	var buf bytes.Buffer
+
+	if err := template.Must(template.New(filename).Parse(body)).Execute(&buf, data); err != nil {
+		return fmt.Errorf("error executing template: %w", err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+
+	if err != nil {
+		log.Printf("warning: internal error: invalid Go generated: %s", err)
+		log.Printf("warning: compile the package to analyze the error")
+		src = buf.Bytes()
+	}
+
+	return os.WriteFile(filename, src, 0644)
+}
+
+const dataSourceTemplate = `// Code generated by "internal/generate/pluraldatasource/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .DestinationPackage }}
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"{{ .SourcePackage }}"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+{{- if .Filters }}
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/namevaluesfilters"
+{{- end }}
+{{- if .Tags }}
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+{{- end }}
+)
+
+func DataSource{{ .Name }}() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSource{{ .Name }}Read,
+
+		Schema: map[string]*schema.Schema{
+{{- range .Attributes }}
+{{- if eq .Name "arns" }}
+			"arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+{{- end }}
+{{- end }}
+{{- if .Filters }}
+			"filter": namevaluesfilters.Schema(),
+{{- end }}
+{{- range .Attributes }}
+{{- if ne .Name "arns" }}
+			"{{ .Name }}": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+{{- end }}
+{{- end }}
+{{- if .Tags }}
+			"tags": tftags.TagsSchema(),
+{{- end }}
+		},
+	}
+}
+
+func dataSource{{ .Name }}Read(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).{{ .ConnName }}()
+
+	input := &{{ slice .InputType 1 }}{}
+{{- if .Filters }}
+
+	if v, ok := d.GetOk("filter"); ok {
+		input.{{ .FiltersField }} = namevaluesfilters.New(v.(*schema.Set)).{{ .FiltersFunc }}()
+	}
+{{- end }}
+
+	var results []{{ .ItemType }}
+
+{{- if .Pages }}
+
+	err := conn.{{ .Op }}Pages(input, func(page {{ .OutputType }}, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.{{ .ListField }} {
+			if v != nil {
+				results = append(results, v)
+			}
+		}
+
+		return !lastPage
+	})
+{{- else if .Paginator }}
+
+	var err error
+
+	for {
+		var page {{ .OutputType }}
+
+		page, err = conn.{{ .Op }}(input)
+
+		if err != nil {
+			break
+		}
+
+		for _, v := range page.{{ .ListField }} {
+			if v != nil {
+				results = append(results, v)
+			}
+		}
+
+		if aws.StringValue(page.{{ .Paginator }}) == "" {
+			break
+		}
+
+		input.{{ .Paginator }} = page.{{ .Paginator }}
+	}
+{{- else }}
+
+	page, err := conn.{{ .Op }}(input)
+
+	if err == nil && page != nil {
+		for _, v := range page.{{ .ListField }} {
+			if v != nil {
+				results = append(results, v)
+			}
+		}
+	}
+{{- end }}
+
+	if err != nil {
+		return fmt.Errorf("error reading {{ .HumanName }}: %w", err)
+	}
+{{- if .Tags }}
+
+	tagsToMatch := tftags.New(d.Get("tags").(map[string]interface{}))
+{{- end }}
+
+	var {{ range $i, $a := .Attributes }}{{ if $i }}, {{ end }}{{ $a.Name }}{{ end }} []string
+
+	for _, v := range results {
+{{- if .Tags }}
+		if len(tagsToMatch) > 0 {
+{{- if .TagsField }}
+			tags := KeyValueTags(v.{{ .TagsField }})
+{{- else }}
+			arn := aws.StringValue(v.{{ .TagsARNField }})
+			tags, err := ListTags(conn, arn)
+
+			if err != nil {
+				return fmt.Errorf("error listing tags for %s: %w", arn, err)
+			}
+{{- end }}
+
+			if !tags.ContainsAll(tagsToMatch) {
+				continue
+			}
+		}
+
+{{ end }}
+{{- range .Attributes }}
+		{{ .Name }} = append({{ .Name }}, aws.StringValue(v.{{ .Field }}))
+{{- end }}
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+{{- range .Attributes }}
+
+	if err := d.Set("{{ .Name }}", {{ .Name }}); err != nil {
+		return fmt.Errorf("error setting {{ .Name }}: %w", err)
+	}
+{{- end }}
+
+	return nil
+}
+`
+
+const testTemplate = `package {{ .DestinationPackage }}_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/{{ .SDKPackageName }}"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAcc{{ .ServicePrefix }}{{ .Name }}DataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.{{ .TypeName }}.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, {{ .SDKPackageName }}.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAcc{{ .Name }}DataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+{{- range .Attributes }}
+					resource.TestCheckResourceAttrSet(dataSourceName, "{{ .Name }}.#"),
+{{- end }}
+				),
+			},
+		},
+	})
+}
+
+func testAcc{{ .Name }}DataSourceConfig(rName string) string {
+	return fmt.Sprintf(` + "`" + `
+# TODO: Create resources named %[1]q for the data source to find.
+
+data "{{ .TypeName }}" "test" {}
+` + "`" + `, rName)
+}
+`
@@ -0,0 +1,233 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+var (
+	name             = flag.String("Name", "", "name of the resource, used in function names, e.g. NodeGroup")
+	parts            = flag.String("Parts", "", "comma separated list of the ID part names, e.g. cluster-name,node-group-name")
+	separator        = flag.String("Separator", ":", "separator between ID parts")
+	expectedFormat   = flag.String("Format", "", "expected ID format in error messages, if not the part names joined by -Separator")
+	legacySeparators stringSlice
+	validators       stringSlice
+)
+
+func init() {
+	flag.Var(&legacySeparators, "LegacySeparator", "separator previously used between ID parts, tried when parsing if the ID does not match -Separator (may be repeated)")
+	flag.Var(&validators, "Validate", "<part-name>=<function-name>, a func(string) error in the destination package validating the part (may be repeated)")
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+type stringSlice []string
+
+func (s *stringSlice) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringSlice) Set(value string) error {
+	*s = append(*s, value)
+
+	return nil
+}
+
+type TemplatePart struct {
+	Name      string
+	Parameter string
+}
+
+type TemplateValidator struct {
+	PartName string
+	Function string
+}
+
+type TemplateData struct {
+	Parameters         string
+	DestinationPackage string
+
+	CreateFunc       string
+	Format           string
+	LegacySeparators []string
+	Name             string
+	ParseFunc        string
+	Parts            []TemplatePart
+	Separator        string
+	TemplateVar      string
+	Validators       []TemplateValidator
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	if *name == "" || *parts == "" || *separator == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	wd, err := os.Getwd()
+
+	if err != nil {
+		log.Fatalf("unable to get working directory: %s", err)
+	}
+
+	templateData := TemplateData{
+		Parameters:         parameters(os.Args[1:]),
+		DestinationPackage: filepath.Base(wd),
+		CreateFunc:         *name + "CreateResourceID",
+		Format:             *expectedFormat,
+		LegacySeparators:   legacySeparators,
+		Name:               *name,
+		ParseFunc:          *name + "ParseResourceID",
+		Separator:          *separator,
+		TemplateVar:        lowerFirst(*name) + "ResourceIDTemplate",
+	}
+
+	partNames := map[string]bool{}
+
+	for _, partName := range strings.Split(*parts, ",") {
+		if partName == "" || partNames[partName] {
+			log.Fatalf("invalid -Parts: %q", *parts)
+		}
+
+		partNames[partName] = true
+		templateData.Parts = append(templateData.Parts, TemplatePart{
+			Name:      partName,
+			Parameter: camelCase(partName),
+		})
+	}
+
+	for _, validator := range validators {
+		kv := strings.SplitN(validator, "=", 2)
+
+		if len(kv) != 2 || kv[1] == "" || !partNames[kv[0]] {
+			log.Fatalf("invalid -Validate: %q", validator)
+		}
+
+		templateData.Validators = append(templateData.Validators, TemplateValidator{
+			PartName: kv[0],
+			Function: kv[1],
+		})
+	}
+
+	var buf bytes.Buffer
+
+	if err := template.Must(template.New("resourceid").Parse(resourceIDTemplate)).Execute(&buf, templateData); err != nil {
+		log.Fatalf("error executing template: %s", err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+
+	if err != nil {
+		log.Printf("warning: internal error: invalid Go generated: %s", err)
+		log.Printf("warning: compile the package to analyze the error")
+		src = buf.Bytes()
+	}
+
+	filename := fmt.Sprintf("%s_resource_id_gen.go", snakeCase(*name))
+
+	if err := os.WriteFile(filename, src, 0644); err != nil {
+		log.Fatalf("error writing output: %s", err)
+	}
+}
+
+// parameters returns the command line arguments, quoting any containing spaces.
+func parameters(args []string) string {
+	for i, arg := range args {
+		if strings.Contains(arg, " ") {
+			if parts := strings.SplitN(arg, "=", 2); len(parts) == 2 {
+				args[i] = fmt.Sprintf("%s=%q", parts[0], parts[1])
+			}
+		}
+	}
+
+	return strings.Join(args, " ")
+}
+
+var snakeCaseRegexp = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+func snakeCase(s string) string {
+	return strings.ToLower(snakeCaseRegexp.ReplaceAllString(s, "${1}_${2}"))
+}
+
+// camelCase converts a kebab-case part name to a Go parameter name, e.g. "cluster-name" to "clusterName".
+func camelCase(s string) string {
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return r == '-' || r == '_'
+	})
+
+	for i, word := range words {
+		if i == 0 {
+			words[i] = strings.ToLower(word)
+			continue
+		}
+
+		switch word = strings.ToLower(word); word {
+		case "arn", "id":
+			words[i] = strings.ToUpper(word)
+		default:
+			words[i] = strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+
+	return strings.Join(words, "")
+}
+
+func lowerFirst(s string) string {
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+const resourceIDTemplate = `// Code generated by "internal/generate/resourceid/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .DestinationPackage }}
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/resourceid"
+)
+
+var {{ .TemplateVar }} = resourceid.New({{ printf "%q" .Separator }}{{ range .Parts }}, {{ printf "%q" .Name }}{{ end }})
+{{- if .Format }}.
+	WithFormat({{ printf "%q" .Format }})
+{{- end }}
+{{- if .LegacySeparators }}.
+	WithLegacySeparators({{ range $i, $e := .LegacySeparators }}{{ if $i }}, {{ end }}{{ printf "%q" $e }}{{ end }})
+{{- end }}
+{{- range .Validators }}.
+	WithValidation({{ printf "%q" .PartName }}, {{ .Function }})
+{{- end }}
+
+// {{ .CreateFunc }} returns the {{ .Name }} resource ID made up of the specified parts.
+func {{ .CreateFunc }}({{ range $i, $e := .Parts }}{{ if $i }}, {{ end }}{{ .Parameter }}{{ end }} string) string {
+	return {{ .TemplateVar }}.Create({{ range $i, $e := .Parts }}{{ if $i }}, {{ end }}{{ .Parameter }}{{ end }})
+}
+
+// {{ .ParseFunc }} returns the parts of the specified {{ .Name }} resource ID.
+func {{ .ParseFunc }}(id string) ({{ range .Parts }}string, {{ end }}error) {
+	parts, err := {{ .TemplateVar }}.Parse(id)
+
+	if err != nil {
+		return {{ range .Parts }}"", {{ end }}err
+	}
+
+	return {{ range $i, $e := .Parts }}parts[{{ $i }}], {{ end }}nil
+}
+`
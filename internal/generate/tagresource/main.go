@@ -154,7 +154,7 @@ func ResourceTag() *schema.Resource {
 }
 
 func resourceTagCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn()
 
 	identifier := d.Get("{{ .IDAttribName }}").(string)
 	key := d.Get("key").(string)
@@ -163,7 +163,7 @@ func resourceTagCreate(d *schema.ResourceData, meta interface{}) error {
 	{{ if eq .ServicePackage "ec2" }}
 	if err := CreateTags(conn, identifier, map[string]string{key: value}); err != nil {
 	{{- else }}
-	if err := UpdateTags(conn, identifier, nil, map[string]string{key: value}); err != nil {
+	if err := UpdateTags(conn, identifier, nil, map[string]string{key: value}, nil); err != nil {
 	{{- end }}
 		return fmt.Errorf("error creating %s resource (%s) tag (%s): %w", {{ .ServicePackage }}.ServiceID, identifier, key, err)
 	}
@@ -174,7 +174,7 @@ func resourceTagCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTagRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn()
 	identifier, key, err := tftags.GetResourceID(d.Id())
 
 	if err != nil {
@@ -201,14 +201,14 @@ func resourceTagRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTagUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn()
 	identifier, key, err := tftags.GetResourceID(d.Id())
 
 	if err != nil {
 		return err
 	}
 
-	if err := UpdateTags(conn, identifier, nil, map[string]string{key: d.Get("value").(string)}); err != nil {
+	if err := UpdateTags(conn, identifier, nil, map[string]string{key: d.Get("value").(string)}, nil); err != nil {
 		return fmt.Errorf("error updating %s resource (%s) tag (%s): %w", {{ .ServicePackage }}.ServiceID, identifier, key, err)
 	}
 
@@ -216,14 +216,14 @@ func resourceTagUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTagDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn()
 	identifier, key, err := tftags.GetResourceID(d.Id())
 
 	if err != nil {
 		return err
 	}
 
-	if err := UpdateTags(conn, identifier, map[string]string{key: d.Get("value").(string)}, nil); err != nil {
+	if err := UpdateTags(conn, identifier, map[string]string{key: d.Get("value").(string)}, nil, nil); err != nil {
 		return fmt.Errorf("error deleting %s resource (%s) tag (%s): %w", {{ .ServicePackage }}.ServiceID, identifier, key, err)
 	}
 
@@ -249,7 +249,7 @@ import (
 )
 
 func testAccCheckTagDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).{{ .AWSServiceUpper }}Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_{{ .ServicePackage }}_tag" {
@@ -295,7 +295,7 @@ func testAccCheckTagExists(resourceName string) resource.TestCheckFunc {
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).{{ .AWSServiceUpper }}Conn()
 
 		_, err = tf{{ .ServicePackage }}.GetTag(conn, identifier, key)
 
@@ -649,14 +649,16 @@ var updatetagsBody = `
 // UpdateTags updates {{ .ServicePackage }} service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
+// Tags matching the key_regexes, case_insensitive_keys or key_value_pairs of the ignore
+// configuration, if any, are neither added nor removed.
 {{- if  .TagTypeAddBoolElem }}
-func UpdateTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }}, resourceType string{{ end }}, oldTagsSet interface{}, newTagsSet interface{}) error {
-	oldTags := KeyValueTags(oldTagsSet, identifier{{ if .TagResTypeElem }}, resourceType{{ end }})
-	newTags := KeyValueTags(newTagsSet, identifier{{ if .TagResTypeElem }}, resourceType{{ end }})
+func UpdateTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }}, resourceType string{{ end }}, oldTagsSet interface{}, newTagsSet interface{}, ignoreConfig *tftags.IgnoreConfig) error {
+	oldTags := KeyValueTags(oldTagsSet, identifier{{ if .TagResTypeElem }}, resourceType{{ end }}).IgnoreConfigMatchers(ignoreConfig)
+	newTags := KeyValueTags(newTagsSet, identifier{{ if .TagResTypeElem }}, resourceType{{ end }}).IgnoreConfigMatchers(ignoreConfig)
 {{- else }}
-func UpdateTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }}, resourceType string{{ end }}, oldTagsMap interface{}, newTagsMap interface{}) error {
-	oldTags := tftags.New(oldTagsMap)
-	newTags := tftags.New(newTagsMap)
+func UpdateTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }}, resourceType string{{ end }}, oldTagsMap interface{}, newTagsMap interface{}, ignoreConfig *tftags.IgnoreConfig) error {
+	oldTags := tftags.New(oldTagsMap).IgnoreConfigMatchers(ignoreConfig)
+	newTags := tftags.New(newTagsMap).IgnoreConfigMatchers(ignoreConfig)
 {{- end }}
 	{{- if eq (.TagOp) (.UntagOp) }}
 	removedTags := oldTags.Removed(newTags)
@@ -0,0 +1,358 @@
+// Package metrics collects API call and polling metrics and writes them as a JSON summary.
+//
+// Terraform does not expose resource addresses to providers, so API calls are keyed by service and operation
+// and polling by the provider function that started it.
+//
+// Polling is only recorded for the tfresource retry and wait helpers that call Poll. Waiters that use
+// resource.StateChangeConf directly are not recorded as polls, but their API calls are still summarized.
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	completeHandlerName = "terraform-provider-aws.MetricsComplete"
+	retryHandlerName    = "terraform-provider-aws.MetricsRetry"
+
+	// flushDelay is how long after a metric is recorded that the summary file is rewritten.
+	flushDelay = 2 * time.Second
+)
+
+// APICallSummary is the summary of calls to a single AWS API operation.
+type APICallSummary struct {
+	Service           string  `json:"service"`
+	Operation         string  `json:"operation"`
+	Calls             int     `json:"calls"`
+	Errors            int     `json:"errors"`
+	Retries           int     `json:"retries"`
+	Throttles         int     `json:"throttles"`
+	TotalDurationMS   float64 `json:"total_duration_ms"`
+	AverageDurationMS float64 `json:"average_duration_ms"`
+	MaxDurationMS     float64 `json:"max_duration_ms"`
+}
+
+// PollSummary is the summary of tfresource retry or wait loops started from a single provider function.
+type PollSummary struct {
+	Helper            string  `json:"helper"`
+	Caller            string  `json:"caller"`
+	Calls             int     `json:"calls"`
+	Errors            int     `json:"errors"`
+	TotalDurationMS   float64 `json:"total_duration_ms"`
+	AverageDurationMS float64 `json:"average_duration_ms"`
+	MaxDurationMS     float64 `json:"max_duration_ms"`
+}
+
+// Summary is the content of the metrics file.
+// Entries are sorted by total duration, longest first.
+type Summary struct {
+	APICalls []*APICallSummary `json:"api_calls"`
+	Polls    []*PollSummary    `json:"polls"`
+}
+
+type apiCallKey struct {
+	service, operation string
+}
+
+type pollKey struct {
+	helper, caller string
+}
+
+// Collector accumulates metrics and periodically writes them to a file.
+// A Collector without an output path discards all metrics.
+type Collector struct {
+	apiCalls map[apiCallKey]*APICallSummary
+	mutex    sync.Mutex
+	path     string
+	polls    map[pollKey]*PollSummary
+	timer    *time.Timer
+}
+
+// NewCollector returns a new, disabled, Collector.
+func NewCollector() *Collector {
+	return &Collector{
+		apiCalls: make(map[apiCallKey]*APICallSummary),
+		polls:    make(map[pollKey]*PollSummary),
+	}
+}
+
+// DefaultCollector is the Collector shared by all provider configurations in the process.
+var DefaultCollector = NewCollector()
+
+// SetOutputPath enables metrics collection, writing the summary to the specified path.
+func (c *Collector) SetOutputPath(path string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.path != "" && c.path != path {
+		log.Printf("[WARN] Changing API metrics file from %s to %s", c.path, path)
+	}
+
+	c.path = path
+}
+
+// Enabled returns whether metrics are being collected.
+func (c *Collector) Enabled() bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.path != ""
+}
+
+// RecordAPICall records a completed API call.
+func (c *Collector) RecordAPICall(service, operation string, duration time.Duration, retries int, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.path == "" {
+		return
+	}
+
+	key := apiCallKey{service: service, operation: operation}
+	v, ok := c.apiCalls[key]
+
+	if !ok {
+		v = &APICallSummary{Service: service, Operation: operation}
+		c.apiCalls[key] = v
+	}
+
+	v.Calls++
+	v.Retries += retries
+	if err != nil {
+		v.Errors++
+	}
+	v.TotalDurationMS += milliseconds(duration)
+	v.AverageDurationMS = v.TotalDurationMS / float64(v.Calls)
+	if d := milliseconds(duration); d > v.MaxDurationMS {
+		v.MaxDurationMS = d
+	}
+
+	c.scheduleFlush()
+}
+
+// RecordThrottle records a throttled API call attempt.
+func (c *Collector) RecordThrottle(service, operation string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.path == "" {
+		return
+	}
+
+	key := apiCallKey{service: service, operation: operation}
+	v, ok := c.apiCalls[key]
+
+	if !ok {
+		v = &APICallSummary{Service: service, Operation: operation}
+		c.apiCalls[key] = v
+	}
+
+	v.Throttles++
+
+	c.scheduleFlush()
+}
+
+// RecordPoll records a completed retry or wait loop.
+func (c *Collector) RecordPoll(helper, caller string, duration time.Duration, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.path == "" {
+		return
+	}
+
+	key := pollKey{helper: helper, caller: caller}
+	v, ok := c.polls[key]
+
+	if !ok {
+		v = &PollSummary{Helper: helper, Caller: caller}
+		c.polls[key] = v
+	}
+
+	v.Calls++
+	if err != nil {
+		v.Errors++
+	}
+	v.TotalDurationMS += milliseconds(duration)
+	v.AverageDurationMS = v.TotalDurationMS / float64(v.Calls)
+	if d := milliseconds(duration); d > v.MaxDurationMS {
+		v.MaxDurationMS = d
+	}
+
+	c.scheduleFlush()
+}
+
+// Summary returns a snapshot of the collected metrics.
+func (c *Collector) Summary() *Summary {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.summary()
+}
+
+func (c *Collector) summary() *Summary {
+	summary := &Summary{
+		APICalls: make([]*APICallSummary, 0, len(c.apiCalls)),
+		Polls:    make([]*PollSummary, 0, len(c.polls)),
+	}
+
+	for _, v := range c.apiCalls {
+		v := *v
+		summary.APICalls = append(summary.APICalls, &v)
+	}
+	sort.Slice(summary.APICalls, func(i, j int) bool {
+		a, b := summary.APICalls[i], summary.APICalls[j]
+		if a.TotalDurationMS != b.TotalDurationMS {
+			return a.TotalDurationMS > b.TotalDurationMS
+		}
+		return a.Service+a.Operation < b.Service+b.Operation
+	})
+
+	for _, v := range c.polls {
+		v := *v
+		summary.Polls = append(summary.Polls, &v)
+	}
+	sort.Slice(summary.Polls, func(i, j int) bool {
+		a, b := summary.Polls[i], summary.Polls[j]
+		if a.TotalDurationMS != b.TotalDurationMS {
+			return a.TotalDurationMS > b.TotalDurationMS
+		}
+		return a.Helper+a.Caller < b.Helper+b.Caller
+	})
+
+	return summary
+}
+
+// scheduleFlush arranges for the summary to be written shortly.
+// Must be called with the mutex held.
+func (c *Collector) scheduleFlush() {
+	if c.timer != nil {
+		return
+	}
+
+	c.timer = time.AfterFunc(flushDelay, func() {
+		if err := c.Flush(); err != nil {
+			log.Printf("[WARN] %s", err)
+		}
+	})
+}
+
+// Flush writes the summary to the output path.
+func (c *Collector) Flush() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.timer != nil {
+		c.timer.Stop()
+		c.timer = nil
+	}
+
+	if c.path == "" {
+		return nil
+	}
+
+	b, err := json.MarshalIndent(c.summary(), "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("error encoding API metrics: %w", err)
+	}
+
+	// Write to a temporary file and rename so readers never see a partial summary.
+	f, err := ioutil.TempFile(filepath.Dir(c.path), filepath.Base(c.path)+".*.tmp")
+
+	if err != nil {
+		return fmt.Errorf("error writing API metrics file (%s): %w", c.path, err)
+	}
+
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return fmt.Errorf("error writing API metrics file (%s): %w", c.path, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error writing API metrics file (%s): %w", c.path, err)
+	}
+
+	if err := os.Rename(f.Name(), c.path); err != nil {
+		return fmt.Errorf("error writing API metrics file (%s): %w", c.path, err)
+	}
+
+	return nil
+}
+
+// AddHandlers adds request handlers recording the latency, retries and throttling of each API call.
+func (c *Collector) AddHandlers(handlers *request.Handlers) {
+	handlers.Retry.PushBackNamed(request.NamedHandler{
+		Name: retryHandlerName,
+		Fn: func(r *request.Request) {
+			if r.Error != nil && r.IsErrorThrottle() {
+				c.RecordThrottle(r.ClientInfo.ServiceID, r.Operation.Name)
+			}
+		},
+	})
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: completeHandlerName,
+		Fn: func(r *request.Request) {
+			c.RecordAPICall(r.ClientInfo.ServiceID, r.Operation.Name, time.Since(r.Time), r.RetryCount, r.Error)
+		},
+	})
+}
+
+// Poll returns a function that records a retry or wait loop started by the caller of the helper function.
+// Call the returned function with the loop's result when it completes.
+func Poll(helper string) func(error) {
+	if !DefaultCollector.Enabled() {
+		return func(error) {}
+	}
+
+	start := time.Now()
+	caller := externalCaller()
+
+	return func(err error) {
+		DefaultCollector.RecordPoll(helper, caller, time.Since(start), err)
+	}
+}
+
+// externalCaller returns the name of the first function on the stack outside the tfresource helper package.
+// It must be called directly from Poll.
+func externalCaller() string {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	for {
+		frame, more := frames.Next()
+		name := strings.TrimPrefix(frame.Function, "github.com/hashicorp/terraform-provider-aws/internal/")
+
+		if !strings.HasPrefix(name, "tfresource.") {
+			return name
+		}
+
+		if !more {
+			return name
+		}
+	}
+}
+
+// Flush writes the default collector's summary to its output path.
+func Flush() error {
+	return DefaultCollector.Flush()
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,185 @@
+package metrics
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+func TestCollectorDisabled(t *testing.T) {
+	c := NewCollector()
+
+	c.RecordAPICall("SQS", "ListQueues", time.Second, 0, nil)
+	c.RecordPoll("WaitUntil", "caller", time.Second, nil)
+
+	if summary := c.Summary(); len(summary.APICalls) != 0 || len(summary.Polls) != 0 {
+		t.Errorf("expected no metrics, got %d API calls and %d polls", len(summary.APICalls), len(summary.Polls))
+	}
+
+	if err := c.Flush(); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestCollectorFlush(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.json")
+	c := NewCollector()
+	c.SetOutputPath(path)
+
+	c.RecordAPICall("SQS", "ListQueues", 100*time.Millisecond, 0, nil)
+	c.RecordAPICall("SQS", "ListQueues", 300*time.Millisecond, 2, errors.New("test"))
+	c.RecordThrottle("SQS", "ListQueues")
+	c.RecordAPICall("EC2", "DescribeVpcs", time.Second, 0, nil)
+	c.RecordPoll("WaitUntil", "ec2.WaitVPCAvailable", 5*time.Second, nil)
+	c.RecordPoll("RetryWhen", "ec2.resourceVPCCreate", time.Second, nil)
+
+	if err := c.Flush(); err != nil {
+		t.Fatalf("error flushing: %s", err)
+	}
+
+	b, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("error reading metrics file: %s", err)
+	}
+
+	var summary Summary
+
+	if err := json.Unmarshal(b, &summary); err != nil {
+		t.Fatalf("error decoding metrics file: %s", err)
+	}
+
+	if got, want := len(summary.APICalls), 2; got != want {
+		t.Fatalf("got %d API call summaries, expected %d", got, want)
+	}
+
+	if got, want := summary.APICalls[0].Operation, "DescribeVpcs"; got != want {
+		t.Errorf("got slowest operation %s, expected %s", got, want)
+	}
+
+	got := summary.APICalls[1]
+	want := APICallSummary{
+		Service:           "SQS",
+		Operation:         "ListQueues",
+		Calls:             2,
+		Errors:            1,
+		Retries:           2,
+		Throttles:         1,
+		TotalDurationMS:   400,
+		AverageDurationMS: 200,
+		MaxDurationMS:     300,
+	}
+
+	if *got != want {
+		t.Errorf("got %+v, expected %+v", *got, want)
+	}
+
+	if got, want := len(summary.Polls), 2; got != want {
+		t.Fatalf("got %d poll summaries, expected %d", got, want)
+	}
+
+	if got, want := summary.Polls[0].Caller, "ec2.WaitVPCAvailable"; got != want {
+		t.Errorf("got slowest poll caller %s, expected %s", got, want)
+	}
+}
+
+func TestCollectorRecordThrottleSchedulesFlush(t *testing.T) {
+	c := NewCollector()
+	c.SetOutputPath(filepath.Join(t.TempDir(), "metrics.json"))
+
+	c.RecordThrottle("SQS", "ListQueues")
+
+	c.mutex.Lock()
+	scheduled := c.timer != nil
+	c.mutex.Unlock()
+
+	if !scheduled {
+		t.Error("expected a flush to be scheduled")
+	}
+
+	if err := c.Flush(); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestPoll(t *testing.T) {
+	defaultCollector := DefaultCollector
+	defer func() { DefaultCollector = defaultCollector }()
+
+	DefaultCollector = NewCollector()
+	DefaultCollector.SetOutputPath(filepath.Join(t.TempDir(), "metrics.json"))
+
+	Poll("WaitUntil")(errors.New("test"))
+
+	summary := DefaultCollector.Summary()
+
+	if got, want := len(summary.Polls), 1; got != want {
+		t.Fatalf("got %d poll summaries, expected %d", got, want)
+	}
+
+	if got, want := summary.Polls[0].Caller, "metrics.TestPoll"; got != want {
+		t.Errorf("got caller %s, expected %s", got, want)
+	}
+
+	if got, want := summary.Polls[0].Errors, 1; got != want {
+		t.Errorf("got %d errors, expected %d", got, want)
+	}
+}
+
+func TestCollectorAddHandlers(t *testing.T) {
+	var requests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`<ErrorResponse><Error><Type>Sender</Type><Code>Throttling</Code><Message>Rate exceeded</Message></Error><RequestId>1</RequestId></ErrorResponse>`)) //nolint:errcheck
+			return
+		}
+
+		w.Write([]byte(`<ListQueuesResponse><ListQueuesResult></ListQueuesResult></ListQueuesResponse>`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("mock_access_key", "mock_secret_key", ""),
+		Endpoint:    aws.String(server.URL),
+		Region:      aws.String("us-east-1"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	c := NewCollector()
+	c.SetOutputPath(filepath.Join(t.TempDir(), "metrics.json"))
+	c.AddHandlers(&sess.Handlers)
+
+	if _, err := sqs.New(sess).ListQueues(&sqs.ListQueuesInput{}); err != nil {
+		t.Fatalf("error listing queues: %s", err)
+	}
+
+	summary := c.Summary()
+
+	if got, want := len(summary.APICalls), 1; got != want {
+		t.Fatalf("got %d API call summaries, expected %d", got, want)
+	}
+
+	got := summary.APICalls[0]
+
+	if got.Service != sqs.ServiceID || got.Operation != "ListQueues" || got.Calls != 1 || got.Retries != 1 || got.Throttles != 1 || got.Errors != 0 {
+		t.Errorf("unexpected API call summary: %+v", *got)
+	}
+}
@@ -1,8 +1,10 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -190,6 +192,8 @@ func Provider() *schema.Provider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -222,6 +226,8 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"rate_limits": rateLimitsSchema(),
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -261,6 +267,12 @@ func Provider() *schema.Provider {
 				Description: descriptions["http_proxy"],
 			},
 
+			"metrics_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["metrics_file"],
+			},
+
 			"endpoints": endpointsSchema(),
 
 			"ignore_tags": {
@@ -284,6 +296,53 @@ func Provider() *schema.Provider {
 							Set:         schema.HashString,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_regexes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Set:         schema.HashString,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"case_insensitive_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource tag keys to ignore across all resources, regardless of case.",
+						},
+						"key_value_pairs": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tag keys and values to ignore across all resources. A tag is ignored only if both its key and value match.",
+						},
+					},
+				},
+			},
+
+			"required_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to enforce resource tags across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource tag keys required on all resources, after merging default tags.",
+						},
+						"value_regexes": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Map of resource tag keys to regular expressions that their values must match.",
+						},
 					},
 				},
 			},
@@ -588,6 +647,8 @@ func Provider() *schema.Provider {
 			"aws_lambda_alias":               lambda.DataSourceAlias(),
 			"aws_lambda_code_signing_config": lambda.DataSourceCodeSigningConfig(),
 			"aws_lambda_function":            lambda.DataSourceFunction(),
+			"aws_lambda_function_url":        lambda.DataSourceFunctionURL(),
+			"aws_lambda_functions":           lambda.DataSourceFunctions(),
 			"aws_lambda_invocation":          lambda.DataSourceInvocation(),
 			"aws_lambda_layer_version":       lambda.DataSourceLayerVersion(),
 
@@ -601,6 +662,7 @@ func Provider() *schema.Provider {
 			"aws_default_tags":            meta.DataSourceDefaultTags(),
 			"aws_ip_ranges":               meta.DataSourceIPRanges(),
 			"aws_partition":               meta.DataSourcePartition(),
+			"aws_provider_identity":       meta.DataSourceProviderIdentity(),
 			"aws_region":                  meta.DataSourceRegion(),
 			"aws_regions":                 meta.DataSourceRegions(),
 
@@ -1338,6 +1400,7 @@ func Provider() *schema.Provider {
 			"aws_lambda_event_source_mapping":           lambda.ResourceEventSourceMapping(),
 			"aws_lambda_function":                       lambda.ResourceFunction(),
 			"aws_lambda_function_event_invoke_config":   lambda.ResourceFunctionEventInvokeConfig(),
+			"aws_lambda_function_url":                   lambda.ResourceFunctionURL(),
 			"aws_lambda_layer_version":                  lambda.ResourceLayerVersion(),
 			"aws_lambda_layer_version_permission":       lambda.ResourceLayerVersionPermission(),
 			"aws_lambda_permission":                     lambda.ResourcePermission(),
@@ -1508,18 +1571,28 @@ func Provider() *schema.Provider {
 			"aws_route53_resolver_rule":                            route53resolver.ResourceRule(),
 			"aws_route53_resolver_rule_association":                route53resolver.ResourceRuleAssociation(),
 
-			"aws_s3_bucket":                                   s3.ResourceBucket(),
-			"aws_s3_bucket_analytics_configuration":           s3.ResourceBucketAnalyticsConfiguration(),
-			"aws_s3_bucket_intelligent_tiering_configuration": s3.ResourceBucketIntelligentTieringConfiguration(),
-			"aws_s3_bucket_inventory":                         s3.ResourceBucketInventory(),
-			"aws_s3_bucket_metric":                            s3.ResourceBucketMetric(),
-			"aws_s3_bucket_notification":                      s3.ResourceBucketNotification(),
-			"aws_s3_bucket_object":                            s3.ResourceBucketObject(),
-			"aws_s3_bucket_ownership_controls":                s3.ResourceBucketOwnershipControls(),
-			"aws_s3_bucket_policy":                            s3.ResourceBucketPolicy(),
-			"aws_s3_bucket_public_access_block":               s3.ResourceBucketPublicAccessBlock(),
-			"aws_s3_bucket_replication_configuration":         s3.ResourceBucketReplicationConfiguration(),
-			"aws_s3_object_copy":                              s3.ResourceObjectCopy(),
+			"aws_s3_bucket":                                      s3.ResourceBucket(),
+			"aws_s3_bucket_accelerate_configuration":             s3.ResourceBucketAccelerateConfiguration(),
+			"aws_s3_bucket_acl":                                  s3.ResourceBucketACL(),
+			"aws_s3_bucket_analytics_configuration":              s3.ResourceBucketAnalyticsConfiguration(),
+			"aws_s3_bucket_cors_configuration":                   s3.ResourceBucketCorsConfiguration(),
+			"aws_s3_bucket_intelligent_tiering_configuration":    s3.ResourceBucketIntelligentTieringConfiguration(),
+			"aws_s3_bucket_inventory":                            s3.ResourceBucketInventory(),
+			"aws_s3_bucket_lifecycle_configuration":              s3.ResourceBucketLifecycleConfiguration(),
+			"aws_s3_bucket_logging":                              s3.ResourceBucketLogging(),
+			"aws_s3_bucket_metric":                               s3.ResourceBucketMetric(),
+			"aws_s3_bucket_notification":                         s3.ResourceBucketNotification(),
+			"aws_s3_bucket_object":                               s3.ResourceBucketObject(),
+			"aws_s3_bucket_object_lock_configuration":            s3.ResourceBucketObjectLockConfiguration(),
+			"aws_s3_bucket_ownership_controls":                   s3.ResourceBucketOwnershipControls(),
+			"aws_s3_bucket_policy":                               s3.ResourceBucketPolicy(),
+			"aws_s3_bucket_public_access_block":                  s3.ResourceBucketPublicAccessBlock(),
+			"aws_s3_bucket_replication_configuration":            s3.ResourceBucketReplicationConfiguration(),
+			"aws_s3_bucket_request_payment_configuration":        s3.ResourceBucketRequestPaymentConfiguration(),
+			"aws_s3_bucket_server_side_encryption_configuration": s3.ResourceBucketServerSideEncryptionConfiguration(),
+			"aws_s3_bucket_versioning":                           s3.ResourceBucketVersioning(),
+			"aws_s3_bucket_website_configuration":                s3.ResourceBucketWebsiteConfiguration(),
+			"aws_s3_object_copy":                                 s3.ResourceObjectCopy(),
 
 			"aws_s3_access_point":                             s3control.ResourceAccessPoint(),
 			"aws_s3control_access_point_policy":               s3control.ResourceAccessPointPolicy(),
@@ -1723,6 +1796,14 @@ func Provider() *schema.Provider {
 		},
 	}
 
+	// Allow each resource to be managed in a region other than the provider's.
+	addRegionAttributes(provider.ResourcesMap)
+
+	// Identify the resource in CustomizeDiff diagnostics, e.g. for required tags.
+	for typeName, r := range provider.ResourcesMap {
+		addResourceTypeNameToCustomizeDiff(typeName, r)
+	}
+
 	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -1767,6 +1848,9 @@ func init() {
 
 		"endpoint": "Use this to override the default service endpoint URL",
 
+		"metrics_file": "Path of a local file to write a JSON summary of AWS API call latency, retries and throttling\n" +
+			"and of the time spent in the generic retry and wait helpers.",
+
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 			"default value is `false`",
 
@@ -1803,9 +1887,9 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		DefaultTagsConfig:       expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
 		Endpoints:               make(map[string]string),
 		MaxRetries:              d.Get("max_retries").(int),
-		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
 		Insecure:                d.Get("insecure").(bool),
 		HTTPProxy:               d.Get("http_proxy").(string),
+		MetricsFile:             d.Get("metrics_file").(string),
 		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
 		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
 		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
@@ -1815,69 +1899,8 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		TerraformVersion:        terraformVersion,
 	}
 
-	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
-		m := l[0].(map[string]interface{})
-
-		if v, ok := m["duration_seconds"].(int); ok && v != 0 {
-			config.AssumeRoleDurationSeconds = v
-		}
-
-		if v, ok := m["external_id"].(string); ok && v != "" {
-			config.AssumeRoleExternalID = v
-		}
-
-		if v, ok := m["policy"].(string); ok && v != "" {
-			config.AssumeRolePolicy = v
-		}
-
-		if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
-			for _, policyARNRaw := range policyARNSet.List() {
-				policyARN, ok := policyARNRaw.(string)
-
-				if !ok {
-					continue
-				}
-
-				config.AssumeRolePolicyARNs = append(config.AssumeRolePolicyARNs, policyARN)
-			}
-		}
-
-		if v, ok := m["role_arn"].(string); ok && v != "" {
-			config.AssumeRoleARN = v
-		}
-
-		if v, ok := m["session_name"].(string); ok && v != "" {
-			config.AssumeRoleSessionName = v
-		}
-
-		if tagMapRaw, ok := m["tags"].(map[string]interface{}); ok && len(tagMapRaw) > 0 {
-			config.AssumeRoleTags = make(map[string]string)
-
-			for k, vRaw := range tagMapRaw {
-				v, ok := vRaw.(string)
-
-				if !ok {
-					continue
-				}
-
-				config.AssumeRoleTags[k] = v
-			}
-		}
-
-		if transitiveTagKeySet, ok := m["transitive_tag_keys"].(*schema.Set); ok && transitiveTagKeySet.Len() > 0 {
-			for _, transitiveTagKeyRaw := range transitiveTagKeySet.List() {
-				transitiveTagKey, ok := transitiveTagKeyRaw.(string)
-
-				if !ok {
-					continue
-				}
-
-				config.AssumeRoleTransitiveTagKeys = append(config.AssumeRoleTransitiveTagKeys, transitiveTagKey)
-			}
-		}
-
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
-	}
+	config.AssumeRoles = expandProviderAssumeRoles(d.Get("assume_role").([]interface{}))
+	config.AssumeRoleWithWebIdentity = expandProviderAssumeRoleWithWebIdentity(d.Get("assume_role_with_web_identity").([]interface{}))
 
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
@@ -1897,6 +1920,30 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		}
 	}
 
+	rateLimits, err := expandProviderRateLimits(d.Get("rate_limits").(*schema.Set).List())
+
+	if err != nil {
+		return nil, err
+	}
+
+	config.RateLimits = rateLimits
+
+	requiredTagsConfig, err := expandProviderRequiredTags(d.Get("required_tags").([]interface{}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	config.RequiredTagsConfig = requiredTagsConfig
+
+	ignoreTagsConfig, err := expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	config.IgnoreTagsConfig = ignoreTagsConfig
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
 		for _, accountIDRaw := range v.(*schema.Set).List() {
 			config.AllowedAccountIds = append(config.AllowedAccountIds, accountIDRaw.(string))
@@ -1914,9 +1961,9 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "IAM Roles to assume in order, each using the credentials obtained from the previous.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration_seconds": {
@@ -1972,6 +2019,186 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "IAM Role to assume using an OIDC web identity token, e.g. from a CI system. Any assume_role blocks are assumed using its credentials.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Seconds to restrict the assume role session duration.",
+					ValidateFunc: validation.IntBetween(900, 43200),
+				},
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: verify.ValidARN,
+					},
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Amazon Resource Name of an IAM Role to assume prior to making API calls.",
+					ValidateFunc: verify.ValidARN,
+				},
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Identifier for the assumed role session.",
+				},
+				"web_identity_token": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					Description:  "OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ExactlyOneOf: []string{"assume_role_with_web_identity.0.web_identity_token", "assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+				"web_identity_token_file": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Path of a file containing an OAuth 2.0 access token or OpenID Connect ID token. The file is re-read whenever credentials are refreshed.",
+					ExactlyOneOf: []string{"assume_role_with_web_identity.0.web_identity_token", "assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+			},
+		},
+	}
+}
+
+func expandProviderAssumeRoleWithWebIdentity(l []interface{}) *conns.AssumeRoleWithWebIdentity {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	assumeRole := &conns.AssumeRoleWithWebIdentity{}
+
+	if v, ok := m["duration_seconds"].(int); ok && v != 0 {
+		assumeRole.DurationSeconds = v
+	}
+
+	if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
+		for _, policyARNRaw := range policyARNSet.List() {
+			policyARN, ok := policyARNRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			assumeRole.PolicyARNs = append(assumeRole.PolicyARNs, policyARN)
+		}
+	}
+
+	if v, ok := m["role_arn"].(string); ok && v != "" {
+		assumeRole.RoleARN = v
+	}
+
+	if v, ok := m["session_name"].(string); ok && v != "" {
+		assumeRole.SessionName = v
+	}
+
+	if v, ok := m["web_identity_token"].(string); ok && v != "" {
+		assumeRole.WebIdentityToken = v
+	}
+
+	if v, ok := m["web_identity_token_file"].(string); ok && v != "" {
+		assumeRole.WebIdentityTokenFile = v
+	}
+
+	log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q, WebIdentityTokenFile: %q)", assumeRole.RoleARN, assumeRole.SessionName, assumeRole.WebIdentityTokenFile)
+
+	return assumeRole
+}
+
+func expandProviderAssumeRoles(l []interface{}) []*conns.AssumeRole {
+	var assumeRoles []*conns.AssumeRole
+
+	for _, tfMapRaw := range l {
+		m, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		assumeRole := &conns.AssumeRole{}
+
+		if v, ok := m["duration_seconds"].(int); ok && v != 0 {
+			assumeRole.DurationSeconds = v
+		}
+
+		if v, ok := m["external_id"].(string); ok && v != "" {
+			assumeRole.ExternalID = v
+		}
+
+		if v, ok := m["policy"].(string); ok && v != "" {
+			assumeRole.Policy = v
+		}
+
+		if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
+			for _, policyARNRaw := range policyARNSet.List() {
+				policyARN, ok := policyARNRaw.(string)
+
+				if !ok {
+					continue
+				}
+
+				assumeRole.PolicyARNs = append(assumeRole.PolicyARNs, policyARN)
+			}
+		}
+
+		if v, ok := m["role_arn"].(string); ok && v != "" {
+			assumeRole.RoleARN = v
+		}
+
+		if v, ok := m["session_name"].(string); ok && v != "" {
+			assumeRole.SessionName = v
+		}
+
+		if tagMapRaw, ok := m["tags"].(map[string]interface{}); ok && len(tagMapRaw) > 0 {
+			assumeRole.Tags = make(map[string]string)
+
+			for k, vRaw := range tagMapRaw {
+				v, ok := vRaw.(string)
+
+				if !ok {
+					continue
+				}
+
+				assumeRole.Tags[k] = v
+			}
+		}
+
+		if transitiveTagKeySet, ok := m["transitive_tag_keys"].(*schema.Set); ok && transitiveTagKeySet.Len() > 0 {
+			for _, transitiveTagKeyRaw := range transitiveTagKeySet.List() {
+				transitiveTagKey, ok := transitiveTagKeyRaw.(string)
+
+				if !ok {
+					continue
+				}
+
+				assumeRole.TransitiveTagKeys = append(assumeRole.TransitiveTagKeys, transitiveTagKey)
+			}
+		}
+
+		// A block without a role (e.g. from an empty variable) does not assume a role.
+		if assumeRole.RoleARN == "" {
+			continue
+		}
+
+		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID)
+
+		assumeRoles = append(assumeRoles, assumeRole)
+	}
+
+	return assumeRoles
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -1993,6 +2220,42 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func rateLimitsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Configuration blocks with settings to limit the rate and concurrency of API requests to a service.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Maximum number of requests that may be sent at once before requests_per_second applies.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"max_concurrent_requests": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Maximum number of requests to the service in flight at any time.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Description:  "Sustained rate of requests per second to the service.",
+					ValidateFunc: validation.FloatAtLeast(0),
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Service to limit, named as in the endpoints configuration block.",
+					ValidateFunc: validation.StringInSlice(conns.HCLKeys(), false),
+				},
+			},
+		},
+	}
+}
+
 func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -2007,9 +2270,102 @@ func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
 	return defaultConfig
 }
 
-func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
+func expandProviderRequiredTags(l []interface{}) (*tftags.RequiredConfig, error) {
 	if len(l) == 0 || l[0] == nil {
-		return nil
+		return nil, nil
+	}
+
+	requiredConfig := &tftags.RequiredConfig{
+		ValueRegexes: make(map[string]*regexp.Regexp),
+	}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["keys"].(*schema.Set); ok {
+		requiredConfig.Keys = tftags.New(v.List())
+	}
+
+	if v, ok := m["value_regexes"].(map[string]interface{}); ok {
+		for key, expr := range v {
+			re, err := regexp.Compile(expr.(string))
+
+			if err != nil {
+				return nil, fmt.Errorf("error compiling required_tags value regex for key (%s): %w", key, err)
+			}
+
+			requiredConfig.ValueRegexes[key] = re
+		}
+	}
+
+	return requiredConfig, nil
+}
+
+func expandProviderRateLimits(l []interface{}) (map[string]*conns.RateLimitConfig, error) {
+	if len(l) == 0 {
+		return nil, nil
+	}
+
+	rateLimits := make(map[string]*conns.RateLimitConfig)
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		hclKey := tfMap["service"].(string)
+		serviceKey, err := conns.ServiceForHCLKey(hclKey)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign rate limit (%s): %w", hclKey, err)
+		}
+
+		if _, ok := rateLimits[serviceKey]; ok {
+			return nil, fmt.Errorf("duplicate rate_limits configuration for service (%s)", hclKey)
+		}
+
+		rateLimit := &conns.RateLimitConfig{}
+
+		if v, ok := tfMap["burst"].(int); ok {
+			rateLimit.Burst = v
+		}
+
+		if v, ok := tfMap["max_concurrent_requests"].(int); ok {
+			rateLimit.MaxConcurrentRequests = v
+		}
+
+		if v, ok := tfMap["requests_per_second"].(float64); ok {
+			rateLimit.RequestsPerSecond = v
+		}
+
+		if rateLimit.RequestsPerSecond == 0 && rateLimit.MaxConcurrentRequests == 0 {
+			return nil, fmt.Errorf("rate_limits configuration for service (%s) must specify requests_per_second or max_concurrent_requests", hclKey)
+		}
+
+		log.Printf("[INFO] rate_limits configuration set: (Service: %q, RequestsPerSecond: %v, Burst: %d, MaxConcurrentRequests: %d)", hclKey, rateLimit.RequestsPerSecond, rateLimit.Burst, rateLimit.MaxConcurrentRequests)
+
+		rateLimits[serviceKey] = rateLimit
+	}
+
+	return rateLimits, nil
+}
+
+// addResourceTypeNameToCustomizeDiff adds the resource's type name to the context passed to its CustomizeDiff function.
+func addResourceTypeNameToCustomizeDiff(typeName string, r *schema.Resource) {
+	f := r.CustomizeDiff
+
+	if f == nil {
+		return
+	}
+
+	r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		return f(conns.NewResourceContext(ctx, typeName), d, meta)
+	}
+}
+
+func expandProviderIgnoreTags(l []interface{}) (*tftags.IgnoreConfig, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
 	}
 
 	ignoreConfig := &tftags.IgnoreConfig{}
@@ -2023,5 +2379,25 @@ func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 		ignoreConfig.KeyPrefixes = tftags.New(v.List())
 	}
 
-	return ignoreConfig
+	if v, ok := m["key_regexes"].(*schema.Set); ok {
+		for _, expr := range v.List() {
+			re, err := regexp.Compile(expr.(string))
+
+			if err != nil {
+				return nil, fmt.Errorf("error compiling ignore_tags key regex (%s): %w", expr, err)
+			}
+
+			ignoreConfig.KeyRegexes = append(ignoreConfig.KeyRegexes, re)
+		}
+	}
+
+	if v, ok := m["case_insensitive_keys"].(*schema.Set); ok {
+		ignoreConfig.CaseInsensitiveKeys = tftags.New(v.List())
+	}
+
+	if v, ok := m["key_value_pairs"].(map[string]interface{}); ok {
+		ignoreConfig.KeyValuePairs = tftags.New(v)
+	}
+
+	return ignoreConfig, nil
 }
@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// regionAttribute is the name of the per-resource region override.
+const regionAttribute = "region"
+
+// regionImportIDSuffixRegexp matches the optional "@REGION" suffix of an import ID.
+var regionImportIDSuffixRegexp = regexp.MustCompile(`@([a-z]{2}(-[a-z]+)+-\d)$`)
+
+// globalResourceTypePrefixes are the type name prefixes of resources of global services,
+// which are not managed in a particular region.
+var globalResourceTypePrefixes = []string{
+	"aws_account_",
+	"aws_budgets_",
+	"aws_cloudfront_",
+	"aws_cur_",
+	"aws_globalaccelerator_",
+	"aws_iam_",
+	"aws_organizations_",
+	"aws_route53_",
+	"aws_route53recoverycontrolconfig_",
+	"aws_route53recoveryreadiness_",
+	"aws_shield_",
+	"aws_waf_",
+}
+
+// regionalResourceTypePrefixes are exceptions to globalResourceTypePrefixes.
+var regionalResourceTypePrefixes = []string{
+	"aws_route53_resolver_",
+}
+
+// regionGetter is implemented by both schema.ResourceData and schema.ResourceDiff.
+type regionGetter interface {
+	Get(string) interface{}
+}
+
+// addRegionAttributes adds the region override to each regional resource that does not already
+// have a top-level attribute of that name.
+func addRegionAttributes(resources map[string]*schema.Resource) {
+	for typeName, r := range resources {
+		if !isRegionalResourceType(typeName) {
+			continue
+		}
+
+		if _, ok := r.Schema[regionAttribute]; ok {
+			continue
+		}
+
+		addRegionAttribute(r)
+	}
+}
+
+// isRegionalResourceType returns whether resources of the specified type are managed in a region.
+func isRegionalResourceType(typeName string) bool {
+	for _, prefix := range regionalResourceTypePrefixes {
+		if strings.HasPrefix(typeName, prefix) {
+			return true
+		}
+	}
+
+	for _, prefix := range globalResourceTypePrefixes {
+		if strings.HasPrefix(typeName, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// addRegionAttribute adds an optional region argument to the resource schema and wraps the
+// resource's functions so that they are called with the AWSClient for that region.
+// The region is only recorded in state if it is configured (or imported), so resources without
+// one continue to follow the provider region.
+// Changing the region only forces a new resource if the resource moves to another region,
+// so configuring the provider region for an existing resource updates it in place.
+func addRegionAttribute(r *schema.Resource) {
+	r.Schema[regionAttribute] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Region in which to manage the resource. Defaults to the provider region.",
+		ValidateFunc: verify.ValidRegionName,
+	}
+
+	if f := r.Create; f != nil {
+		r.Create = func(d *schema.ResourceData, meta interface{}) error {
+			meta, err := regionalClient(d, meta)
+
+			if err != nil {
+				return err
+			}
+
+			return f(d, meta)
+		}
+	}
+	if f := r.CreateContext; f != nil {
+		r.CreateContext = regionalContextFunc(f)
+	}
+	if f := r.CreateWithoutTimeout; f != nil {
+		r.CreateWithoutTimeout = regionalContextFunc(f)
+	}
+
+	if f := r.Read; f != nil {
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			meta, err := regionalClient(d, meta)
+
+			if err != nil {
+				return err
+			}
+
+			return f(d, meta)
+		}
+	}
+	if f := r.ReadContext; f != nil {
+		r.ReadContext = regionalContextFunc(f)
+	}
+	if f := r.ReadWithoutTimeout; f != nil {
+		r.ReadWithoutTimeout = regionalContextFunc(f)
+	}
+
+	if f := r.Update; f != nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			if !d.HasChangeExcept(regionAttribute) {
+				return nil
+			}
+
+			meta, err := regionalClient(d, meta)
+
+			if err != nil {
+				return err
+			}
+
+			return f(d, meta)
+		}
+	}
+	if f := r.UpdateContext; f != nil {
+		r.UpdateContext = regionalUpdateContextFunc(f)
+	}
+	if f := r.UpdateWithoutTimeout; f != nil {
+		r.UpdateWithoutTimeout = regionalUpdateContextFunc(f)
+	}
+	// Resources whose other arguments all force a new resource are only updated when
+	// the region argument changes without moving the resource.
+	if r.Update == nil && r.UpdateContext == nil && r.UpdateWithoutTimeout == nil {
+		r.Update = schema.Noop
+	}
+
+	if f := r.Delete; f != nil {
+		r.Delete = func(d *schema.ResourceData, meta interface{}) error {
+			meta, err := regionalClient(d, meta)
+
+			if err != nil {
+				return err
+			}
+
+			return f(d, meta)
+		}
+	}
+	if f := r.DeleteContext; f != nil {
+		r.DeleteContext = regionalContextFunc(f)
+	}
+	if f := r.DeleteWithoutTimeout; f != nil {
+		r.DeleteWithoutTimeout = regionalContextFunc(f)
+	}
+
+	f := r.CustomizeDiff
+	r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if err := forceNewIfRegionMoved(d, meta); err != nil {
+			return err
+		}
+
+		if f == nil {
+			return nil
+		}
+
+		meta, err := regionalClient(d, meta)
+
+		if err != nil {
+			return err
+		}
+
+		return f(ctx, d, meta)
+	}
+
+	if importer := r.Importer; importer != nil {
+		if f := importer.State; f != nil {
+			importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				meta, err := regionalClientForImport(d, meta)
+
+				if err != nil {
+					return nil, err
+				}
+
+				return f(d, meta)
+			}
+		}
+		if f := importer.StateContext; f != nil {
+			importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				meta, err := regionalClientForImport(d, meta)
+
+				if err != nil {
+					return nil, err
+				}
+
+				return f(ctx, d, meta)
+			}
+		}
+	}
+}
+
+func regionalContextFunc(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		meta, err := regionalClient(d, meta)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		return f(ctx, d, meta)
+	}
+}
+
+func regionalUpdateContextFunc(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	f = regionalContextFunc(f)
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if !d.HasChangeExcept(regionAttribute) {
+			return nil
+		}
+
+		return f(ctx, d, meta)
+	}
+}
+
+// forceNewIfRegionMoved forces a new resource if a change to the region argument moves an existing resource
+// to another region. An empty region and the provider region are equivalent.
+func forceNewIfRegionMoved(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange(regionAttribute) {
+		return nil
+	}
+
+	providerRegion := meta.(*conns.AWSClient).Region
+	o, n := d.GetChange(regionAttribute)
+
+	if effectiveRegion(o.(string), providerRegion) == effectiveRegion(n.(string), providerRegion) {
+		return nil
+	}
+
+	return d.ForceNew(regionAttribute)
+}
+
+func effectiveRegion(region, providerRegion string) string {
+	if region == "" {
+		return providerRegion
+	}
+
+	return region
+}
+
+// regionalClient returns the AWSClient for the resource's region.
+func regionalClient(d regionGetter, meta interface{}) (*conns.AWSClient, error) {
+	client := meta.(*conns.AWSClient)
+	region := d.Get(regionAttribute).(string)
+
+	regionalClient, err := client.RegionalClient(region)
+
+	if err != nil {
+		return nil, fmt.Errorf("error configuring AWS client for region (%s): %w", region, err)
+	}
+
+	return regionalClient, nil
+}
+
+// regionalClientForImport returns the AWSClient for an import ID with an optional "@REGION" suffix,
+// removing the suffix from the ID.
+func regionalClientForImport(d *schema.ResourceData, meta interface{}) (*conns.AWSClient, error) {
+	if m := regionImportIDSuffixRegexp.FindStringSubmatch(d.Id()); m != nil {
+		d.SetId(strings.TrimSuffix(d.Id(), m[0]))
+
+		if err := d.Set(regionAttribute, m[1]); err != nil {
+			return nil, fmt.Errorf("error setting %s: %w", regionAttribute, err)
+		}
+	}
+
+	return regionalClient(d, meta)
+}
@@ -0,0 +1,172 @@
+package resourceid
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Part is a single component of a composite resource ID.
+type Part struct {
+	// Name is used in error messages to describe the expected ID format, e.g. "cluster-name".
+	Name string
+
+	// Validate, if set, is called with the value of the part when an ID is parsed.
+	Validate func(value string) error
+}
+
+// Template describes the format of a composite resource ID.
+type Template struct {
+	// Parts are the ordered components of the ID. Every part must be non-empty.
+	Parts []Part
+
+	// Separator is placed between parts when an ID is created and is tried first when an ID is parsed.
+	Separator string
+
+	// LegacySeparators are tried in order when an ID does not parse with Separator,
+	// so that IDs created, or imported, before a change of separator keep working.
+	LegacySeparators []string
+
+	// ExpectedFormat, if set, replaces the part names joined by Separator in error messages,
+	// so that the messages of existing ID parsers can be kept unchanged.
+	ExpectedFormat string
+}
+
+// New returns a Template for the specified separator and part names.
+func New(separator string, names ...string) *Template {
+	t := &Template{
+		Separator: separator,
+	}
+
+	for _, name := range names {
+		t.Parts = append(t.Parts, Part{Name: name})
+	}
+
+	return t
+}
+
+// WithLegacySeparators returns the Template with the specified legacy separators.
+func (t *Template) WithLegacySeparators(separators ...string) *Template {
+	t.LegacySeparators = separators
+
+	return t
+}
+
+// WithFormat returns the Template with the specified description of the expected ID format.
+func (t *Template) WithFormat(format string) *Template {
+	t.ExpectedFormat = format
+
+	return t
+}
+
+// WithValidation returns the Template with a validation function for the named part.
+// It panics if there is no part with the specified name.
+func (t *Template) WithValidation(name string, f func(value string) error) *Template {
+	for i, part := range t.Parts {
+		if part.Name == name {
+			t.Parts[i].Validate = f
+
+			return t
+		}
+	}
+
+	panic(fmt.Sprintf("resource ID template has no part named %q", name))
+}
+
+// Format returns a description of the expected ID format, e.g. "cluster-name:addon-name".
+func (t *Template) Format() string {
+	if t.ExpectedFormat != "" {
+		return t.ExpectedFormat
+	}
+
+	names := make([]string, len(t.Parts))
+
+	for i, part := range t.Parts {
+		names[i] = part.Name
+	}
+
+	return strings.Join(names, t.Separator)
+}
+
+// Create returns the ID made up of the specified part values.
+// It panics if the number of values does not match the number of parts.
+func (t *Template) Create(values ...string) string {
+	if len(values) != len(t.Parts) {
+		panic(fmt.Sprintf("resource ID template %s has %d parts, got %d values", t.Format(), len(t.Parts), len(values)))
+	}
+
+	return strings.Join(values, t.Separator)
+}
+
+// Parse returns the part values of the specified ID.
+// The returned error is a *ParseError.
+func (t *Template) Parse(id string) ([]string, error) {
+	values, err := t.parse(id, t.Separator)
+
+	if err == nil {
+		return values, nil
+	}
+
+	for _, separator := range t.LegacySeparators {
+		if values, legacyErr := t.parse(id, separator); legacyErr == nil {
+			return values, nil
+		}
+	}
+
+	return nil, &ParseError{
+		ID:     id,
+		Format: t.Format(),
+		Err:    err,
+	}
+}
+
+func (t *Template) parse(id, separator string) ([]string, error) {
+	values := strings.Split(id, separator)
+
+	if len(values) != len(t.Parts) {
+		return nil, fmt.Errorf("expected %d parts, got %d", len(t.Parts), len(values))
+	}
+
+	for i, part := range t.Parts {
+		value := values[i]
+
+		if value == "" {
+			return nil, fmt.Errorf("%s is empty", part.Name)
+		}
+
+		if part.Validate != nil {
+			if err := part.Validate(value); err != nil {
+				return nil, fmt.Errorf("%s: %w", part.Name, err)
+			}
+		}
+	}
+
+	return values, nil
+}
+
+// ParseError is returned when a resource ID does not match its template.
+// The reason, which is not part of the message, is available with errors.Unwrap.
+type ParseError struct {
+	ID     string
+	Format string
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("unexpected format for ID (%s), expected %s", e.ID, e.Format)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// MatchRegexp returns a validation function that checks that a part value matches the regular expression.
+func MatchRegexp(re *regexp.Regexp) func(string) error {
+	return func(value string) error {
+		if !re.MatchString(value) {
+			return fmt.Errorf("%q does not match %s", value, re)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,148 @@
+package resourceid
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestTemplateCreate(t *testing.T) {
+	template := New(":", "cluster-name", "node-group-name")
+
+	if got, expected := template.Create("cluster", "node-group"), "cluster:node-group"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func TestTemplateFormat(t *testing.T) {
+	template := New(",", "stack-set-name", "account-id", "region")
+
+	if got, expected := template.Format(), "stack-set-name,account-id,region"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func TestTemplateFormat_expectedFormat(t *testing.T) {
+	template := New(",", "stack-set-name", "account-id", "region").WithFormat("STACKSETNAME,ACCOUNDID,REGION")
+
+	_, err := template.Parse("stack-set")
+
+	if got, expected := err.Error(), "unexpected format for ID (stack-set), expected STACKSETNAME,ACCOUNDID,REGION"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func TestTemplateParse(t *testing.T) {
+	template := New("/", "server-id", "user-name").
+		WithLegacySeparators(":", ",").
+		WithValidation("server-id", MatchRegexp(regexp.MustCompile(`^s-[0-9a-f]{17}$`)))
+
+	testCases := []struct {
+		Name           string
+		ID             string
+		Expected       []string
+		ExpectedError  string
+		ExpectedReason string
+	}{
+		{
+			Name:           "empty",
+			ID:             "",
+			ExpectedError:  `unexpected format for ID (), expected server-id/user-name`,
+			ExpectedReason: `expected 2 parts, got 1`,
+		},
+		{
+			Name:           "too few parts",
+			ID:             "s-0123456789abcdef0",
+			ExpectedError:  `unexpected format for ID (s-0123456789abcdef0), expected server-id/user-name`,
+			ExpectedReason: `expected 2 parts, got 1`,
+		},
+		{
+			Name:           "too many parts",
+			ID:             "s-0123456789abcdef0/user/extra",
+			ExpectedError:  `unexpected format for ID (s-0123456789abcdef0/user/extra), expected server-id/user-name`,
+			ExpectedReason: `expected 2 parts, got 3`,
+		},
+		{
+			Name:           "empty part",
+			ID:             "s-0123456789abcdef0/",
+			ExpectedError:  `unexpected format for ID (s-0123456789abcdef0/), expected server-id/user-name`,
+			ExpectedReason: `user-name is empty`,
+		},
+		{
+			Name:           "invalid part",
+			ID:             "server/user",
+			ExpectedError:  `unexpected format for ID (server/user), expected server-id/user-name`,
+			ExpectedReason: `server-id: "server" does not match ^s-[0-9a-f]{17}$`,
+		},
+		{
+			Name:     "valid",
+			ID:       "s-0123456789abcdef0/user",
+			Expected: []string{"s-0123456789abcdef0", "user"},
+		},
+		{
+			Name:     "legacy separator",
+			ID:       "s-0123456789abcdef0:user",
+			Expected: []string{"s-0123456789abcdef0", "user"},
+		},
+		{
+			Name:     "second legacy separator",
+			ID:       "s-0123456789abcdef0,user",
+			Expected: []string{"s-0123456789abcdef0", "user"},
+		},
+		{
+			Name:           "invalid legacy part",
+			ID:             "server:user",
+			ExpectedError:  `unexpected format for ID (server:user), expected server-id/user-name`,
+			ExpectedReason: `expected 2 parts, got 1`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := template.Parse(testCase.ID)
+
+			if testCase.ExpectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error, got %v", got)
+				}
+
+				var parseErr *ParseError
+
+				if !errors.As(err, &parseErr) {
+					t.Fatalf("expected *ParseError, got %T", err)
+				}
+
+				if err.Error() != testCase.ExpectedError {
+					t.Errorf("got error %q, expected %q", err, testCase.ExpectedError)
+				}
+
+				if reason := errors.Unwrap(err).Error(); reason != testCase.ExpectedReason {
+					t.Errorf("got reason %q, expected %q", reason, testCase.ExpectedReason)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestTemplateWithValidation_unknownPart(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic")
+		}
+	}()
+
+	New(":", "cluster-name").WithValidation("node-group-name", MatchRegexp(regexp.MustCompile(`.`)))
+}
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_accessanalyzer_analyzer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_accessanalyzer_analyzer", &resource.Sweeper{
 		Name: "aws_accessanalyzer_analyzer",
 		F:    sweepAnalyzers,
 	})
@@ -26,7 +26,7 @@ func sweepAnalyzers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).AccessAnalyzerConn
+	conn := client.(*conns.AWSClient).AccessAnalyzerConn()
 	input := &accessanalyzer.ListAnalyzersInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -40,7 +40,11 @@ func sweepAnalyzers(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(analyzer.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResource := sweep.NewSweepResource(r, d, client)
+			sweepResource.CreationTime = aws.TimeValue(analyzer.CreatedAt)
+			sweepResource.Tags = KeyValueTags(analyzer.Tags).Map()
+
+			sweepResources = append(sweepResources, sweepResource)
 		}
 
 		return !lastPage
@@ -765,7 +765,7 @@ resource "aws_acm_certificate" "cert" {
 }
 
 func testAccCheckAcmCertificateDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ACMConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ACMConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_acm_certificate" {
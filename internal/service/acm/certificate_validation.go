@@ -45,7 +45,7 @@ func ResourceCertificateValidation() *schema.Resource {
 func resourceCertificateValidationCreate(d *schema.ResourceData, meta interface{}) error {
 	certificate_arn := d.Get("certificate_arn").(string)
 
-	conn := meta.(*conns.AWSClient).ACMConn
+	conn := meta.(*conns.AWSClient).ACMConn()
 	params := &acm.DescribeCertificateInput{
 		CertificateArn: aws.String(certificate_arn),
 	}
@@ -172,7 +172,7 @@ func resourceCertificateCheckValidationRecords(validationRecordFqdns []interface
 }
 
 func resourceCertificateValidationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ACMConn
+	conn := meta.(*conns.AWSClient).ACMConn()
 
 	params := &acm.DescribeCertificateInput{
 		CertificateArn: aws.String(d.Get("certificate_arn").(string)),
@@ -39,7 +39,7 @@ func ResourceAlertManagerDefinition() *schema.Resource {
 }
 
 func resourceAlertManagerDefinitionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).AMPConn
+	conn := meta.(*conns.AWSClient).AMPConn()
 
 	workspaceID := d.Get("workspace_id").(string)
 	input := &prometheusservice.CreateAlertManagerDefinitionInput{
@@ -64,7 +64,7 @@ func resourceAlertManagerDefinitionCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAlertManagerDefinitionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).AMPConn
+	conn := meta.(*conns.AWSClient).AMPConn()
 
 	input := &prometheusservice.PutAlertManagerDefinitionInput{
 		Data:        []byte(d.Get("definition").(string)),
@@ -86,7 +86,7 @@ func resourceAlertManagerDefinitionUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAlertManagerDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).AMPConn
+	conn := meta.(*conns.AWSClient).AMPConn()
 
 	amd, err := FindAlertManagerDefinitionByID(ctx, conn, d.Id())
 
@@ -107,7 +107,7 @@ func resourceAlertManagerDefinitionRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceAlertManagerDefinitionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).AMPConn
+	conn := meta.(*conns.AWSClient).AMPConn()
 
 	log.Printf("[DEBUG] Deleting Prometheus Alert Manager Definition: (%s)", d.Id())
 	_, err := conn.DeleteAlertManagerDefinitionWithContext(ctx, &prometheusservice.DeleteAlertManagerDefinitionInput{
@@ -41,9 +41,11 @@ func KeyValueTags(tags map[string]*string) tftags.KeyValueTags {
 // UpdateTags updates amplify service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func UpdateTags(conn *amplify.Amplify, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
-	oldTags := tftags.New(oldTagsMap)
-	newTags := tftags.New(newTagsMap)
+// Tags matching the key_regexes, case_insensitive_keys or key_value_pairs of the ignore
+// configuration, if any, are neither added nor removed.
+func UpdateTags(conn *amplify.Amplify, identifier string, oldTagsMap interface{}, newTagsMap interface{}, ignoreConfig *tftags.IgnoreConfig) error {
+	oldTags := tftags.New(oldTagsMap).IgnoreConfigMatchers(ignoreConfig)
+	newTags := tftags.New(newTagsMap).IgnoreConfigMatchers(ignoreConfig)
 
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &amplify.UntagResourceInput{
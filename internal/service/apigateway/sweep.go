@@ -18,12 +18,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_api_gateway_rest_api", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_rest_api", &resource.Sweeper{
 		Name: "aws_api_gateway_rest_api",
 		F:    sweepRestAPIs,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_vpc_link", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_vpc_link", &resource.Sweeper{
 		Name: "aws_api_gateway_vpc_link",
 		F:    sweepVPCLinks,
 	})
@@ -34,7 +34,7 @@ func sweepRestAPIs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).APIGatewayConn
+	conn := client.(*conns.AWSClient).APIGatewayConn()
 
 	err = conn.GetRestApisPages(&apigateway.GetRestApisInput{}, func(page *apigateway.GetRestApisOutput, lastPage bool) bool {
 		for _, item := range page.Items {
@@ -76,7 +76,7 @@ func sweepVPCLinks(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).APIGatewayConn
+	conn := client.(*conns.AWSClient).APIGatewayConn()
 
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var sweeperErrs *multierror.Error
@@ -90,7 +90,10 @@ func sweepVPCLinks(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResource := sweep.NewSweepResource(r, d, client)
+			sweepResource.Tags = KeyValueTags(item.Tags).Map()
+
+			sweepResources = append(sweepResources, sweepResource)
 		}
 		return !lastPage
 	})
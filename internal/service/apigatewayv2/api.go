@@ -155,7 +155,7 @@ func ResourceAPI() *schema.Resource {
 }
 
 func resourceImportOpenAPI(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 
 	if body, ok := d.GetOk("body"); ok {
@@ -204,7 +204,7 @@ func resourceImportOpenAPI(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 
-		if err := UpdateTags(conn, d.Get("arn").(string), d.Get("tags_all"), tags); err != nil {
+		if err := UpdateTags(conn, d.Get("arn").(string), d.Get("tags_all"), tags, meta.(*conns.AWSClient).IgnoreTagsConfig); err != nil {
 			return fmt.Errorf("error updating API Gateway v2 API (%s) tags: %s", d.Id(), err)
 		}
 
@@ -219,7 +219,7 @@ func resourceImportOpenAPI(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAPICreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -274,7 +274,7 @@ func resourceAPICreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAPIRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -332,7 +332,7 @@ func resourceAPIRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAPIUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn()
 
 	deleteCorsConfiguration := false
 	if d.HasChange("cors_configuration") {
@@ -387,7 +387,7 @@ func resourceAPIUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
-		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n, meta.(*conns.AWSClient).IgnoreTagsConfig); err != nil {
 			return fmt.Errorf("error updating API Gateway v2 API (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -403,7 +403,7 @@ func resourceAPIUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAPIDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn()
 
 	log.Printf("[DEBUG] Deleting API Gateway v2 API (%s)", d.Id())
 	_, err := conn.DeleteApi(&apigatewayv2.DeleteApiInput{
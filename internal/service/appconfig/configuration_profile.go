@@ -95,7 +95,7 @@ func ResourceConfigurationProfile() *schema.Resource {
 }
 
 func resourceConfigurationProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppConfigConn
+	conn := meta.(*conns.AWSClient).AppConfigConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -137,7 +137,7 @@ func resourceConfigurationProfileCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceConfigurationProfileRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppConfigConn
+	conn := meta.(*conns.AWSClient).AppConfigConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -211,7 +211,7 @@ func resourceConfigurationProfileRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceConfigurationProfileUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppConfigConn
+	conn := meta.(*conns.AWSClient).AppConfigConn()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		confProfID, appID, err := ConfigurationProfileParseID(d.Id())
@@ -250,7 +250,7 @@ func resourceConfigurationProfileUpdate(d *schema.ResourceData, meta interface{}
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
-		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n, meta.(*conns.AWSClient).IgnoreTagsConfig); err != nil {
 			return fmt.Errorf("error updating AppConfig Configuration Profile (%s) tags: %w", d.Get("arn").(string), err)
 		}
 	}
@@ -259,7 +259,7 @@ func resourceConfigurationProfileUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceConfigurationProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppConfigConn
+	conn := meta.(*conns.AWSClient).AppConfigConn()
 
 	confProfID, appID, err := ConfigurationProfileParseID(d.Id())
 
@@ -17,19 +17,19 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_apprunner_auto_scaling_configuration_version", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apprunner_auto_scaling_configuration_version", &resource.Sweeper{
 		Name:         "aws_apprunner_auto_scaling_configuration_version",
 		F:            sweepAutoScalingConfigurationVersions,
 		Dependencies: []string{"aws_apprunner_service"},
 	})
 
-	resource.AddTestSweepers("aws_apprunner_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apprunner_connection", &resource.Sweeper{
 		Name:         "aws_apprunner_connection",
 		F:            sweepConnections,
 		Dependencies: []string{"aws_apprunner_service"},
 	})
 
-	resource.AddTestSweepers("aws_apprunner_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apprunner_service", &resource.Sweeper{
 		Name: "aws_apprunner_service",
 		F:    sweepServices,
 	})
@@ -42,7 +42,7 @@ func sweepAutoScalingConfigurationVersions(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).AppRunnerConn
+	conn := client.(*conns.AWSClient).AppRunnerConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	ctx := context.Background()
 	var errs *multierror.Error
@@ -107,7 +107,7 @@ func sweepConnections(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).AppRunnerConn
+	conn := client.(*conns.AWSClient).AppRunnerConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	ctx := context.Background()
 
@@ -134,7 +134,10 @@ func sweepConnections(region string) error {
 			d.SetId(name)
 			d.Set("arn", c.ConnectionArn)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResource := sweep.NewSweepResource(r, d, client)
+			sweepResource.CreationTime = aws.TimeValue(c.CreatedAt)
+
+			sweepResources = append(sweepResources, sweepResource)
 		}
 
 		return !lastPage
@@ -168,7 +171,7 @@ func sweepServices(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).AppRunnerConn
+	conn := client.(*conns.AWSClient).AppRunnerConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	ctx := context.Background()
 	var errs *multierror.Error
@@ -193,7 +196,10 @@ func sweepServices(region string) error {
 			d := r.Data(nil)
 			d.SetId(arn)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResource := sweep.NewSweepResource(r, d, client)
+			sweepResource.CreationTime = aws.TimeValue(service.CreatedAt)
+
+			sweepResources = append(sweepResources, sweepResource)
 		}
 
 		return !lastPage
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appstream_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appstream_fleet", &resource.Sweeper{
 		Name: "aws_appstream_fleet",
 		F:    sweepFleet,
 		Dependencies: []string{
@@ -26,7 +26,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appstream_image_builder", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appstream_image_builder", &resource.Sweeper{
 		Name: "aws_appstream_image_builder",
 		F:    sweepImageBuilder,
 		Dependencies: []string{
@@ -35,7 +35,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appstream_stack", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appstream_stack", &resource.Sweeper{
 		Name: "aws_appstream_stack",
 		F:    sweepStack,
 	})
@@ -48,7 +48,7 @@ func sweepFleet(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).AppStreamConn
+	conn := client.(*conns.AWSClient).AppStreamConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -70,7 +70,10 @@ func sweepFleet(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResource := sweep.NewSweepResource(r, d, client)
+			sweepResource.CreationTime = aws.TimeValue(fleet.CreatedTime)
+
+			sweepResources = append(sweepResources, sweepResource)
 		}
 
 		return !lastPage
@@ -103,7 +106,7 @@ func sweepImageBuilder(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).AppStreamConn
+	conn := client.(*conns.AWSClient).AppStreamConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -125,7 +128,10 @@ func sweepImageBuilder(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResource := sweep.NewSweepResource(r, d, client)
+			sweepResource.CreationTime = aws.TimeValue(imageBuilder.CreatedTime)
+
+			sweepResources = append(sweepResources, sweepResource)
 		}
 
 		return !lastPage
@@ -159,7 +165,7 @@ func sweepStack(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).AppStreamConn
+	conn := client.(*conns.AWSClient).AppStreamConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -181,7 +187,10 @@ func sweepStack(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResource := sweep.NewSweepResource(r, d, client)
+			sweepResource.CreationTime = aws.TimeValue(stack.CreatedTime)
+
+			sweepResources = append(sweepResources, sweepResource)
 		}
 
 		return !lastPage
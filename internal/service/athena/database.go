@@ -91,7 +91,7 @@ func expandAthenaResultConfiguration(bucket string, encryptionConfigurationList
 }
 
 func resourceDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AthenaConn
+	conn := meta.(*conns.AWSClient).AthenaConn()
 
 	input := &athena.StartQueryExecutionInput{
 		QueryString:         aws.String(fmt.Sprintf("create database `%s`;", d.Get("name").(string))),
@@ -111,7 +111,7 @@ func resourceDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDatabaseRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AthenaConn
+	conn := meta.(*conns.AWSClient).AthenaConn()
 
 	input := &athena.GetDatabaseInput{
 		DatabaseName: aws.String(d.Get("name").(string)),
@@ -129,7 +129,7 @@ func resourceDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AthenaConn
+	conn := meta.(*conns.AWSClient).AthenaConn()
 
 	name := d.Get("name").(string)
 
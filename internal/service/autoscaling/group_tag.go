@@ -53,13 +53,13 @@ func ResourceGroupTag() *schema.Resource {
 }
 
 func resourceGroupTagCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 
 	identifier := d.Get("autoscaling_group_name").(string)
 	tags := d.Get("tag").([]interface{})
 	key := tags[0].(map[string]interface{})["key"].(string)
 
-	if err := UpdateTags(conn, identifier, TagResourceTypeGroup, nil, tags); err != nil {
+	if err := UpdateTags(conn, identifier, TagResourceTypeGroup, nil, tags, meta.(*conns.AWSClient).IgnoreTagsConfig); err != nil {
 		return fmt.Errorf("error creating AutoScaling Group (%s) tag (%s): %w", identifier, key, err)
 	}
 
@@ -69,7 +69,7 @@ func resourceGroupTagCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGroupTagRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 	identifier, key, err := tftags.GetResourceID(d.Id())
 
 	if err != nil {
@@ -102,14 +102,14 @@ func resourceGroupTagRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGroupTagUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 	identifier, key, err := tftags.GetResourceID(d.Id())
 
 	if err != nil {
 		return err
 	}
 
-	if err := UpdateTags(conn, identifier, TagResourceTypeGroup, nil, d.Get("tag")); err != nil {
+	if err := UpdateTags(conn, identifier, TagResourceTypeGroup, nil, d.Get("tag"), meta.(*conns.AWSClient).IgnoreTagsConfig); err != nil {
 		return fmt.Errorf("error updating AutoScaling Group (%s) tag (%s): %w", identifier, key, err)
 	}
 
@@ -117,14 +117,14 @@ func resourceGroupTagUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGroupTagDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 	identifier, key, err := tftags.GetResourceID(d.Id())
 
 	if err != nil {
 		return err
 	}
 
-	if err := UpdateTags(conn, identifier, TagResourceTypeGroup, d.Get("tag"), nil); err != nil {
+	if err := UpdateTags(conn, identifier, TagResourceTypeGroup, d.Get("tag"), nil, meta.(*conns.AWSClient).IgnoreTagsConfig); err != nil {
 		return fmt.Errorf("error deleting AutoScaling Group (%s) tag (%s): %w", identifier, key, err)
 	}
 
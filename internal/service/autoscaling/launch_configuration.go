@@ -358,8 +358,8 @@ func ResourceLaunchConfiguration() *schema.Resource {
 }
 
 func resourceLaunchConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*conns.AWSClient).AutoScalingConn
-	ec2conn := meta.(*conns.AWSClient).EC2Conn
+	autoscalingconn := meta.(*conns.AWSClient).AutoScalingConn()
+	ec2conn := meta.(*conns.AWSClient).EC2Conn()
 
 	lcName := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
 
@@ -567,8 +567,8 @@ func resourceLaunchConfigurationCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceLaunchConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*conns.AWSClient).AutoScalingConn
-	ec2conn := meta.(*conns.AWSClient).EC2Conn
+	autoscalingconn := meta.(*conns.AWSClient).AutoScalingConn()
+	ec2conn := meta.(*conns.AWSClient).EC2Conn()
 
 	describeOpts := autoscaling.DescribeLaunchConfigurationsInput{
 		LaunchConfigurationNames: []*string{aws.String(d.Id())},
@@ -634,7 +634,7 @@ func resourceLaunchConfigurationRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceLaunchConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*conns.AWSClient).AutoScalingConn
+	autoscalingconn := meta.(*conns.AWSClient).AutoScalingConn()
 	input := &autoscaling.DeleteLaunchConfigurationInput{
 		LaunchConfigurationName: aws.String(d.Id()),
 	}
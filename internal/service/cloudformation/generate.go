@@ -1,4 +1,5 @@
 //go:generate go run ../../generate/tags/main.go -ServiceTagsSlice
+//go:generate go run ../../generate/resourceid/main.go -Name=StackSetInstance -Parts=stack-set-name,account-id,region -Separator=, -Format=STACKSETNAME,ACCOUNDID,REGION
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package cloudformation
@@ -13,9 +13,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
-	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourceMetricFilter() *schema.Resource {
@@ -78,9 +78,9 @@ func ResourceMetricFilter() *schema.Resource {
 							ValidateFunc: validation.StringLenBetween(0, 100),
 						},
 						"default_value": {
-							Type:         schema.TypeString,
+							Type:         nullable.TypeNullableFloat,
 							Optional:     true,
-							ValidateFunc: verify.ValidTypeStringNullableFloat,
+							ValidateFunc: nullable.ValidateTypeStringNullableFloat,
 						},
 						"dimensions": {
 							Type:     schema.TypeMap,
@@ -101,7 +101,7 @@ func ResourceMetricFilter() *schema.Resource {
 }
 
 func resourceMetricFilterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn()
 
 	name := d.Get("name").(string)
 	logGroupName := d.Get("log_group_name").(string)
@@ -136,7 +136,7 @@ func resourceMetricFilterUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceMetricFilterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn()
 
 	mf, err := LookupMetricFilter(conn, d.Get("name").(string),
 		d.Get("log_group_name").(string), nil)
@@ -203,7 +203,7 @@ func LookupMetricFilter(conn *cloudwatchlogs.CloudWatchLogs,
 }
 
 func resourceMetricFilterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn()
 
 	input := cloudwatchlogs.DeleteMetricFilterInput{
 		FilterName:   aws.String(d.Get("name").(string)),
@@ -245,9 +245,8 @@ func expandCloudWatchLogMetricTransformations(m map[string]interface{}) []*cloud
 		MetricValue:     aws.String(m["value"].(string)),
 	}
 
-	if m["default_value"].(string) != "" {
-		value, _ := strconv.ParseFloat(m["default_value"].(string), 64)
-		transformation.DefaultValue = aws.Float64(value)
+	if v, null, _ := nullable.Float(m["default_value"].(string)).Value(); !null {
+		transformation.DefaultValue = aws.Float64(v)
 	}
 
 	if dims := m["dimensions"].(map[string]interface{}); len(dims) > 0 {
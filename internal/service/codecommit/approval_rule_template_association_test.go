@@ -99,7 +99,7 @@ func testAccCheckCodeCommitApprovalRuleTemplateAssociationExists(name string) re
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeCommitConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeCommitConn()
 
 		approvalTemplateName, repositoryName, err := tfcodecommit.ApprovalRuleTemplateAssociationParseID(rs.Primary.ID)
 
@@ -118,7 +118,7 @@ func testAccCheckCodeCommitApprovalRuleTemplateAssociationExists(name string) re
 }
 
 func testAccCheckCodeCommitApprovalRuleTemplateAssociationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeCommitConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeCommitConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codecommit_approval_rule_template_association" {
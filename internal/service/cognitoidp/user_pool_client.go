@@ -246,7 +246,7 @@ func ResourceUserPoolClient() *schema.Resource {
 }
 
 func resourceUserPoolClientCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CognitoIDPConn
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
 
 	params := &cognitoidentityprovider.CreateUserPoolClientInput{
 		ClientName: aws.String(d.Get("name").(string)),
@@ -339,7 +339,7 @@ func resourceUserPoolClientCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceUserPoolClientRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CognitoIDPConn
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
 
 	params := &cognitoidentityprovider.DescribeUserPoolClientInput{
 		ClientId:   aws.String(d.Id()),
@@ -391,7 +391,7 @@ func resourceUserPoolClientRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceUserPoolClientUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CognitoIDPConn
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
 
 	params := &cognitoidentityprovider.UpdateUserPoolClientInput{
 		ClientId:              aws.String(d.Id()),
@@ -480,7 +480,7 @@ func resourceUserPoolClientUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceUserPoolClientDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CognitoIDPConn
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
 
 	params := &cognitoidentityprovider.DeleteUserPoolClientInput{
 		ClientId:   aws.String(d.Id()),
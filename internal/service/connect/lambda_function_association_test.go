@@ -82,7 +82,7 @@ func testAccLambdaFunctionAssociation_disappears(t *testing.T) {
 }
 
 func testAccCheckLambdaFunctionAssociationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_connect_lambda_function_association" {
@@ -128,7 +128,7 @@ func testAccCheckLambdaFunctionAssociationExists(resourceName string) resource.T
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn()
 
 		lfaArn, err := tfconnect.FindLambdaFunctionAssociationByArnWithContext(context.Background(), conn, instanceID, functionArn)
 
@@ -88,7 +88,7 @@ func ResourceAgent() *schema.Resource {
 }
 
 func resourceAgentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DataSyncConn
+	conn := meta.(*conns.AWSClient).DataSyncConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -206,7 +206,7 @@ func resourceAgentCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAgentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DataSyncConn
+	conn := meta.(*conns.AWSClient).DataSyncConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -257,7 +257,7 @@ func resourceAgentRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAgentUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DataSyncConn
+	conn := meta.(*conns.AWSClient).DataSyncConn()
 
 	if d.HasChange("name") {
 		input := &datasync.UpdateAgentInput{
@@ -276,7 +276,7 @@ func resourceAgentUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
-		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+		if err := UpdateTags(conn, d.Id(), o, n, meta.(*conns.AWSClient).IgnoreTagsConfig); err != nil {
 			return fmt.Errorf("error updating DataSync Agent (%s) tags: %w", d.Id(), err)
 		}
 	}
@@ -285,7 +285,7 @@ func resourceAgentUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAgentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DataSyncConn
+	conn := meta.(*conns.AWSClient).DataSyncConn()
 
 	log.Printf("[DEBUG] Deleting DataSync Agent: %s", d.Id())
 	_, err := conn.DeleteAgent(&datasync.DeleteAgentInput{
@@ -17,37 +17,37 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_datasync_agent", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_agent", &resource.Sweeper{
 		Name: "aws_datasync_agent",
 		F:    sweepAgents,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_efs", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_efs", &resource.Sweeper{
 		Name: "aws_datasync_location_efs",
 		F:    sweepLocationEFSs,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_fsx_windows_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_fsx_windows_file_system", &resource.Sweeper{
 		Name: "aws_datasync_location_fsx_windows_file_system",
 		F:    sweepLocationFSxWindows,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_nfs", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_nfs", &resource.Sweeper{
 		Name: "aws_datasync_location_nfs",
 		F:    sweepLocationNFSs,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_s3", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_s3", &resource.Sweeper{
 		Name: "aws_datasync_location_s3",
 		F:    sweepLocationS3s,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_smb", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_smb", &resource.Sweeper{
 		Name: "aws_datasync_location_smb",
 		F:    sweepLocationSMBs,
 	})
 
-	resource.AddTestSweepers("aws_datasync_task", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_task", &resource.Sweeper{
 		Name: "aws_datasync_task",
 		F:    sweepTasks,
 	})
@@ -58,7 +58,7 @@ func sweepAgents(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).DataSyncConn
+	conn := client.(*conns.AWSClient).DataSyncConn()
 
 	input := &datasync.ListAgentsInput{}
 	for {
@@ -112,7 +112,7 @@ func sweepLocationEFSs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).DataSyncConn
+	conn := client.(*conns.AWSClient).DataSyncConn()
 
 	input := &datasync.ListLocationsInput{}
 	for {
@@ -169,7 +169,7 @@ func sweepLocationFSxWindows(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).DataSyncConn
+	conn := client.(*conns.AWSClient).DataSyncConn()
 
 	input := &datasync.ListLocationsInput{}
 	for {
@@ -226,7 +226,7 @@ func sweepLocationNFSs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).DataSyncConn
+	conn := client.(*conns.AWSClient).DataSyncConn()
 
 	input := &datasync.ListLocationsInput{}
 	for {
@@ -282,7 +282,7 @@ func sweepLocationS3s(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).DataSyncConn
+	conn := client.(*conns.AWSClient).DataSyncConn()
 
 	input := &datasync.ListLocationsInput{}
 	for {
@@ -339,7 +339,7 @@ func sweepLocationSMBs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).DataSyncConn
+	conn := client.(*conns.AWSClient).DataSyncConn()
 
 	input := &datasync.ListLocationsInput{}
 	for {
@@ -395,7 +395,7 @@ func sweepTasks(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).DataSyncConn
+	conn := client.(*conns.AWSClient).DataSyncConn()
 
 	input := &datasync.ListTasksInput{}
 	for {
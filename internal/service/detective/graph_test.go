@@ -120,7 +120,7 @@ func TestAccDetectiveGraph_disappears(t *testing.T) {
 }
 
 func testAccCheckDetectiveGraphDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_detective_graph" {
@@ -153,7 +153,7 @@ func testAccCheckDetectiveGraphExists(resourceName string, graph *detective.Grap
 			return fmt.Errorf("not found: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn()
 		resp, err := tfdetective.FindDetectiveGraphByArn(conn, context.Background(), rs.Primary.ID)
 
 		if err != nil {
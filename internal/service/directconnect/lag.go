@@ -82,7 +82,7 @@ func ResourceLag() *schema.Resource {
 }
 
 func resourceLagCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DirectConnectConn
+	conn := meta.(*conns.AWSClient).DirectConnectConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -132,7 +132,7 @@ func resourceLagCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLagRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DirectConnectConn
+	conn := meta.(*conns.AWSClient).DirectConnectConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -185,7 +185,7 @@ func resourceLagRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLagUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DirectConnectConn
+	conn := meta.(*conns.AWSClient).DirectConnectConn()
 
 	if d.HasChange("name") {
 		input := &directconnect.UpdateLagInput{
@@ -205,7 +205,7 @@ func resourceLagUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
-		if err := UpdateTags(conn, arn, o, n); err != nil {
+		if err := UpdateTags(conn, arn, o, n, meta.(*conns.AWSClient).IgnoreTagsConfig); err != nil {
 			return fmt.Errorf("error updating Direct Connect LAG (%s) tags: %w", arn, err)
 		}
 	}
@@ -214,7 +214,7 @@ func resourceLagUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLagDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DirectConnectConn
+	conn := meta.(*conns.AWSClient).DirectConnectConn()
 
 	if d.Get("force_destroy").(bool) {
 		lag, err := FindLagByID(conn, d.Id())
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dms_replication_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dms_replication_instance", &resource.Sweeper{
 		Name: "aws_dms_replication_instance",
 		F:    sweepReplicationInstances,
 		Dependencies: []string{
@@ -24,7 +24,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dms_replication_task", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dms_replication_task", &resource.Sweeper{
 		Name: "aws_dms_replication_task",
 		F:    sweepReplicationTasks,
 	})
@@ -37,7 +37,7 @@ func sweepReplicationInstances(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).DMSConn
+	conn := client.(*conns.AWSClient).DMSConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -48,7 +48,10 @@ func sweepReplicationInstances(region string) error {
 			d.Set("replication_instance_arn", instance.ReplicationInstanceArn)
 			d.SetId(aws.StringValue(instance.ReplicationInstanceIdentifier))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResource := sweep.NewSweepResource(r, d, client)
+			sweepResource.CreationTime = aws.TimeValue(instance.InstanceCreateTime)
+
+			sweepResources = append(sweepResources, sweepResource)
 		}
 
 		return !lastPage
@@ -77,7 +80,7 @@ func sweepReplicationTasks(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).DMSConn
+	conn := client.(*conns.AWSClient).DMSConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -91,7 +94,10 @@ func sweepReplicationTasks(region string) error {
 			d.SetId(aws.StringValue(instance.ReplicationTaskIdentifier))
 			d.Set("replication_task_arn", instance.ReplicationTaskArn)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResource := sweep.NewSweepResource(r, d, client)
+			sweepResource.CreationTime = aws.TimeValue(instance.ReplicationTaskCreationDate)
+
+			sweepResources = append(sweepResources, sweepResource)
 		}
 
 		return !lastPage
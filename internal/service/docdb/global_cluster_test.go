@@ -305,7 +305,7 @@ func testAccCheckDocDBGlobalClusterExists(resourceName string, globalCluster *do
 			return fmt.Errorf("no DocDB Global Cluster ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DocDBConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DocDBConn()
 		cluster, err := tfdocdb.FindGlobalClusterById(context.TODO(), conn, rs.Primary.ID)
 
 		if err != nil {
@@ -327,7 +327,7 @@ func testAccCheckDocDBGlobalClusterExists(resourceName string, globalCluster *do
 }
 
 func testAccCheckDocDBGlobalClusterDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DocDBConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DocDBConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_docdb_global_cluster" {
@@ -356,7 +356,7 @@ func testAccCheckDocDBGlobalClusterDestroy(s *terraform.State) error {
 
 func testAccCheckDocDBGlobalClusterDisappears(globalCluster *docdb.GlobalCluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DocDBConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DocDBConn()
 
 		input := &docdb.DeleteGlobalClusterInput{
 			GlobalClusterIdentifier: globalCluster.GlobalClusterIdentifier,
@@ -393,7 +393,7 @@ func testAccCheckDocDBGlobalClusterRecreated(i, j *docdb.GlobalCluster) resource
 }
 
 func testAccPreCheckDocDBGlobalCluster(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DocDBConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DocDBConn()
 
 	input := &docdb.DescribeGlobalClustersInput{}
 
@@ -67,6 +67,7 @@ func ResourceTable() *schema.Resource {
 				}
 				return nil
 			},
+			verify.ARNInProviderRegion("server_side_encryption.0.kms_key_arn"),
 			verify.SetTagsDiff,
 		),
 
@@ -231,10 +232,10 @@ func ResourceTable() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"kms_key_arn": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: verify.ValidARN,
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: verify.ValidARNPartitionRegion,
 						},
 						"region_name": {
 							Type:     schema.TypeString,
@@ -255,10 +256,10 @@ func ResourceTable() *schema.Resource {
 							Required: true,
 						},
 						"kms_key_arn": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: verify.ValidARN,
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: verify.ValidARNPartitionRegion,
 						},
 					},
 				},
@@ -310,10 +311,10 @@ func ResourceTable() *schema.Resource {
 							Default:  false,
 						},
 						"kms_key_arn": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: verify.ValidARN,
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: verify.ValidARNPartitionRegion,
 						},
 					},
 				},
@@ -350,7 +351,7 @@ func ResourceTable() *schema.Resource {
 }
 
 func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -576,7 +577,7 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTableRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -711,7 +712,7 @@ func resourceTableRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTableUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 	billingMode := d.Get("billing_mode").(string)
 
 	// Global Secondary Index operations must occur in multiple phases
@@ -880,7 +881,7 @@ func resourceTableUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
-		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n, meta.(*conns.AWSClient).IgnoreTagsConfig); err != nil {
 			return fmt.Errorf("error updating DynamoDB Table (%s) tags: %w", d.Id(), err)
 		}
 	}
@@ -901,7 +902,7 @@ func resourceTableUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTableDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 
 	log.Printf("[DEBUG] DynamoDB delete table: %s", d.Id())
 
@@ -48,7 +48,7 @@ func ResourceAMILaunchPermission() *schema.Resource {
 }
 
 func resourceAMILaunchPermissionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	image_id := d.Get("image_id").(string)
 	account_id := d.Get("account_id").(string)
@@ -71,7 +71,7 @@ func resourceAMILaunchPermissionCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAMILaunchPermissionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	exists, err := HasLaunchPermission(conn, d.Get("image_id").(string), d.Get("account_id").(string))
 	if err != nil {
@@ -91,7 +91,7 @@ func resourceAMILaunchPermissionRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAMILaunchPermissionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	image_id := d.Get("image_id").(string)
 	account_id := d.Get("account_id").(string)
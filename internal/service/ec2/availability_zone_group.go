@@ -46,7 +46,7 @@ func ResourceAvailabilityZoneGroup() *schema.Resource {
 }
 
 func resourceAvailabilityZoneGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	configurationOptInStatus := d.Get("opt_in_status").(string)
 
@@ -77,7 +77,7 @@ func resourceAvailabilityZoneGroupCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAvailabilityZoneGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	availabilityZone, err := ec2DescribeAvailabilityZoneGroup(conn, d.Id())
 
@@ -96,7 +96,7 @@ func resourceAvailabilityZoneGroupRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAvailabilityZoneGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	optInStatus := d.Get("opt_in_status").(string)
 
 	input := &ec2.ModifyAvailabilityZoneGroupInput{
@@ -29,7 +29,7 @@ func DataSourceCoIPPools() *schema.Resource {
 }
 
 func dataSourceCoIPPoolsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	req := &ec2.DescribeCoipPoolsInput{}
 
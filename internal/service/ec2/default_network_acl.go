@@ -213,7 +213,7 @@ func resourceDefaultNetworkACLCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceDefaultNetworkACLUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("ingress") {
 		err := updateNetworkAclEntries(d, "ingress", conn)
@@ -280,7 +280,7 @@ func resourceDefaultNetworkACLUpdate(d *schema.ResourceData, meta interface{}) e
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
-		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+		if err := UpdateTags(conn, d.Id(), o, n, meta.(*conns.AWSClient).IgnoreTagsConfig); err != nil {
 			return fmt.Errorf("error updating EC2 Default Network ACL (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -297,7 +297,7 @@ func resourceDefaultNetworkACLDelete(d *schema.ResourceData, meta interface{}) e
 // revokeAllNetworkACLEntries revoke all ingress and egress rules that the Default
 // Network ACL currently has
 func revokeAllNetworkACLEntries(netaclId string, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	resp, err := conn.DescribeNetworkAcls(&ec2.DescribeNetworkAclsInput{
 		NetworkAclIds: []*string{aws.String(netaclId)},
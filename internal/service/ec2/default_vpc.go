@@ -36,7 +36,7 @@ func ResourceDefaultVPC() *schema.Resource {
 }
 
 func resourceDefaultVPCCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	req := &ec2.DescribeVpcsInput{
 		Filters: []*ec2.Filter{
 			{
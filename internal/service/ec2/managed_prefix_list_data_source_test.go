@@ -15,7 +15,7 @@ import (
 
 func testAccManagedPrefixListGetIdByNameDataSource(name string, id *string, arn *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		output, err := conn.DescribeManagedPrefixLists(&ec2.DescribeManagedPrefixListsInput{
 			Filters: []*ec2.Filter{
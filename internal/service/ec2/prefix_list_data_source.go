@@ -35,7 +35,7 @@ func DataSourcePrefixList() *schema.Resource {
 }
 
 func dataSourcePrefixListRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	filters, filtersOk := d.GetOk("filter")
 
@@ -35,7 +35,7 @@ func ResourceSnapshotCreateVolumePermission() *schema.Resource {
 }
 
 func resourceSnapshotCreateVolumePermissionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	snapshot_id := d.Get("snapshot_id").(string)
 	account_id := d.Get("account_id").(string)
@@ -74,7 +74,7 @@ func resourceSnapshotCreateVolumePermissionCreate(d *schema.ResourceData, meta i
 }
 
 func resourceSnapshotCreateVolumePermissionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	snapshotID, accountID, err := SnapshotCreateVolumePermissionParseID(d.Id())
 	if err != nil {
@@ -95,7 +95,7 @@ func resourceSnapshotCreateVolumePermissionRead(d *schema.ResourceData, meta int
 }
 
 func resourceSnapshotCreateVolumePermissionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	snapshotID, accountID, err := SnapshotCreateVolumePermissionParseID(d.Id())
 	if err != nil {
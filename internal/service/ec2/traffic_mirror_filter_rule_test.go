@@ -147,7 +147,7 @@ func testAccCheckTrafficMirrorFilterRuleExists(name string) resource.TestCheckFu
 		ruleId := rs.Primary.ID
 		filterId := rs.Primary.Attributes["traffic_mirror_filter_id"]
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		out, err := conn.DescribeTrafficMirrorFilters(&ec2.DescribeTrafficMirrorFiltersInput{
 			TrafficMirrorFilterIds: []*string{
 				aws.String(filterId),
@@ -225,7 +225,7 @@ resource "aws_ec2_traffic_mirror_filter_rule" "test" {
 }
 
 func testAccPreCheckTrafficMirrorFilterRule(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	_, err := conn.DescribeTrafficMirrorFilters(&ec2.DescribeTrafficMirrorFiltersInput{})
 
@@ -239,7 +239,7 @@ func testAccPreCheckTrafficMirrorFilterRule(t *testing.T) {
 }
 
 func testAccCheckTrafficMirrorFilterRuleDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_traffic_mirror_filter_rule" {
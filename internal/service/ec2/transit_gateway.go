@@ -121,7 +121,7 @@ func ResourceTransitGateway() *schema.Resource {
 }
 
 func resourceTransitGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -160,7 +160,7 @@ func resourceTransitGatewayCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceTransitGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -220,7 +220,7 @@ func resourceTransitGatewayRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	modifyTransitGatewayInput := &ec2.ModifyTransitGatewayInput{}
 	transitGatewayModified := false
@@ -267,7 +267,7 @@ func resourceTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) erro
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
-		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+		if err := UpdateTags(conn, d.Id(), o, n, meta.(*conns.AWSClient).IgnoreTagsConfig); err != nil {
 			return fmt.Errorf("error updating EC2 Transit Gateway (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -276,7 +276,7 @@ func resourceTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceTransitGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DeleteTransitGatewayInput{
 		TransitGatewayId: aws.String(d.Id()),
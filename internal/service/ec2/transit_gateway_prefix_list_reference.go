@@ -53,7 +53,7 @@ func ResourceTransitGatewayPrefixListReference() *schema.Resource {
 }
 
 func resourceTransitGatewayPrefixListReferenceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.CreateTransitGatewayPrefixListReferenceInput{}
 
@@ -93,7 +93,7 @@ func resourceTransitGatewayPrefixListReferenceCreate(d *schema.ResourceData, met
 }
 
 func resourceTransitGatewayPrefixListReferenceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	transitGatewayPrefixListReference, err := FindTransitGatewayPrefixListReferenceByID(conn, d.Id())
 
@@ -135,7 +135,7 @@ func resourceTransitGatewayPrefixListReferenceRead(d *schema.ResourceData, meta
 }
 
 func resourceTransitGatewayPrefixListReferenceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.ModifyTransitGatewayPrefixListReferenceInput{}
 
@@ -173,7 +173,7 @@ func resourceTransitGatewayPrefixListReferenceUpdate(d *schema.ResourceData, met
 }
 
 func resourceTransitGatewayPrefixListReferenceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	transitGatewayRouteTableID, prefixListID, err := TransitGatewayPrefixListReferenceParseID(d.Id())
 
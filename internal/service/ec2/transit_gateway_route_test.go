@@ -175,7 +175,7 @@ func testAccCheckTransitGatewayRouteExists(resourceName string, transitGatewayRo
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		route, err := tfec2.DescribeTransitGatewayRoute(conn, transitGatewayRouteTableID, destination)
 
@@ -194,7 +194,7 @@ func testAccCheckTransitGatewayRouteExists(resourceName string, transitGatewayRo
 }
 
 func testAccCheckTransitGatewayRouteDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_transit_gateway_route" {
@@ -229,7 +229,7 @@ func testAccCheckTransitGatewayRouteDestroy(s *terraform.State) error {
 
 func testAccCheckTransitGatewayRouteDisappears(transitGateway *ec2.TransitGateway, transitGatewayRoute *ec2.TransitGatewayRoute) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		input := &ec2.DeleteTransitGatewayRouteInput{
 			DestinationCidrBlock:       transitGatewayRoute.DestinationCidrBlock,
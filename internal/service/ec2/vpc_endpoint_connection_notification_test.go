@@ -52,7 +52,7 @@ func TestAccEC2VPCEndpointConnectionNotification_basic(t *testing.T) {
 }
 
 func testAccCheckVpcEndpointConnectionNotificationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_endpoint_connection_notification" {
@@ -90,7 +90,7 @@ func testAccCheckVpcEndpointConnectionNotificationExists(n string) resource.Test
 			return fmt.Errorf("No VPC Endpoint connection notification ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		resp, err := conn.DescribeVpcEndpointConnectionNotifications(&ec2.DescribeVpcEndpointConnectionNotificationsInput{
 			ConnectionNotificationId: aws.String(rs.Primary.ID),
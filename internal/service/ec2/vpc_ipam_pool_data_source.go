@@ -92,7 +92,7 @@ func DataSourceVPCIpamPool() *schema.Resource {
 }
 
 func dataSourceVPCIpamPoolRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeIpamPoolsInput{}
 
 	if v, ok := d.GetOk("ipam_pool_id"); ok {
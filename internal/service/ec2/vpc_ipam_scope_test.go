@@ -92,7 +92,7 @@ func TestAccVPCIpamScope_tags(t *testing.T) {
 }
 
 func testAccCheckVPCIpamScopeDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_ipam_scope" {
@@ -72,7 +72,7 @@ func ResourceVPCIPv4CIDRBlockAssociation() *schema.Resource {
 }
 
 func resourceVPCIPv4CIDRBlockAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	req := &ec2.AssociateVpcCidrBlockInput{
 		VpcId: aws.String(d.Get("vpc_id").(string)),
@@ -115,7 +115,7 @@ func resourceVPCIPv4CIDRBlockAssociationCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceVPCIPv4CIDRBlockAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeVpcsInput{
 		Filters: BuildAttributeFilterList(
@@ -160,7 +160,7 @@ func resourceVPCIPv4CIDRBlockAssociationRead(d *schema.ResourceData, meta interf
 }
 
 func resourceVPCIPv4CIDRBlockAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	log.Printf("[DEBUG] Deleting VPC IPv4 CIDR block association: %s", d.Id())
 	_, err := conn.DisassociateVpcCidrBlock(&ec2.DisassociateVpcCidrBlockInput{
@@ -338,7 +338,7 @@ func TestAccECSCluster_configuration(t *testing.T) {
 }
 
 func testAccCheckClusterDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecs_cluster" {
@@ -368,7 +368,7 @@ func testAccCheckClusterExists(resourceName string, cluster *ecs.Cluster) resour
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn()
 		output, err := tfecs.FindClusterByNameOrARN(conn, rs.Primary.ID)
 
 		if err != nil {
@@ -664,7 +664,7 @@ func testAccCheckAddonExists(ctx context.Context, resourceName string, addon *ek
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSConn()
 
 		output, err := tfeks.FindAddonByClusterNameAndAddonName(ctx, conn, clusterName, addonName)
 
@@ -680,7 +680,7 @@ func testAccCheckAddonExists(ctx context.Context, resourceName string, addon *ek
 
 func testAccCheckAddonDestroy(s *terraform.State) error {
 	ctx := context.TODO()
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EKSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EKSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_eks_addon" {
@@ -710,7 +710,7 @@ func testAccCheckAddonDestroy(s *terraform.State) error {
 }
 
 func testAccPreCheckAddon(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EKSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EKSConn()
 
 	input := &eks.DescribeAddonVersionsInput{}
 
@@ -727,9 +727,9 @@ func testAccPreCheckAddon(t *testing.T) {
 
 func testAccCheckEksAddonUpdateTags(addon *eks.Addon, oldTags, newTags map[string]string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSConn()
 
-		return tfeks.UpdateTags(conn, aws.StringValue(addon.AddonArn), oldTags, newTags)
+		return tfeks.UpdateTags(conn, aws.StringValue(addon.AddonArn), oldTags, newTags, nil)
 	}
 }
 
@@ -1,4 +1,8 @@
 //go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsMap -UpdateTags
+//go:generate go run ../../generate/resourceid/main.go -Name=Addon -Parts=cluster-name,addon-name
+//go:generate go run ../../generate/resourceid/main.go -Name=FargateProfile -Parts=cluster-name,fargate-profile-name
+//go:generate go run ../../generate/resourceid/main.go -Name=IdentityProviderConfig -Parts=cluster-name,config-name
+//go:generate go run ../../generate/resourceid/main.go -Name=NodeGroup -Parts=cluster-name,node-group-name
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package eks
@@ -2,6 +2,7 @@ package eks
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -80,11 +81,12 @@ func waitAddonUpdateSuccessful(ctx context.Context, conn *eks.EKS, clusterName,
 }
 
 func waitClusterCreated(conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{eks.ClusterStatusCreating},
-		Target:  []string{eks.ClusterStatusActive},
-		Refresh: statusCluster(conn, name),
-		Timeout: timeout,
+	stateConf := &tfresource.Waiter{
+		Description: fmt.Sprintf("EKS Cluster (%s) creation", name),
+		Pending:     []string{eks.ClusterStatusCreating},
+		Target:      []string{eks.ClusterStatusActive},
+		Refresh:     statusCluster(conn, name),
+		Timeout:     timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -97,11 +99,12 @@ func waitClusterCreated(conn *eks.EKS, name string, timeout time.Duration) (*eks
 }
 
 func waitClusterDeleted(conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{eks.ClusterStatusActive, eks.ClusterStatusDeleting},
-		Target:  []string{},
-		Refresh: statusCluster(conn, name),
-		Timeout: timeout,
+	stateConf := &tfresource.Waiter{
+		Description: fmt.Sprintf("EKS Cluster (%s) deletion", name),
+		Pending:     []string{eks.ClusterStatusActive, eks.ClusterStatusDeleting},
+		Target:      []string{},
+		Refresh:     statusCluster(conn, name),
+		Timeout:     timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -169,20 +172,18 @@ func waitFargateProfileDeleted(conn *eks.EKS, clusterName, fargateProfileName st
 }
 
 func waitNodegroupCreated(ctx context.Context, conn *eks.EKS, clusterName, nodeGroupName string, timeout time.Duration) (*eks.Nodegroup, error) {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{eks.NodegroupStatusCreating},
-		Target:  []string{eks.NodegroupStatusActive},
-		Refresh: statusNodegroup(conn, clusterName, nodeGroupName),
-		Timeout: timeout,
+	stateConf := &tfresource.Waiter{
+		Description:  fmt.Sprintf("EKS Node Group (%s) creation", NodeGroupCreateResourceID(clusterName, nodeGroupName)),
+		Pending:      []string{eks.NodegroupStatusCreating},
+		Target:       []string{eks.NodegroupStatusActive},
+		Refresh:      statusNodegroup(conn, clusterName, nodeGroupName),
+		Timeout:      timeout,
+		StatusReason: nodegroupStatusReason,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*eks.Nodegroup); ok {
-		if status, health := aws.StringValue(output.Status), output.Health; status == eks.NodegroupStatusCreateFailed && health != nil {
-			tfresource.SetLastError(err, IssuesError(health.Issues))
-		}
-
 		return output, err
 	}
 
@@ -190,26 +191,39 @@ func waitNodegroupCreated(ctx context.Context, conn *eks.EKS, clusterName, nodeG
 }
 
 func waitNodegroupDeleted(ctx context.Context, conn *eks.EKS, clusterName, nodeGroupName string, timeout time.Duration) (*eks.Nodegroup, error) {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{eks.NodegroupStatusActive, eks.NodegroupStatusDeleting},
-		Target:  []string{},
-		Refresh: statusNodegroup(conn, clusterName, nodeGroupName),
-		Timeout: timeout,
+	stateConf := &tfresource.Waiter{
+		Description:  fmt.Sprintf("EKS Node Group (%s) deletion", NodeGroupCreateResourceID(clusterName, nodeGroupName)),
+		Pending:      []string{eks.NodegroupStatusActive, eks.NodegroupStatusDeleting},
+		Target:       []string{},
+		Refresh:      statusNodegroup(conn, clusterName, nodeGroupName),
+		Timeout:      timeout,
+		StatusReason: nodegroupStatusReason,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*eks.Nodegroup); ok {
-		if status, health := aws.StringValue(output.Status), output.Health; status == eks.NodegroupStatusDeleteFailed && health != nil {
-			tfresource.SetLastError(err, IssuesError(health.Issues))
-		}
-
 		return output, err
 	}
 
 	return nil, err
 }
 
+// nodegroupStatusReason returns the health issues of a node group, if any.
+func nodegroupStatusReason(v interface{}) string {
+	output, ok := v.(*eks.Nodegroup)
+
+	if !ok || output.Health == nil {
+		return ""
+	}
+
+	if err := IssuesError(output.Health.Issues); err != nil {
+		return err.Error()
+	}
+
+	return ""
+}
+
 func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.EKS, clusterName, nodeGroupName, id string, timeout time.Duration) (*eks.Update, error) { //nolint:unparam
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{eks.UpdateStatusInProgress},
@@ -41,7 +41,7 @@ func TestAccElastiCacheSecurityGroup_basic(t *testing.T) {
 }
 
 func testAccCheckSecurityGroupDestroy(s *terraform.State) error {
-	conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).ElastiCacheConn
+	conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).ElastiCacheConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticache_security_group" {
@@ -73,7 +73,7 @@ func testAccCheckSecurityGroupExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No cache security group ID is set")
 		}
 
-		conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).ElastiCacheConn
+		conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).ElastiCacheConn()
 		_, err := conn.DescribeCacheSecurityGroups(&elasticache.DescribeCacheSecurityGroupsInput{
 			CacheSecurityGroupName: aws.String(rs.Primary.ID),
 		})
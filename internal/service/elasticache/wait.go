@@ -1,10 +1,12 @@
 package elasticache
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -24,17 +26,18 @@ const (
 
 // WaitReplicationGroupAvailable waits for a ReplicationGroup to return Available
 func WaitReplicationGroupAvailable(conn *elasticache.ElastiCache, replicationGroupID string, timeout time.Duration) (*elasticache.ReplicationGroup, error) {
-	stateConf := &resource.StateChangeConf{
+	stateConf := &tfresource.Waiter{
+		Description: fmt.Sprintf("ElastiCache Replication Group (%s) availability", replicationGroupID),
 		Pending: []string{
 			ReplicationGroupStatusCreating,
 			ReplicationGroupStatusModifying,
 			ReplicationGroupStatusSnapshotting,
 		},
-		Target:     []string{ReplicationGroupStatusAvailable},
-		Refresh:    StatusReplicationGroup(conn, replicationGroupID),
-		Timeout:    timeout,
-		MinTimeout: replicationGroupAvailableMinTimeout,
-		Delay:      replicationGroupAvailableDelay,
+		Target:          []string{ReplicationGroupStatusAvailable},
+		Refresh:         StatusReplicationGroup(conn, replicationGroupID),
+		Timeout:         timeout,
+		MinPollInterval: replicationGroupAvailableMinTimeout,
+		Delay:           replicationGroupAvailableDelay,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -46,17 +49,18 @@ func WaitReplicationGroupAvailable(conn *elasticache.ElastiCache, replicationGro
 
 // WaitReplicationGroupDeleted waits for a ReplicationGroup to be deleted
 func WaitReplicationGroupDeleted(conn *elasticache.ElastiCache, replicationGroupID string, timeout time.Duration) (*elasticache.ReplicationGroup, error) {
-	stateConf := &resource.StateChangeConf{
+	stateConf := &tfresource.Waiter{
+		Description: fmt.Sprintf("ElastiCache Replication Group (%s) deletion", replicationGroupID),
 		Pending: []string{
 			ReplicationGroupStatusCreating,
 			ReplicationGroupStatusAvailable,
 			ReplicationGroupStatusDeleting,
 		},
-		Target:     []string{},
-		Refresh:    StatusReplicationGroup(conn, replicationGroupID),
-		Timeout:    timeout,
-		MinTimeout: replicationGroupDeletedMinTimeout,
-		Delay:      replicationGroupDeletedDelay,
+		Target:          []string{},
+		Refresh:         StatusReplicationGroup(conn, replicationGroupID),
+		Timeout:         timeout,
+		MinPollInterval: replicationGroupDeletedMinTimeout,
+		Delay:           replicationGroupDeletedDelay,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -102,18 +106,19 @@ const (
 
 // waitCacheClusterAvailable waits for a Cache Cluster to return Available
 func waitCacheClusterAvailable(conn *elasticache.ElastiCache, cacheClusterID string, timeout time.Duration) (*elasticache.CacheCluster, error) { //nolint:unparam
-	stateConf := &resource.StateChangeConf{
+	stateConf := &tfresource.Waiter{
+		Description: fmt.Sprintf("ElastiCache Cache Cluster (%s) availability", cacheClusterID),
 		Pending: []string{
 			CacheClusterStatusCreating,
 			CacheClusterStatusModifying,
 			CacheClusterStatusSnapshotting,
 			CacheClusterStatusRebootingClusterNodes,
 		},
-		Target:     []string{CacheClusterStatusAvailable},
-		Refresh:    StatusCacheCluster(conn, cacheClusterID),
-		Timeout:    timeout,
-		MinTimeout: cacheClusterAvailableMinTimeout,
-		Delay:      cacheClusterAvailableDelay,
+		Target:          []string{CacheClusterStatusAvailable},
+		Refresh:         StatusCacheCluster(conn, cacheClusterID),
+		Timeout:         timeout,
+		MinPollInterval: cacheClusterAvailableMinTimeout,
+		Delay:           cacheClusterAvailableDelay,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -125,7 +130,8 @@ func waitCacheClusterAvailable(conn *elasticache.ElastiCache, cacheClusterID str
 
 // WaitCacheClusterDeleted waits for a Cache Cluster to be deleted
 func WaitCacheClusterDeleted(conn *elasticache.ElastiCache, cacheClusterID string, timeout time.Duration) (*elasticache.CacheCluster, error) {
-	stateConf := &resource.StateChangeConf{
+	stateConf := &tfresource.Waiter{
+		Description: fmt.Sprintf("ElastiCache Cache Cluster (%s) deletion", cacheClusterID),
 		Pending: []string{
 			CacheClusterStatusCreating,
 			CacheClusterStatusAvailable,
@@ -135,11 +141,11 @@ func WaitCacheClusterDeleted(conn *elasticache.ElastiCache, cacheClusterID strin
 			CacheClusterStatusRestoreFailed,
 			CacheClusterStatusSnapshotting,
 		},
-		Target:     []string{},
-		Refresh:    StatusCacheCluster(conn, cacheClusterID),
-		Timeout:    timeout,
-		MinTimeout: cacheClusterDeletedMinTimeout,
-		Delay:      cacheClusterDeletedDelay,
+		Target:          []string{},
+		Refresh:         StatusCacheCluster(conn, cacheClusterID),
+		Timeout:         timeout,
+		MinPollInterval: cacheClusterDeletedMinTimeout,
+		Delay:           cacheClusterDeletedDelay,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -56,7 +56,7 @@ func ResourceConfigurationTemplate() *schema.Resource {
 }
 
 func resourceConfigurationTemplateCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn
+	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
 
 	// Get the relevant properties
 	name := d.Get("name").(string)
@@ -93,7 +93,7 @@ func resourceConfigurationTemplateCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceConfigurationTemplateRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn
+	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
 
 	log.Printf("[DEBUG] Elastic Beanstalk configuration template read: %s", d.Get("name").(string))
 
@@ -127,7 +127,7 @@ func resourceConfigurationTemplateRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceConfigurationTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn
+	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
 
 	log.Printf("[DEBUG] Elastic Beanstalk configuration template update: %s", d.Get("name").(string))
 
@@ -222,7 +222,7 @@ func resourceConfigurationTemplateOptionSettingsUpdate(conn *elasticbeanstalk.El
 }
 
 func resourceConfigurationTemplateDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn
+	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
 
 	application := d.Get("application").(string)
 
@@ -163,7 +163,7 @@ func testAccCheckEmrStudioExists(resourceName string, studio *emr.Studio) resour
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn()
 
 		output, err := tfemr.FindStudioByID(conn, rs.Primary.ID)
 		if err != nil {
@@ -181,7 +181,7 @@ func testAccCheckEmrStudioExists(resourceName string, studio *emr.Studio) resour
 }
 
 func testAccCheckEmrStudioDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_emr_studio" {
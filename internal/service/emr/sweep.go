@@ -16,12 +16,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_emr_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emr_cluster", &resource.Sweeper{
 		Name: "aws_emr_cluster",
 		F:    sweepClusters,
 	})
 
-	resource.AddTestSweepers("aws_emr_studio", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emr_studio", &resource.Sweeper{
 		Name: "aws_emr_studio",
 		F:    sweepStudios,
 	})
@@ -32,7 +32,7 @@ func sweepClusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).EMRConn
+	conn := client.(*conns.AWSClient).EMRConn()
 
 	input := &emr.ListClustersInput{
 		ClusterStates: []*string{
@@ -88,7 +88,7 @@ func sweepStudios(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).EMRConn
+	conn := client.(*conns.AWSClient).EMRConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var sweeperErrs *multierror.Error
 	input := &emr.ListStudiosInput{}
@@ -103,7 +103,10 @@ func sweepStudios(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(studio.StudioId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResource := sweep.NewSweepResource(r, d, client)
+			sweepResource.CreationTime = aws.TimeValue(studio.CreationTime)
+
+			sweepResources = append(sweepResources, sweepResource)
 		}
 
 		return !lastPage
@@ -569,7 +569,7 @@ func TestAccEventsConnection_disappears(t *testing.T) {
 }
 
 func testAccCheckConnectionDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EventsConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EventsConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_event_connection" {
@@ -599,7 +599,7 @@ func testAccCheckConnectionExists(n string, v *eventbridge.DescribeConnectionOut
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EventsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EventsConn()
 
 		output, err := tfevents.FindConnectionByName(conn, rs.Primary.ID)
 
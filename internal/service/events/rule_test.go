@@ -521,7 +521,7 @@ func testAccCheckRuleExists(n string, rule *eventbridge.DescribeRuleOutput) reso
 			return fmt.Errorf("No EventBridge Rule ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EventsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EventsConn()
 
 		resp, err := tfevents.FindRuleByResourceID(conn, rs.Primary.ID)
 
@@ -542,7 +542,7 @@ func testAccCheckRuleEnabled(n string, desired string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EventsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EventsConn()
 
 		resp, err := tfevents.FindRuleByResourceID(conn, rs.Primary.ID)
 
@@ -559,7 +559,7 @@ func testAccCheckRuleEnabled(n string, desired string) resource.TestCheckFunc {
 }
 
 func testAccCheckRuleDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EventsConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EventsConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_event_rule" {
@@ -37,7 +37,7 @@ func testAccAdminAccount_basic(t *testing.T) {
 }
 
 func testAccCheckFmsAdminAccountDestroy(s *terraform.State) error {
-	conn := testAccProviderFmsAdmin.Meta().(*conns.AWSClient).FMSConn
+	conn := testAccProviderFmsAdmin.Meta().(*conns.AWSClient).FMSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_fms_admin_account" {
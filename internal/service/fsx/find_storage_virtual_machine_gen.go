@@ -0,0 +1,72 @@
+// Code generated by "internal/generate/finder/main.go -Op=DescribeStorageVirtualMachines -Name=StorageVirtualMachine -IDField=StorageVirtualMachineIds -NotFoundCodes=StorageVirtualMachineNotFound"; DO NOT EDIT.
+
+package fsx
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// FindStorageVirtualMachineByID returns the StorageVirtualMachine with the specified ID.
+func FindStorageVirtualMachineByID(conn *fsx.FSx, id string) (*fsx.StorageVirtualMachine, error) {
+	input := &fsx.DescribeStorageVirtualMachinesInput{
+		StorageVirtualMachineIds: aws.StringSlice([]string{id}),
+	}
+
+	return FindStorageVirtualMachine(conn, input)
+}
+
+// FindStorageVirtualMachine returns the single StorageVirtualMachine matching the input.
+// A NotFoundError is returned if there is no match or more than one.
+func FindStorageVirtualMachine(conn *fsx.FSx, input *fsx.DescribeStorageVirtualMachinesInput) (*fsx.StorageVirtualMachine, error) {
+	output, err := FindStorageVirtualMachines(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+// FindStorageVirtualMachines returns all StorageVirtualMachines matching the input.
+func FindStorageVirtualMachines(conn *fsx.FSx, input *fsx.DescribeStorageVirtualMachinesInput) ([]*fsx.StorageVirtualMachine, error) {
+	var output []*fsx.StorageVirtualMachine
+
+	err := conn.DescribeStorageVirtualMachinesPages(input, func(page *fsx.DescribeStorageVirtualMachinesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.StorageVirtualMachines {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, fsx.ErrCodeStorageVirtualMachineNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -1,3 +1,7 @@
+//go:generate go run ../../generate/finder/main.go -Op=DescribeBackups -Name=Backup -IDField=BackupIds -NotFoundCodes=FileSystemNotFound,BackupNotFound
+//go:generate go run ../../generate/finder/main.go -Op=DescribeFileSystems -Name=FileSystem -IDField=FileSystemIds -NotFoundCodes=FileSystemNotFound
+//go:generate go run ../../generate/finder/main.go -Op=DescribeStorageVirtualMachines -Name=StorageVirtualMachine -IDField=StorageVirtualMachineIds -NotFoundCodes=StorageVirtualMachineNotFound
+//go:generate go run ../../generate/finder/main.go -Op=DescribeVolumes -Name=Volume -IDField=VolumeIds -NotFoundCodes=VolumeNotFound
 //go:generate go run ../../generate/tags/main.go -ListTags -ListTagsInIDElem=ResourceARN -ServiceTagsSlice -TagInIDElem=ResourceARN -UpdateTags
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
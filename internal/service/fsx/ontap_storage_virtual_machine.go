@@ -221,7 +221,7 @@ func ResourceOntapStorageVirtualMachine() *schema.Resource {
 }
 
 func resourceOntapStorageVirtualMachineCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).FSxConn
+	conn := meta.(*conns.AWSClient).FSxConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -264,7 +264,7 @@ func resourceOntapStorageVirtualMachineCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceOntapStorageVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).FSxConn
+	conn := meta.(*conns.AWSClient).FSxConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -319,12 +319,12 @@ func resourceOntapStorageVirtualMachineRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceOntapStorageVirtualMachineUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).FSxConn
+	conn := meta.(*conns.AWSClient).FSxConn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
-		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n, meta.(*conns.AWSClient).IgnoreTagsConfig); err != nil {
 			return fmt.Errorf("error updating FSx ONTAP Storage Virtual Machine (%s) tags: %w", d.Get("arn").(string), err)
 		}
 	}
@@ -358,7 +358,7 @@ func resourceOntapStorageVirtualMachineUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceOntapStorageVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).FSxConn
+	conn := meta.(*conns.AWSClient).FSxConn()
 
 	log.Printf("[DEBUG] Deleting FSx ONTAP Storage Virtual Machine: %s", d.Id())
 	_, err := conn.DeleteStorageVirtualMachine(&fsx.DeleteStorageVirtualMachineInput{
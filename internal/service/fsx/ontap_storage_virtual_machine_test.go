@@ -283,7 +283,7 @@ func testAccCheckFsxOntapStorageVirtualMachineExists(resourceName string, svm *f
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).FSxConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FSxConn()
 
 		storageVirtualMachine, err := tffsx.FindStorageVirtualMachineByID(conn, rs.Primary.ID)
 		if err != nil {
@@ -301,7 +301,7 @@ func testAccCheckFsxOntapStorageVirtualMachineExists(resourceName string, svm *f
 }
 
 func testAccCheckFsxOntapStorageVirtualMachineDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).FSxConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).FSxConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_storage_virtual_machine" {
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_globalaccelerator_accelerator", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_accelerator", &resource.Sweeper{
 		Name: "aws_globalaccelerator_accelerator",
 		F:    sweepAccelerators,
 	})
@@ -27,7 +27,7 @@ func sweepAccelerators(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).GlobalAcceleratorConn
+	conn := client.(*conns.AWSClient).GlobalAcceleratorConn()
 
 	input := &globalaccelerator.ListAcceleratorsInput{}
 	var sweeperErrs *multierror.Error
@@ -76,7 +76,7 @@ func sweepAccelerators(region string) error {
 }
 
 func sweepGlobalAcceleratorEndpointGroups(client interface{}, listenerArn *string) *multierror.Error {
-	conn := client.(*conns.AWSClient).GlobalAcceleratorConn
+	conn := client.(*conns.AWSClient).GlobalAcceleratorConn()
 	var sweeperErrs *multierror.Error
 
 	log.Printf("[INFO] deleting Endpoint Groups for Listener %s", *listenerArn)
@@ -110,7 +110,7 @@ func sweepGlobalAcceleratorEndpointGroups(client interface{}, listenerArn *strin
 }
 
 func sweepGlobalAcceleratorListeners(client interface{}, acceleratorArn *string) *multierror.Error {
-	conn := client.(*conns.AWSClient).GlobalAcceleratorConn
+	conn := client.(*conns.AWSClient).GlobalAcceleratorConn()
 	var sweeperErrs *multierror.Error
 
 	log.Printf("[INFO] deleting Listeners for Accelerator %s", *acceleratorArn)
@@ -144,7 +144,7 @@ func testAccDetector_datasources_s3logs(t *testing.T) {
 }
 
 func testAccCheckDetectorDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).GuardDutyConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GuardDutyConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_guardduty_detector" {
@@ -17,13 +17,13 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
 		Name:         "aws_guardduty_detector",
 		F:            sweepDetectors,
 		Dependencies: []string{"aws_guardduty_publishing_destination"},
 	})
 
-	resource.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
 		Name: "aws_guardduty_publishing_destination",
 		F:    sweepPublishingDestinations,
 	})
@@ -36,7 +36,7 @@ func sweepDetectors(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).GuardDutyConn
+	conn := client.(*conns.AWSClient).GuardDutyConn()
 	input := &guardduty.ListDetectorsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -82,7 +82,7 @@ func sweepPublishingDestinations(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).GuardDutyConn
+	conn := client.(*conns.AWSClient).GuardDutyConn()
 	var sweeperErrs *multierror.Error
 
 	detect_input := &guardduty.ListDetectorsInput{}
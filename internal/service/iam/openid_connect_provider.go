@@ -63,7 +63,7 @@ func ResourceOpenIDConnectProvider() *schema.Resource {
 }
 
 func resourceOpenIDConnectProviderCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -85,7 +85,7 @@ func resourceOpenIDConnectProviderCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceOpenIDConnectProviderRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -122,7 +122,7 @@ func resourceOpenIDConnectProviderRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceOpenIDConnectProviderUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 
 	if d.HasChange("thumbprint_list") {
 		input := &iam.UpdateOpenIDConnectProviderThumbprintInput{
@@ -139,7 +139,7 @@ func resourceOpenIDConnectProviderUpdate(d *schema.ResourceData, meta interface{
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
-		if err := openIDConnectProviderUpdateTags(conn, d.Id(), o, n); err != nil {
+		if err := openIDConnectProviderUpdateTags(conn, d.Id(), o, n, meta.(*conns.AWSClient).IgnoreTagsConfig); err != nil {
 			return fmt.Errorf("error updating tags for IAM OIDC Provider (%s): %w", d.Id(), err)
 		}
 	}
@@ -148,7 +148,7 @@ func resourceOpenIDConnectProviderUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceOpenIDConnectProviderDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 
 	input := &iam.DeleteOpenIDConnectProviderInput{
 		OpenIDConnectProviderArn: aws.String(d.Id()),
@@ -124,7 +124,7 @@ func CheckPwdPolicy(pass []byte) bool {
 }
 
 func resourceUserLoginProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 	username := d.Get("user").(string)
 
 	encryptionKey, err := RetrieveGPGKey(strings.TrimSpace(d.Get("pgp_key").(string)))
@@ -163,7 +163,7 @@ func resourceUserLoginProfileCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceUserLoginProfileRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 
 	input := &iam.GetLoginProfileInput{
 		UserName: aws.String(d.Id()),
@@ -211,7 +211,7 @@ func resourceUserLoginProfileRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceUserLoginProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 
 	input := &iam.DeleteLoginProfileInput{
 		UserName: aws.String(d.Id()),
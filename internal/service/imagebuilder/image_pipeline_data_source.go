@@ -107,7 +107,7 @@ func DataSourceImagePipeline() *schema.Resource {
 }
 
 func dataSourceImagePipelineRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ImageBuilderConn
+	conn := meta.(*conns.AWSClient).ImageBuilderConn()
 
 	input := &imagebuilder.GetImagePipelineInput{}
 
@@ -35,7 +35,7 @@ func DataSourceImageRecipes() *schema.Resource {
 }
 
 func dataSourceImageRecipesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ImageBuilderConn
+	conn := meta.(*conns.AWSClient).ImageBuilderConn()
 
 	input := &imagebuilder.ListImageRecipesInput{}
 
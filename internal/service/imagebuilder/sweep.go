@@ -16,32 +16,32 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_imagebuilder_component", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_component", &resource.Sweeper{
 		Name: "aws_imagebuilder_component",
 		F:    sweepComponents,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_distribution_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_distribution_configuration", &resource.Sweeper{
 		Name: "aws_imagebuilder_distribution_configuration",
 		F:    sweepDistributionConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_image_pipeline", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_image_pipeline", &resource.Sweeper{
 		Name: "aws_imagebuilder_image_pipeline",
 		F:    sweepImagePipelines,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_image_recipe", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_image_recipe", &resource.Sweeper{
 		Name: "aws_imagebuilder_image_recipe",
 		F:    sweepImageRecipes,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_image", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_image", &resource.Sweeper{
 		Name: "aws_imagebuilder_image",
 		F:    sweepImages,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_infrastructure_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_infrastructure_configuration", &resource.Sweeper{
 		Name: "aws_imagebuilder_infrastructure_configuration",
 		F:    sweepInfrastructureConfigurations,
 	})
@@ -52,7 +52,7 @@ func sweepComponents(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -132,7 +132,7 @@ func sweepDistributionConfigurations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -184,7 +184,7 @@ func sweepImagePipelines(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -236,7 +236,7 @@ func sweepImageRecipes(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -292,7 +292,7 @@ func sweepImages(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -335,7 +335,10 @@ func sweepImages(region string) error {
 					d := r.Data(nil)
 					d.SetId(imageBuildVersionArn)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResource := sweep.NewSweepResource(r, d, client)
+					sweepResource.Tags = KeyValueTags(imageSummary.Tags).Map()
+
+					sweepResources = append(sweepResources, sweepResource)
 				}
 
 				return !lastPage
@@ -370,7 +373,7 @@ func sweepInfrastructureConfigurations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -161,7 +161,7 @@ func testAccCheckThingGroupMembershipExists(n string) resource.TestCheckFunc {
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn()
 
 		err = tfiot.FindThingGroupMembership(conn, thingGroupName, thingName)
 
@@ -174,7 +174,7 @@ func testAccCheckThingGroupMembershipExists(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckThingGroupMembershipDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iot_thing_group_membership" {
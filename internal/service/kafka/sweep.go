@@ -16,12 +16,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_msk_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_msk_cluster", &resource.Sweeper{
 		Name: "aws_msk_cluster",
 		F:    sweepClusters,
 	})
 
-	resource.AddTestSweepers("aws_msk_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_msk_configuration", &resource.Sweeper{
 		Name: "aws_msk_configuration",
 		F:    sweepConfigurations,
 		Dependencies: []string{
@@ -35,7 +35,7 @@ func sweepClusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).KafkaConn
+	conn := client.(*conns.AWSClient).KafkaConn()
 	input := &kafka.ListClustersInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -49,7 +49,11 @@ func sweepClusters(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(cluster.ClusterArn))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResource := sweep.NewSweepResource(r, d, client)
+			sweepResource.CreationTime = aws.TimeValue(cluster.CreationTime)
+			sweepResource.Tags = KeyValueTags(cluster.Tags).Map()
+
+			sweepResources = append(sweepResources, sweepResource)
 		}
 
 		return !lastPage
@@ -78,7 +82,7 @@ func sweepConfigurations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).KafkaConn
+	conn := client.(*conns.AWSClient).KafkaConn()
 	var sweeperErrs *multierror.Error
 
 	input := &kafka.ListConfigurationsInput{}
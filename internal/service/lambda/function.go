@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -14,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -79,6 +82,29 @@ func ResourceFunction() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"filename", "image_uri"},
 			},
+			"detect_s3_object_changes": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"filename", "image_uri", "s3_object_version"},
+				RequiredWith:  []string{"s3_bucket", "s3_key"},
+			},
+			"s3_object_etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_object_version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_path": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"image_uri"},
+			},
+			"source_path_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"image_uri": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -333,6 +359,7 @@ func ResourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			updateCodeFingerprints,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -350,6 +377,81 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// updateCodeFingerprints computes the hash of "source_path" and reads the ETag and version ID of the S3 object
+// when "detect_s3_object_changes" is set, so that a change to either shows in the plan and triggers a code update.
+func updateCodeFingerprints(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := d.GetOk("source_path"); !d.NewValueKnown("source_path") {
+		if err := d.SetNewComputed("source_path_hash"); err != nil {
+			return err
+		}
+	} else if ok {
+		hash, err := sourcePathHash(v.(string))
+
+		if err != nil {
+			return fmt.Errorf("error hashing Lambda Function source_path (%s): %w", v.(string), err)
+		}
+
+		if err := setNewIfChanged(d, "source_path_hash", hash); err != nil {
+			return err
+		}
+	} else if err := setNewIfChanged(d, "source_path_hash", ""); err != nil {
+		return err
+	}
+
+	if !d.Get("detect_s3_object_changes").(bool) {
+		for _, k := range []string{"s3_object_etag", "s3_object_version_id"} {
+			if err := setNewIfChanged(d, k, ""); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if !d.NewValueKnown("s3_bucket") || !d.NewValueKnown("s3_key") {
+		return setNewComputedS3ObjectAttributes(d)
+	}
+
+	bucket, key := d.Get("s3_bucket").(string), d.Get("s3_key").(string)
+	output, err := meta.(*conns.AWSClient).S3Conn().HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	// The object may be created in the same apply.
+	if tfawserr.ErrStatusCodeEquals(err, http.StatusNotFound) {
+		return setNewComputedS3ObjectAttributes(d)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lambda Function code S3 Bucket (%s) Object (%s): %w", bucket, key, err)
+	}
+
+	if err := setNewIfChanged(d, "s3_object_etag", strings.Trim(aws.StringValue(output.ETag), `"`)); err != nil {
+		return err
+	}
+
+	return setNewIfChanged(d, "s3_object_version_id", aws.StringValue(output.VersionId))
+}
+
+func setNewIfChanged(d *schema.ResourceDiff, key string, value string) error {
+	if d.Get(key).(string) == value {
+		return nil
+	}
+
+	return d.SetNew(key, value)
+}
+
+func setNewComputedS3ObjectAttributes(d *schema.ResourceDiff) error {
+	for _, k := range []string{"s3_object_etag", "s3_object_version_id"} {
+		if err := d.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	configChanged := hasConfigChanges(d)
 	functionCodeUpdated := needsFunctionCodeUpdate(d)
@@ -387,7 +489,7 @@ func hasConfigChanges(d verify.ResourceDiffer) bool {
 // resourceAwsLambdaFunction maps to:
 // CreateFunction in the API / SDK
 func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -599,6 +701,10 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error waiting for Lambda Function (%s) creation: %w", d.Id(), err)
 	}
 
+	if err := setCodeFingerprints(d, meta, true); err != nil {
+		return err
+	}
+
 	if reservedConcurrentExecutions >= 0 {
 
 		log.Printf("[DEBUG] Setting Concurrency to %d for the Lambda Function %s", reservedConcurrentExecutions, functionName)
@@ -637,7 +743,7 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 // resourceFunctionRead maps to:
 // GetFunction in the API / SDK
 func resourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -904,7 +1010,7 @@ func listVersionsByFunctionPages(c *lambda.Lambda, input *lambda.ListVersionsByF
 // resourceAwsLambdaFunction maps to:
 // DeleteFunction in the API / SDK
 func resourceFunctionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 
 	log.Printf("[INFO] Deleting Lambda Function: %s", d.Id())
 
@@ -926,19 +1032,65 @@ func resourceFunctionDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func needsFunctionCodeUpdate(d verify.ResourceDiffer) bool {
-	return d.HasChange("filename") ||
-		d.HasChange("source_code_hash") ||
-		d.HasChange("s3_bucket") ||
-		d.HasChange("s3_key") ||
-		d.HasChange("s3_object_version") ||
-		d.HasChange("image_uri") ||
-		d.HasChange("architectures")
+	return len(functionCodeChanges(d)) > 0
+}
+
+// functionCodeChanges returns the names of the changed attributes that require the function code to be updated.
+func functionCodeChanges(d verify.ResourceDiffer) []string {
+	var keys []string
+
+	for _, k := range []string{"filename", "source_code_hash", "s3_bucket", "s3_key", "s3_object_version", "image_uri", "architectures"} {
+		if d.HasChange(k) {
+			keys = append(keys, k)
+		}
+	}
+
+	// A code fingerprint that is newly set or removed does not by itself mean that the code has changed.
+	for _, k := range []string{"source_path_hash", "s3_object_etag", "s3_object_version_id"} {
+		if o, n := d.GetChange(k); o.(string) != "" && n.(string) != "" && o.(string) != n.(string) {
+			keys = append(keys, k)
+		}
+	}
+
+	return keys
+}
+
+// setCodeFingerprints sets the code fingerprints that were not known when the plan was made.
+// If the code was deployed, the ETag and version ID of the S3 object are always read again, as the object
+// may have changed since the plan was made, e.g. if it is managed by a resource updated in the same apply.
+func setCodeFingerprints(d *schema.ResourceData, meta interface{}, codeDeployed bool) error {
+	if v, ok := d.GetOk("source_path"); ok && d.Get("source_path_hash").(string) == "" {
+		hash, err := sourcePathHash(v.(string))
+
+		if err != nil {
+			return fmt.Errorf("error hashing Lambda Function source_path (%s): %w", v.(string), err)
+		}
+
+		d.Set("source_path_hash", hash)
+	}
+
+	if d.Get("detect_s3_object_changes").(bool) && (codeDeployed || d.Get("s3_object_etag").(string) == "") {
+		bucket, key := d.Get("s3_bucket").(string), d.Get("s3_key").(string)
+		output, err := meta.(*conns.AWSClient).S3Conn().HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading Lambda Function code S3 Bucket (%s) Object (%s): %w", bucket, key, err)
+		}
+
+		d.Set("s3_object_etag", strings.Trim(aws.StringValue(output.ETag), `"`))
+		d.Set("s3_object_version_id", output.VersionId)
+	}
+
+	return nil
 }
 
 // resourceFunctionUpdate maps to:
 // UpdateFunctionCode in the API / SDK
 func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 
 	// If Code Signing Config is updated, calls PutFunctionCodeSigningConfig
 	// If removed, calls DeleteFunctionCodeSigningConfig
@@ -971,7 +1123,7 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
-		if err := UpdateTags(conn, arn, o, n); err != nil {
+		if err := UpdateTags(conn, arn, o, n, meta.(*conns.AWSClient).IgnoreTagsConfig); err != nil {
 			return fmt.Errorf("error updating Lambda Function (%s) tags: %w", arn, err)
 		}
 	}
@@ -1193,6 +1345,10 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if err := setCodeFingerprints(d, meta, codeUpdate); err != nil {
+		return err
+	}
+
 	if d.HasChange("reserved_concurrent_executions") {
 		nc := d.Get("reserved_concurrent_executions")
 
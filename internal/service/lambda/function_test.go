@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/signer"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -1694,6 +1695,140 @@ func TestAccLambdaFunction_localUpdate(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_sourcePath(t *testing.T) {
+	var conf lambda.GetFunctionOutput
+
+	path, zipFile, err := createTempFile("lambda_sourcePath")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(path)
+
+	dir := t.TempDir()
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+
+	var timeBeforeUpdate time.Time
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLambdaFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := testAccCreateZipFromFiles(map[string]string{"test-fixtures/lambda_func.js": "lambda.js"}, zipFile); err != nil {
+						t.Fatalf("error creating zip from files: %s", err)
+					}
+				},
+				Config: testAccFunctionConfig_sourcePath(path, path, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, rName, &conf),
+					testAccCheckSourceCodeHash(&conf, "8DPiX+G1l2LQ8hjBkwRchQFf1TSCEvPrYGRKlM9UoyY="),
+					resource.TestCheckResourceAttr(resourceName, "source_path_hash", "mdiIK1PpLNhkS/hd1QSlBiBJjC3knkyKmDFNpl0OGkg="),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filename", "publish", "source_path", "source_path_hash"},
+			},
+			// A directory with the same files has the same hash, so the code is not updated.
+			{
+				PreConfig: func() {
+					if err := testAccCopyFile("test-fixtures/lambda_func.js", filepath.Join(dir, "lambda.js")); err != nil {
+						t.Fatal(err)
+					}
+					timeBeforeUpdate = time.Now()
+				},
+				Config: testAccFunctionConfig_sourcePath(path, dir, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, rName, &conf),
+					testAccCheckSourceCodeHash(&conf, "8DPiX+G1l2LQ8hjBkwRchQFf1TSCEvPrYGRKlM9UoyY="),
+					resource.TestCheckResourceAttr(resourceName, "source_path_hash", "mdiIK1PpLNhkS/hd1QSlBiBJjC3knkyKmDFNpl0OGkg="),
+					func(s *terraform.State) error {
+						return testAccCheckAttributeIsDateBefore(s, resourceName, "last_modified", timeBeforeUpdate)
+					},
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := testAccCreateZipFromFiles(map[string]string{"test-fixtures/lambda_func_modified.js": "lambda.js"}, zipFile); err != nil {
+						t.Fatalf("error creating zip from files: %s", err)
+					}
+					if err := testAccCopyFile("test-fixtures/lambda_func_modified.js", filepath.Join(dir, "lambda.js")); err != nil {
+						t.Fatal(err)
+					}
+					timeBeforeUpdate = time.Now()
+				},
+				Config: testAccFunctionConfig_sourcePath(path, dir, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, rName, &conf),
+					testAccCheckSourceCodeHash(&conf, "0tdaP9H9hsk9c2CycSwOG/sa/x5JyAmSYunA/ce99Pg="),
+					resource.TestCheckResourceAttr(resourceName, "source_path_hash", "W7SvTbWCtUhBWy/FCZnKsCCFq6T5xMHYyPJE+GiDZps="),
+					func(s *terraform.State) error {
+						return testAccCheckAttributeIsDateAfter(s, resourceName, "last_modified", timeBeforeUpdate)
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunction_detectS3ObjectChanges(t *testing.T) {
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+	objectResourceName := "aws_s3_bucket_object.lambda_code"
+
+	var timeBeforeUpdate time.Time
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLambdaFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_detectS3ObjectChanges(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, rName, &conf),
+					testAccCheckSourceCodeHash(&conf, "Ux/n9CP8l+7Ht0tICw0QPs0yLdC1b+1nJ9K5MZR9ENw="),
+					resource.TestCheckResourceAttr(resourceName, "detect_s3_object_changes", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_object_etag", objectResourceName, "etag"),
+					resource.TestCheckResourceAttr(resourceName, "s3_object_version_id", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"detect_s3_object_changes", "publish", "s3_bucket", "s3_key", "s3_object_etag", "s3_object_version_id"},
+			},
+			// Replace the object outside of Terraform, e.g. from a build pipeline.
+			{
+				PreConfig: func() {
+					if err := testAccPutS3Object(rName, "lambdatest.zip", "test-fixtures/lambdatest_modified.zip"); err != nil {
+						t.Fatal(err)
+					}
+					timeBeforeUpdate = time.Now()
+				},
+				Config: testAccFunctionConfig_detectS3ObjectChanges(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, rName, &conf),
+					testAccCheckSourceCodeHash(&conf, "0yudKNVBReAHFbM8gRGFRNtBEXFFHB2Iv6ca6DNzv5A="),
+					func(s *terraform.State) error {
+						return testAccCheckAttributeIsDateAfter(s, resourceName, "last_modified", timeBeforeUpdate)
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_LocalUpdate_nameOnly(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
@@ -2025,7 +2160,7 @@ func TestAccLambdaFunction_Zip_validation(t *testing.T) {
 }
 
 func testAccCheckLambdaFunctionDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_function" {
@@ -2047,7 +2182,7 @@ func testAccCheckLambdaFunctionDestroy(s *terraform.State) error {
 
 func testAccCheckFunctionDisappears(function *lambda.GetFunctionOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 		input := &lambda.DeleteFunctionInput{
 			FunctionName: function.Configuration.FunctionName,
@@ -2071,7 +2206,7 @@ func testAccCheckFunctionExists(res, funcName string, function *lambda.GetFuncti
 			return fmt.Errorf("Lambda function ID not set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 		params := &lambda.GetFunctionInput{
 			FunctionName: aws.String(funcName),
@@ -2098,7 +2233,7 @@ func testAccCheckFunctionInvokeARN(name string, function *lambda.GetFunctionOutp
 func testAccInvokeFunction(function *lambda.GetFunctionOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		f := function.Configuration
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 		// If the function is VPC-enabled this will create ENI automatically
 		_, err := conn.Invoke(&lambda.InvokeInput{
@@ -2166,6 +2301,30 @@ func testAccCheckAttributeIsDateAfter(s *terraform.State, name string, key strin
 	return nil
 }
 
+func testAccCheckAttributeIsDateBefore(s *terraform.State, name string, key string, after time.Time) error {
+	rs, ok := s.RootModule().Resources[name]
+	if !ok {
+		return fmt.Errorf("Resource %s not found", name)
+	}
+
+	v, ok := rs.Primary.Attributes[key]
+	if !ok {
+		return fmt.Errorf("%s: Attribute '%s' not found", name, key)
+	}
+
+	const ISO8601UTC = "2006-01-02T15:04:05Z0700"
+	timeValue, err := time.Parse(ISO8601UTC, v)
+	if err != nil {
+		return err
+	}
+
+	if !timeValue.Before(after) {
+		return fmt.Errorf("Expected time attribute %s.%s with value %s was not before %s", name, key, v, after.Format(ISO8601UTC))
+	}
+
+	return nil
+}
+
 func testAccCreateZipFromFiles(files map[string]string, zipFile *os.File) error {
 	if err := zipFile.Truncate(0); err != nil {
 		return err
@@ -2201,6 +2360,33 @@ func testAccCreateZipFromFiles(files map[string]string, zipFile *os.File) error
 	return w.Flush()
 }
 
+func testAccCopyFile(source, destination string) error {
+	content, err := os.ReadFile(source)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(destination, content, 0644)
+}
+
+func testAccPutS3Object(bucket, key, source string) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+	f, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = conn.PutObject(&s3.PutObjectInput{
+		Body:   f,
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	return err
+}
+
 func createTempFile(prefix string) (string, *os.File, error) {
 	f, err := os.CreateTemp(os.TempDir(), prefix)
 	if err != nil {
@@ -3248,6 +3434,87 @@ resource "aws_lambda_function" "test" {
 `, roleName, filePath, filePath, funcName)
 }
 
+func testAccFunctionConfig_sourcePath(filePath, sourcePath, rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "iam_for_lambda" {
+  name = %[3]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = %[1]q
+  source_path   = %[2]q
+  function_name = %[3]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs12.x"
+}
+`, filePath, sourcePath, rName)
+}
+
+func testAccFunctionConfig_detectS3ObjectChanges(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "lambda_bucket" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_object" "lambda_code" {
+  bucket = aws_s3_bucket.lambda_bucket.id
+  key    = "lambdatest.zip"
+  source = "test-fixtures/lambdatest.zip"
+
+  lifecycle {
+    ignore_changes = [etag]
+  }
+}
+
+resource "aws_iam_role" "iam_for_lambda" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test" {
+  s3_bucket                = aws_s3_bucket.lambda_bucket.id
+  s3_key                   = aws_s3_bucket_object.lambda_code.id
+  detect_s3_object_changes = true
+  function_name            = %[1]q
+  role                     = aws_iam_role.iam_for_lambda.arn
+  handler                  = "exports.example"
+  runtime                  = "nodejs12.x"
+}
+`, rName)
+}
+
 func testAccFunctionConfig_local_name_only(filePath, roleName, funcName string) string {
 	return testAccFunctionConfig_local_name_only_tpl(filePath, roleName, funcName)
 }
@@ -3422,7 +3689,7 @@ func TestFlattenLambdaImageConfigShouldNotFailWithEmptyImageConfig(t *testing.T)
 }
 
 func testAccPreCheckSingerSigningProfile(t *testing.T, platformID string) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SignerConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SignerConn()
 
 	input := &signer.ListSigningPlatformsInput{}
 
@@ -0,0 +1,346 @@
+package lambda
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const functionURLResourceIDSeparator = "/"
+
+func ResourceFunctionURL() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFunctionURLCreate,
+		Read:   resourceFunctionURLRead,
+		Update: resourceFunctionURLUpdate,
+		Delete: resourceFunctionURLDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"authorization_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(lambda.FunctionUrlAuthType_Values(), false),
+			},
+			"cors": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_credentials": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"allow_headers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 100,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+							},
+						},
+						"allow_methods": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 6,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"*", "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT"}, false),
+							},
+						},
+						"allow_origins": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 100,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 253),
+							},
+						},
+						"expose_headers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 100,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+							},
+						},
+						"max_age": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 86400),
+						},
+					},
+				},
+			},
+			"function_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validFunctionName,
+			},
+			"function_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"qualifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validQualifier,
+			},
+			"url_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceFunctionURLCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LambdaConn()
+
+	functionName := d.Get("function_name").(string)
+	qualifier := d.Get("qualifier").(string)
+	id := FunctionURLCreateResourceID(functionName, qualifier)
+
+	input := &lambda.CreateFunctionUrlConfigInput{
+		AuthType:     aws.String(d.Get("authorization_type").(string)),
+		FunctionName: aws.String(functionName),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	if v, ok := d.GetOk("cors"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Cors = expandFunctionURLCors(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Lambda Function URL: %s", input)
+	_, err := conn.CreateFunctionUrlConfig(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lambda Function URL (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceFunctionURLRead(d, meta)
+}
+
+func resourceFunctionURLRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LambdaConn()
+
+	functionName, qualifier, err := FunctionURLParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindFunctionURLByNameAndQualifier(conn, functionName, qualifier)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lambda Function URL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lambda Function URL (%s): %w", d.Id(), err)
+	}
+
+	functionURL := aws.StringValue(output.FunctionUrl)
+
+	d.Set("authorization_type", output.AuthType)
+	if output.Cors != nil {
+		if err := d.Set("cors", []interface{}{flattenFunctionURLCors(output.Cors)}); err != nil {
+			return fmt.Errorf("error setting cors: %w", err)
+		}
+	} else {
+		d.Set("cors", nil)
+	}
+	d.Set("function_arn", output.FunctionArn)
+	d.Set("function_name", functionName)
+	d.Set("function_url", functionURL)
+	d.Set("qualifier", qualifier)
+	d.Set("url_id", FunctionURLIDFromURL(functionURL))
+
+	return nil
+}
+
+func resourceFunctionURLUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LambdaConn()
+
+	functionName, qualifier, err := FunctionURLParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &lambda.UpdateFunctionUrlConfigInput{
+		FunctionName: aws.String(functionName),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	if d.HasChange("authorization_type") {
+		input.AuthType = aws.String(d.Get("authorization_type").(string))
+	}
+
+	if d.HasChange("cors") {
+		if v, ok := d.GetOk("cors"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Cors = expandFunctionURLCors(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.Cors = &lambda.Cors{}
+		}
+	}
+
+	log.Printf("[DEBUG] Updating Lambda Function URL: %s", input)
+	_, err = conn.UpdateFunctionUrlConfig(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Lambda Function URL (%s): %w", d.Id(), err)
+	}
+
+	return resourceFunctionURLRead(d, meta)
+}
+
+func resourceFunctionURLDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LambdaConn()
+
+	functionName, qualifier, err := FunctionURLParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &lambda.DeleteFunctionUrlConfigInput{
+		FunctionName: aws.String(functionName),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	log.Printf("[INFO] Deleting Lambda Function URL: %s", d.Id())
+	_, err = conn.DeleteFunctionUrlConfig(input)
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lambda Function URL (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// FunctionURLCreateResourceID returns the ID of a function URL.
+// The ID is the function name, followed by the qualifier if one is specified.
+func FunctionURLCreateResourceID(functionName, qualifier string) string {
+	if qualifier == "" {
+		return functionName
+	}
+
+	return strings.Join([]string{functionName, qualifier}, functionURLResourceIDSeparator)
+}
+
+func FunctionURLParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, functionURLResourceIDSeparator)
+
+	if len(parts) == 1 && parts[0] != "" {
+		return parts[0], "", nil
+	}
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected FUNCTION_NAME or FUNCTION_NAME%[2]sQUALIFIER", id, functionURLResourceIDSeparator)
+}
+
+// FunctionURLIDFromURL returns the URL ID, the first label of the host name, of a function URL
+// such as "https://abcdefghijklmnopqrstuvwxyz123456.lambda-url.us-west-2.on.aws/".
+func FunctionURLIDFromURL(v string) string {
+	u, err := url.Parse(v)
+
+	if err != nil {
+		return ""
+	}
+
+	return strings.Split(u.Hostname(), ".")[0]
+}
+
+func expandFunctionURLCors(tfMap map[string]interface{}) *lambda.Cors {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lambda.Cors{}
+
+	if v, ok := tfMap["allow_credentials"].(bool); ok {
+		apiObject.AllowCredentials = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["allow_headers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowHeaders = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["allow_methods"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowMethods = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["allow_origins"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowOrigins = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["expose_headers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ExposeHeaders = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["max_age"].(int); ok && v != 0 {
+		apiObject.MaxAge = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenFunctionURLCors(apiObject *lambda.Cors) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"allow_credentials": aws.BoolValue(apiObject.AllowCredentials),
+		"allow_headers":     aws.StringValueSlice(apiObject.AllowHeaders),
+		"allow_methods":     aws.StringValueSlice(apiObject.AllowMethods),
+		"allow_origins":     aws.StringValueSlice(apiObject.AllowOrigins),
+		"expose_headers":    aws.StringValueSlice(apiObject.ExposeHeaders),
+		"max_age":           aws.Int64Value(apiObject.MaxAge),
+	}
+
+	return tfMap
+}
@@ -0,0 +1,123 @@
+package lambda
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceFunctionURL() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFunctionURLRead,
+
+		Schema: map[string]*schema.Schema{
+			"authorization_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_credentials": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"allow_headers": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allow_methods": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allow_origins": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"expose_headers": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"max_age": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validFunctionName,
+			},
+			"function_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"qualifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validQualifier,
+			},
+			"url_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceFunctionURLRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LambdaConn()
+
+	functionName := d.Get("function_name").(string)
+	qualifier := d.Get("qualifier").(string)
+	id := FunctionURLCreateResourceID(functionName, qualifier)
+
+	output, err := FindFunctionURLByNameAndQualifier(conn, functionName, qualifier)
+
+	if err != nil {
+		return fmt.Errorf("error reading Lambda Function URL (%s): %w", id, err)
+	}
+
+	functionURL := aws.StringValue(output.FunctionUrl)
+
+	d.SetId(id)
+	d.Set("authorization_type", output.AuthType)
+	if output.Cors != nil {
+		if err := d.Set("cors", []interface{}{flattenFunctionURLCors(output.Cors)}); err != nil {
+			return fmt.Errorf("error setting cors: %w", err)
+		}
+	} else {
+		d.Set("cors", nil)
+	}
+	d.Set("creation_time", output.CreationTime)
+	d.Set("function_arn", output.FunctionArn)
+	d.Set("function_name", functionName)
+	d.Set("function_url", functionURL)
+	d.Set("last_modified_time", output.LastModifiedTime)
+	d.Set("qualifier", qualifier)
+	d.Set("url_id", FunctionURLIDFromURL(functionURL))
+
+	return nil
+}
@@ -0,0 +1,377 @@
+package lambda_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lambda"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestFunctionURLParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName             string
+		InputID              string
+		ExpectError          bool
+		ExpectedFunctionName string
+		ExpectedQualifier    string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "empty qualifier",
+			InputID:     "my-function/",
+			ExpectError: true,
+		},
+		{
+			TestName:    "too many parts",
+			InputID:     "my-function/live/extra",
+			ExpectError: true,
+		},
+		{
+			TestName:             "function name only",
+			InputID:              "my-function",
+			ExpectedFunctionName: "my-function",
+		},
+		{
+			TestName:             "function name and qualifier",
+			InputID:              "my-function/live",
+			ExpectedFunctionName: "my-function",
+			ExpectedQualifier:    "live",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotFunctionName, gotQualifier, err := tflambda.FunctionURLParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotFunctionName != testCase.ExpectedFunctionName {
+				t.Errorf("got function name %s, expected %s", gotFunctionName, testCase.ExpectedFunctionName)
+			}
+
+			if gotQualifier != testCase.ExpectedQualifier {
+				t.Errorf("got qualifier %s, expected %s", gotQualifier, testCase.ExpectedQualifier)
+			}
+		})
+	}
+}
+
+func TestAccLambdaFunctionURL_basic(t *testing.T) {
+	var conf lambda.GetFunctionUrlConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function_url.test"
+	functionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFunctionURLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionURLExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", lambda.FunctionUrlAuthTypeNone),
+					resource.TestCheckResourceAttr(resourceName, "cors.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "function_arn", functionResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "function_name", rName),
+					resource.TestMatchResourceAttr(resourceName, "function_url", regexp.MustCompile(`^https://[a-z0-9]+\.lambda-url\.`)),
+					resource.TestCheckResourceAttr(resourceName, "qualifier", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "url_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionURL_disappears(t *testing.T) {
+	var conf lambda.GetFunctionUrlConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function_url.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFunctionURLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionURLExists(resourceName, &conf),
+					acctest.CheckResourceDisappears(acctest.Provider, tflambda.ResourceFunctionURL(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionURL_cors(t *testing.T) {
+	var conf lambda.GetFunctionUrlConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function_url.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFunctionURLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLCorsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionURLExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", lambda.FunctionUrlAuthTypeAwsIam),
+					resource.TestCheckResourceAttr(resourceName, "cors.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_credentials", "true"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_headers.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_headers.*", "date"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_headers.*", "keep-alive"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_methods.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_methods.*", "*"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_origins.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_origins.*", "https://www.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.expose_headers.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.expose_headers.*", "date"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.max_age", "86400"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFunctionURLCorsUpdatedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionURLExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", lambda.FunctionUrlAuthTypeAwsIam),
+					resource.TestCheckResourceAttr(resourceName, "cors.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_credentials", "false"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_headers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_methods.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_methods.*", "GET"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_methods.*", "POST"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_origins.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_origins.*", "*"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.expose_headers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.max_age", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionURL_alias(t *testing.T) {
+	var conf lambda.GetFunctionUrlConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function_url.test"
+	aliasResourceName := "aws_lambda_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFunctionURLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLAliasConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionURLExists(resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "function_arn", aliasResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "qualifier", "live"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFunctionURLExists(n string, v *lambda.GetFunctionUrlConfigOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lambda Function URL ID is set")
+		}
+
+		functionName, qualifier, err := tflambda.FunctionURLParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
+
+		output, err := tflambda.FindFunctionURLByNameAndQualifier(conn, functionName, qualifier)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckFunctionURLDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lambda_function_url" {
+			continue
+		}
+
+		functionName, qualifier, err := tflambda.FunctionURLParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tflambda.FindFunctionURLByNameAndQualifier(conn, functionName, qualifier)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lambda Function URL %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFunctionURLBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSLambdaBasicExecutionRole"
+  role       = aws_iam_role.test.id
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.test.arn
+  handler       = "exports.example"
+  publish       = true
+  runtime       = "nodejs12.x"
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName)
+}
+
+func testAccFunctionURLBasicConfig(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionURLBaseConfig(rName), `
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  authorization_type = "NONE"
+}
+`)
+}
+
+func testAccFunctionURLCorsConfig(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionURLBaseConfig(rName), `
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  authorization_type = "AWS_IAM"
+
+  cors {
+    allow_credentials = true
+    allow_origins     = ["https://www.example.com"]
+    allow_methods     = ["*"]
+    allow_headers     = ["date", "keep-alive"]
+    expose_headers    = ["date"]
+    max_age           = 86400
+  }
+}
+`)
+}
+
+func testAccFunctionURLCorsUpdatedConfig(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionURLBaseConfig(rName), `
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  authorization_type = "AWS_IAM"
+
+  cors {
+    allow_origins = ["*"]
+    allow_methods = ["GET", "POST"]
+  }
+}
+`)
+}
+
+func testAccFunctionURLAliasConfig(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionURLBaseConfig(rName), `
+resource "aws_lambda_alias" "test" {
+  name             = "live"
+  function_name    = aws_lambda_function.test.function_name
+  function_version = aws_lambda_function.test.version
+}
+
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_alias.test.function_name
+  qualifier          = aws_lambda_alias.test.name
+  authorization_type = "NONE"
+}
+`)
+}
@@ -0,0 +1,93 @@
+// Code generated by "internal/generate/pluraldatasource/main.go -Op=ListFunctions -Name=Functions -TypeName=aws_lambda_functions -HumanName="Lambda Functions" -ARNField=FunctionArn -NameField=FunctionName -Tags"; DO NOT EDIT.
+
+package lambda
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceFunctions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFunctionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceFunctionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LambdaConn()
+
+	input := &lambda.ListFunctionsInput{}
+
+	var results []*lambda.FunctionConfiguration
+
+	err := conn.ListFunctionsPages(input, func(page *lambda.ListFunctionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Functions {
+			if v != nil {
+				results = append(results, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Lambda Functions: %w", err)
+	}
+
+	tagsToMatch := tftags.New(d.Get("tags").(map[string]interface{}))
+
+	var arns, names []string
+
+	for _, v := range results {
+		if len(tagsToMatch) > 0 {
+			arn := aws.StringValue(v.FunctionArn)
+			tags, err := ListTags(conn, arn)
+
+			if err != nil {
+				return fmt.Errorf("error listing tags for %s: %w", arn, err)
+			}
+
+			if !tags.ContainsAll(tagsToMatch) {
+				continue
+			}
+		}
+
+		arns = append(arns, aws.StringValue(v.FunctionArn))
+		names = append(names, aws.StringValue(v.FunctionName))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("arns", arns); err != nil {
+		return fmt.Errorf("error setting arns: %w", err)
+	}
+
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names: %w", err)
+	}
+
+	return nil
+}
@@ -1,3 +1,4 @@
+//go:generate go run ../../generate/pluraldatasource/main.go -Op=ListFunctions -Name=Functions -TypeName=aws_lambda_functions "-HumanName=Lambda Functions" -ARNField=FunctionArn -NameField=FunctionName -Tags
 //go:generate go run ../../generate/tags/main.go -ListTags -ListTagsOp=ListTags -ListTagsInIDElem=Resource -ServiceTagsMap -TagInIDElem=Resource -UpdateTags
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -49,6 +50,12 @@ func ResourcePermission() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validFunctionName,
 			},
+			"function_url_auth_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(lambda.FunctionUrlAuthType_Values(), false),
+			},
 			"principal": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -92,7 +99,7 @@ func ResourcePermission() *schema.Resource {
 }
 
 func resourcePermissionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 
 	functionName := d.Get("function_name").(string)
 
@@ -121,6 +128,9 @@ func resourcePermissionCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("event_source_token"); ok {
 		input.EventSourceToken = aws.String(v.(string))
 	}
+	if v, ok := d.GetOk("function_url_auth_type"); ok {
+		input.FunctionUrlAuthType = aws.String(v.(string))
+	}
 	if v, ok := d.GetOk("qualifier"); ok {
 		input.Qualifier = aws.String(v.(string))
 	}
@@ -192,7 +202,7 @@ func resourcePermissionCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourcePermissionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 
 	input := lambda.GetPolicyInput{
 		FunctionName: aws.String(d.Get("function_name").(string)),
@@ -299,6 +309,7 @@ func resourcePermissionRead(d *schema.ResourceData, meta interface{}) error {
 	if stringEquals, ok := statement.Condition["StringEquals"]; ok {
 		d.Set("source_account", stringEquals["AWS:SourceAccount"])
 		d.Set("event_source_token", stringEquals["lambda:EventSourceToken"])
+		d.Set("function_url_auth_type", stringEquals["lambda:FunctionUrlAuthType"])
 	}
 
 	if arnLike, ok := statement.Condition["ArnLike"]; ok {
@@ -311,7 +322,7 @@ func resourcePermissionRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourcePermissionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 
 	functionName := d.Get("function_name").(string)
 
@@ -448,7 +459,7 @@ func resourcePermissionImport(d *schema.ResourceData, meta interface{}) ([]*sche
 	statementId := idParts[1]
 	log.Printf("[DEBUG] Importing Lambda Permission %s for function name %s", statementId, functionName)
 
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 	getFunctionOutput, err := conn.GetFunction(input)
 	if err != nil {
 		return nil, err
@@ -474,6 +485,31 @@ type PolicyStatement struct {
 	Action    string
 	Resource  string
 	Effect    string
-	Principal map[string]string
+	Principal PolicyStatementPrincipal
 	Sid       string
 }
+
+// PolicyStatementPrincipal is the principal of a Lambda policy statement.
+// The anonymous principal, e.g. in the statement allowing public access to a function URL,
+// is returned as the string "*" and is unmarshalled as {"AWS": "*"}.
+type PolicyStatementPrincipal map[string]string
+
+func (p *PolicyStatementPrincipal) UnmarshalJSON(b []byte) error {
+	var v string
+
+	if err := json.Unmarshal(b, &v); err == nil {
+		*p = PolicyStatementPrincipal{"AWS": v}
+
+		return nil
+	}
+
+	var m map[string]string
+
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	*p = m
+
+	return nil
+}
@@ -63,6 +63,26 @@ func TestLambdaPermissionUnmarshalling(t *testing.T) {
 	}
 }
 
+func TestLambdaPermissionUnmarshalling_anonymousPrincipal(t *testing.T) {
+	v := tflambda.Policy{}
+	err := json.Unmarshal(testLambdaFunctionURLPolicy, &v)
+	if err != nil {
+		t.Fatalf("Expected no error when unmarshalling: %s", err)
+	}
+
+	expectedPrincipal := "*"
+	if v.Statement[0].Principal["AWS"] != expectedPrincipal {
+		t.Fatalf("Expected Principal to match (%q != %q)", v.Statement[0].Principal["AWS"], expectedPrincipal)
+	}
+
+	expectedFunctionURLAuthType := "NONE"
+	if v.Statement[0].Condition["StringEquals"]["lambda:FunctionUrlAuthType"] != expectedFunctionURLAuthType {
+		t.Fatalf("Expected Function URL Auth Type to match (%q != %q)",
+			v.Statement[0].Condition["StringEquals"]["lambda:FunctionUrlAuthType"],
+			expectedFunctionURLAuthType)
+	}
+}
+
 func TestLambdaPermissionGetQualifierFromLambdaAliasOrVersionArn_alias(t *testing.T) {
 	arnWithAlias := "arn:aws:lambda:us-west-2:187636751137:function:lambda_function_name:testalias" // lintignore:AWSAT003,AWSAT005 // unit test
 	expectedQualifier := "testalias"
@@ -207,6 +227,39 @@ func TestAccLambdaPermission_basic(t *testing.T) {
 	})
 }
 
+func TestAccLambdaPermission_functionURLs(t *testing.T) {
+	var statement tflambda.PolicyStatement
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_permission.test"
+	functionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionFunctionURLsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLambdaPermissionExists(resourceName, &statement),
+					resource.TestCheckResourceAttr(resourceName, "action", "lambda:InvokeFunctionUrl"),
+					resource.TestCheckResourceAttrPair(resourceName, "function_name", functionResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "function_url_auth_type", lambda.FunctionUrlAuthTypeNone),
+					resource.TestCheckResourceAttr(resourceName, "principal", "*"),
+					resource.TestCheckResourceAttr(resourceName, "statement_id", "FunctionURLAllowPublicAccess"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccCPermissionImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccLambdaPermission_StatementID_duplicate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -561,7 +614,7 @@ func testAccPermissionDisappears(n string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("No resource ID is set")
@@ -596,7 +649,7 @@ func testAccCheckLambdaPermissionExists(n string, statement *tflambda.PolicyStat
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 		// IAM is eventually consistent
 		var foundStatement *tflambda.PolicyStatement
@@ -628,7 +681,7 @@ func testAccCheckLambdaPermissionExists(n string, statement *tflambda.PolicyStat
 }
 
 func testAccCheckPermissionDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_permission" {
@@ -776,6 +829,46 @@ EOF
 `, funcName, roleName)
 }
 
+func testAccPermissionFunctionURLsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_permission" "test" {
+  statement_id           = "FunctionURLAllowPublicAccess"
+  action                 = "lambda:InvokeFunctionUrl"
+  function_name          = aws_lambda_function.test.arn
+  principal              = "*"
+  function_url_auth_type = "NONE"
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.test.arn
+  handler       = "exports.handler"
+  runtime       = "nodejs12.x"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+`, rName)
+}
+
 func testAccPermissionStatementIdDuplicateConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_lambda_permission" "test1" {
@@ -1173,3 +1266,22 @@ var testLambdaPolicy = []byte(`{
   ],
   "Id": "default"
 }`)
+
+var testLambdaFunctionURLPolicy = []byte(`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Condition": {
+        "StringEquals": {
+          "lambda:FunctionUrlAuthType": "NONE"
+        }
+      },
+      "Action": "lambda:InvokeFunctionUrl",
+      "Resource": "arn:aws:lambda:eu-west-1:319201112229:function:myCustomFunction",
+      "Effect": "Allow",
+      "Principal": "*",
+      "Sid": "FunctionURLAllowPublicAccess"
+    }
+  ],
+  "Id": "default"
+}`)
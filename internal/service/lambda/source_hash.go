@@ -0,0 +1,156 @@
+package lambda
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// sourcePathHash returns the base64-encoded SHA-256 hash of the files in a local directory or zip archive.
+// Only the relative path and content of each regular file are hashed, so the hash does not change with
+// file timestamps, permissions or the order of the entries in an archive, and a directory has the same
+// hash as a zip archive of its contents.
+func sourcePathHash(v string) (string, error) {
+	name, err := homedir.Expand(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	fi, err := os.Stat(name)
+
+	if err != nil {
+		return "", err
+	}
+
+	var files map[string][]byte
+
+	if fi.IsDir() {
+		files, err = hashDirectoryFiles(name)
+	} else {
+		files, err = hashZipFiles(name)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	names := make([]string, 0, len(files))
+
+	for k := range files {
+		names = append(names, k)
+	}
+
+	sort.Strings(names)
+
+	h := sha256.New()
+
+	for _, k := range names {
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		h.Write(files[k])
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashDirectoryFiles returns the SHA-256 hash of each regular file under a directory, keyed by slash-separated relative path.
+func hashDirectoryFiles(dir string) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+
+	err := filepath.Walk(dir, func(name string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if fi.IsDir() {
+			return nil
+		}
+
+		// Symbolic links to directories are not followed.
+		if fi.Mode()&os.ModeSymlink != 0 {
+			if target, err := os.Stat(name); err == nil && target.IsDir() {
+				return nil
+			}
+		}
+
+		rel, err := filepath.Rel(dir, name)
+
+		if err != nil {
+			return err
+		}
+
+		f, err := os.Open(name)
+
+		if err != nil {
+			return err
+		}
+
+		defer f.Close()
+
+		sum, err := hashReader(sha256.New(), f)
+
+		if err != nil {
+			return fmt.Errorf("error reading %s: %w", name, err)
+		}
+
+		files[filepath.ToSlash(rel)] = sum
+
+		return nil
+	})
+
+	return files, err
+}
+
+// hashZipFiles returns the SHA-256 hash of each file in a zip archive, keyed by slash-separated path.
+func hashZipFiles(name string) (map[string][]byte, error) {
+	r, err := zip.OpenReader(name)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s is neither a directory nor a zip archive: %w", name, err)
+	}
+
+	defer r.Close()
+
+	files := make(map[string][]byte)
+
+	for _, f := range r.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+
+		rc, err := f.Open()
+
+		if err != nil {
+			return nil, err
+		}
+
+		sum, err := hashReader(sha256.New(), rc)
+		rc.Close()
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s in %s: %w", f.Name, name, err)
+		}
+
+		files[path.Clean(f.Name)] = sum
+	}
+
+	return files, nil
+}
+
+func hashReader(h hash.Hash, r io.Reader) ([]byte, error) {
+	if _, err := io.Copy(h, r); err != nil {
+		return nil, err
+	}
+
+	return h.Sum(nil), nil
+}
@@ -0,0 +1,170 @@
+package lambda
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSourcePathHash(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"index.js":        "exports.handler = async () => 'ok';",
+		"lib/helper.js":   "module.exports = {};",
+		"lib/data/a.json": "{}",
+	}
+
+	srcDir := filepath.Join(dir, "src")
+
+	for name, content := range files {
+		writeTestFile(t, filepath.Join(srcDir, filepath.FromSlash(name)), content, 0644)
+	}
+
+	dirHash, err := sourcePathHash(srcDir)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Same content with different timestamps, permissions and entry order.
+	zipPath := filepath.Join(dir, "function.zip")
+	writeTestZip(t, zipPath, []string{"lib/data/a.json", "lib/helper.js", "index.js"}, files, time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC), 0755)
+
+	zipHash, err := sourcePathHash(zipPath)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if zipHash != dirHash {
+		t.Errorf("got zip hash %s, expected directory hash %s", zipHash, dirHash)
+	}
+
+	reorderedPath := filepath.Join(dir, "reordered.zip")
+	writeTestZip(t, reorderedPath, []string{"index.js", "lib/helper.js", "lib/data/a.json"}, files, time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), 0600)
+
+	reorderedHash, err := sourcePathHash(reorderedPath)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if reorderedHash != zipHash {
+		t.Errorf("got reordered zip hash %s, expected %s", reorderedHash, zipHash)
+	}
+
+	if err := os.Chtimes(filepath.Join(srcDir, "index.js"), time.Now(), time.Now().Add(-time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chmod(filepath.Join(srcDir, "index.js"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	touchedHash, err := sourcePathHash(srcDir)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if touchedHash != dirHash {
+		t.Errorf("got hash %s after changing timestamps and permissions, expected %s", touchedHash, dirHash)
+	}
+
+	writeTestFile(t, filepath.Join(srcDir, "index.js"), "exports.handler = async () => 'changed';", 0644)
+
+	changedHash, err := sourcePathHash(srcDir)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if changedHash == dirHash {
+		t.Errorf("expected hash to change after changing file content")
+	}
+
+	if err := os.Rename(filepath.Join(srcDir, "lib", "helper.js"), filepath.Join(srcDir, "lib", "helpers.js")); err != nil {
+		t.Fatal(err)
+	}
+
+	renamedHash, err := sourcePathHash(srcDir)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if renamedHash == changedHash {
+		t.Errorf("expected hash to change after renaming a file")
+	}
+}
+
+func TestSourcePathHash_invalid(t *testing.T) {
+	dir := t.TempDir()
+
+	if _, err := sourcePathHash(filepath.Join(dir, "missing")); err == nil {
+		t.Errorf("expected error for missing path")
+	}
+
+	notZip := filepath.Join(dir, "index.js")
+	writeTestFile(t, notZip, "exports.handler = async () => 'ok';", 0644)
+
+	if _, err := sourcePathHash(notZip); err == nil {
+		t.Errorf("expected error for file that is not a zip archive")
+	}
+}
+
+func writeTestFile(t *testing.T, name, content string, mode os.FileMode) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(name, []byte(content), mode); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func writeTestZip(t *testing.T, name string, order []string, files map[string]string, modified time.Time, mode os.FileMode) {
+	t.Helper()
+
+	f, err := os.Create(name)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer f.Close()
+
+	w := zip.NewWriter(f)
+
+	if _, err := w.Create("lib/"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, k := range order {
+		header := &zip.FileHeader{
+			Name:     k,
+			Method:   zip.Deflate,
+			Modified: modified,
+		}
+		header.SetMode(mode)
+
+		fw, err := w.CreateHeader(header)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := fw.Write([]byte(files[k])); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -57,7 +57,7 @@ func ResourceAccount() *schema.Resource {
 }
 
 func resourceMacie2AccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Macie2Conn
+	conn := meta.(*conns.AWSClient).Macie2Conn()
 
 	input := &macie2.EnableMacieInput{
 		ClientToken: aws.String(resource.UniqueId()),
@@ -97,7 +97,7 @@ func resourceMacie2AccountCreate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceMacie2AccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Macie2Conn
+	conn := meta.(*conns.AWSClient).Macie2Conn()
 
 	input := &macie2.GetMacieSessionInput{}
 
@@ -124,7 +124,7 @@ func resourceMacie2AccountRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceMacie2AccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Macie2Conn
+	conn := meta.(*conns.AWSClient).Macie2Conn()
 
 	input := &macie2.UpdateMacieSessionInput{}
 
@@ -145,7 +145,7 @@ func resourceMacie2AccountUpdate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceMacie2AccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Macie2Conn
+	conn := meta.(*conns.AWSClient).Macie2Conn()
 
 	input := &macie2.DisableMacieInput{}
 
@@ -0,0 +1,68 @@
+package meta
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceProviderIdentity() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceProviderIdentityRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"assumed_role_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"partition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceProviderIdentityRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*conns.AWSClient)
+
+	log.Printf("[DEBUG] Reading Provider Identity")
+	output, err := client.STSConn().GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading Provider Identity: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+	d.Set("account_id", output.Account)
+	d.Set("arn", output.Arn)
+	d.Set("partition", client.Partition)
+	d.Set("user_id", output.UserId)
+
+	if err := d.Set("assumed_role_arns", client.AssumedRoleARNs); err != nil {
+		return fmt.Errorf("error setting assumed_role_arns: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package meta_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+)
+
+func TestAccMetaProviderIdentityDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_provider_identity.test"
+	callerIdentityDataSourceName := "data.aws_caller_identity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderIdentityDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "account_id", callerIdentityDataSourceName, "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", callerIdentityDataSourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "user_id", callerIdentityDataSourceName, "user_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "assumed_role_arns.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "partition", acctest.Partition()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaProviderIdentityDataSource_assumeRoleChain(t *testing.T) {
+	var providers []*schema.Provider
+	dataSourceName := "data.aws_provider_identity.test"
+	roleARN := os.Getenv(conns.EnvVarAccAssumeRoleARN)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckAssumeRoleARN(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProviderFactories: acctest.FactoriesInternal(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderIdentityDataSourceConfigAssumeRoleChain(roleARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "assumed_role_arns.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "assumed_role_arns.0", roleARN),
+					resource.TestCheckResourceAttr(dataSourceName, "assumed_role_arns.1", roleARN),
+					resource.TestMatchResourceAttr(dataSourceName, "arn", regexp.MustCompile(`:assumed-role/.+/second$`)),
+				),
+			},
+		},
+	})
+}
+
+const testAccProviderIdentityDataSourceConfig = `
+data "aws_provider_identity" "test" {}
+
+data "aws_caller_identity" "test" {}
+`
+
+func testAccProviderIdentityDataSourceConfigAssumeRoleChain(roleARN string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  region = %[2]q
+
+  assume_role {
+    role_arn     = %[1]q
+    session_name = "first"
+  }
+
+  assume_role {
+    role_arn     = %[1]q
+    session_name = "second"
+  }
+}
+
+data "aws_provider_identity" "test" {}
+`, roleARN, acctest.Region())
+}
@@ -18,7 +18,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_networkfirewall_firewall_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_firewall_policy", &resource.Sweeper{
 		Name: "aws_networkfirewall_firewall_policy",
 		F:    sweepFirewallPolicies,
 		Dependencies: []string{
@@ -26,18 +26,18 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkfirewall_firewall", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_firewall", &resource.Sweeper{
 		Name:         "aws_networkfirewall_firewall",
 		F:            sweepFirewalls,
 		Dependencies: []string{"aws_networkfirewall_logging_configuration"},
 	})
 
-	resource.AddTestSweepers("aws_networkfirewall_logging_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_logging_configuration", &resource.Sweeper{
 		Name: "aws_networkfirewall_logging_configuration",
 		F:    sweepLoggingConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_networkfirewall_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_rule_group", &resource.Sweeper{
 		Name: "aws_networkfirewall_rule_group",
 		F:    sweepRuleGroups,
 		Dependencies: []string{
@@ -51,7 +51,7 @@ func sweepFirewallPolicies(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).NetworkFirewallConn
+	conn := client.(*conns.AWSClient).NetworkFirewallConn()
 	ctx := context.Background()
 	input := &networkfirewall.ListFirewallPoliciesInput{MaxResults: aws.Int64(100)}
 	var sweeperErrs *multierror.Error
@@ -101,7 +101,7 @@ func sweepFirewalls(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).NetworkFirewallConn
+	conn := client.(*conns.AWSClient).NetworkFirewallConn()
 	ctx := context.TODO()
 	input := &networkfirewall.ListFirewallsInput{MaxResults: aws.Int64(100)}
 	var sweeperErrs *multierror.Error
@@ -152,7 +152,7 @@ func sweepLoggingConfigurations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).NetworkFirewallConn
+	conn := client.(*conns.AWSClient).NetworkFirewallConn()
 	ctx := context.TODO()
 	input := &networkfirewall.ListFirewallsInput{MaxResults: aws.Int64(100)}
 	var sweeperErrs *multierror.Error
@@ -203,7 +203,7 @@ func sweepRuleGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).NetworkFirewallConn
+	conn := client.(*conns.AWSClient).NetworkFirewallConn()
 	ctx := context.Background()
 	input := &networkfirewall.ListRuleGroupsInput{MaxResults: aws.Int64(100)}
 	var sweeperErrs *multierror.Error
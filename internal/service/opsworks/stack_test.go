@@ -266,7 +266,7 @@ func testAccCheckStackRecreated(t *testing.T, before, after *opsworks.Stack) res
 }
 
 func testAccPreCheckStacks(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn()
 
 	input := &opsworks.DescribeStacksInput{}
 
@@ -483,7 +483,7 @@ func testAccCheckStackExists(n string, vpc bool, opsstack *opsworks.Stack) resou
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn()
 
 		params := &opsworks.DescribeStacksInput{
 			StackIds: []*string{aws.String(rs.Primary.ID)},
@@ -514,7 +514,7 @@ func testAccCheckStackExists(n string, vpc bool, opsstack *opsworks.Stack) resou
 }
 
 func testAccCheckStackDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_opsworks_stack" {
 			continue
@@ -161,7 +161,7 @@ func testAccAccount_Tags(t *testing.T) {
 }
 
 func testAccCheckAccountDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_organizations_account" {
@@ -198,7 +198,7 @@ func testAccCheckAccountExists(n string, a *organizations.Account) resource.Test
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsConn()
 		params := &organizations.DescribeAccountInput{
 			AccountId: &rs.Primary.ID,
 		}
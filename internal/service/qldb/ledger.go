@@ -67,7 +67,7 @@ func ResourceLedger() *schema.Resource {
 }
 
 func resourceLedgerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).QLDBConn
+	conn := meta.(*conns.AWSClient).QLDBConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -119,7 +119,7 @@ func resourceLedgerCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLedgerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).QLDBConn
+	conn := meta.(*conns.AWSClient).QLDBConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -180,7 +180,7 @@ func resourceLedgerRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLedgerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).QLDBConn
+	conn := meta.(*conns.AWSClient).QLDBConn()
 
 	if d.HasChange("permissions_mode") {
 		updateOpts := &qldb.UpdateLedgerPermissionsModeInput{
@@ -209,7 +209,7 @@ func resourceLedgerUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
-		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n, meta.(*conns.AWSClient).IgnoreTagsConfig); err != nil {
 			return fmt.Errorf("error updating tags: %s", err)
 		}
 	}
@@ -218,7 +218,7 @@ func resourceLedgerUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLedgerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).QLDBConn
+	conn := meta.(*conns.AWSClient).QLDBConn()
 	deleteLedgerOpts := &qldb.DeleteLedgerInput{
 		Name: aws.String(d.Id()),
 	}
@@ -56,7 +56,7 @@ func TestAccRDSCertificateDataSource_latestValidTill(t *testing.T) {
 }
 
 func testAccCertificatePreCheck(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn()
 
 	input := &rds.DescribeCertificatesInput{}
 
@@ -491,7 +491,7 @@ func ResourceInstance() *schema.Resource {
 }
 
 func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -830,13 +830,15 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		log.Println(
 			"[INFO] Waiting for DB Instance to be available")
 
-		stateConf := &resource.StateChangeConf{
-			Pending:    resourceInstanceCreatePendingStates,
-			Target:     []string{"available", "storage-optimization"},
-			Refresh:    resourceInstanceStateRefreshFunc(d.Id(), conn),
-			Timeout:    d.Timeout(schema.TimeoutCreate),
-			MinTimeout: 10 * time.Second,
-			Delay:      30 * time.Second, // Wait 30 secs before starting
+		stateConf := &tfresource.Waiter{
+			Description:     fmt.Sprintf("DB Instance (%s) creation", d.Id()),
+			Pending:         resourceInstanceCreatePendingStates,
+			Target:          []string{"available", "storage-optimization"},
+			Refresh:         resourceInstanceStateRefreshFunc(d.Id(), conn),
+			Timeout:         d.Timeout(schema.TimeoutCreate),
+			StatusReason:    dbInstanceStatusReason,
+			MinPollInterval: 10 * time.Second,
+			Delay:           30 * time.Second, // Wait 30 secs before starting
 		}
 
 		// Wait, catching any errors
@@ -1310,13 +1312,15 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(d.Get("identifier").(string))
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    resourceInstanceCreatePendingStates,
-		Target:     []string{"available", "storage-optimization"},
-		Refresh:    resourceInstanceStateRefreshFunc(d.Id(), conn),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second, // Wait 30 secs before starting
+	stateConf := &tfresource.Waiter{
+		Description:     fmt.Sprintf("DB Instance (%s) creation", d.Id()),
+		Pending:         resourceInstanceCreatePendingStates,
+		Target:          []string{"available", "storage-optimization"},
+		Refresh:         resourceInstanceStateRefreshFunc(d.Id(), conn),
+		Timeout:         d.Timeout(schema.TimeoutCreate),
+		StatusReason:    dbInstanceStatusReason,
+		MinPollInterval: 10 * time.Second,
+		Delay:           30 * time.Second, // Wait 30 secs before starting
 	}
 
 	log.Printf("[INFO] Waiting for DB Instance (%s) to be available", d.Id())
@@ -1363,7 +1367,7 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -1507,7 +1511,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 
 	input := &rds.DeleteDBInstanceInput{
 		DBInstanceIdentifier:   aws.String(d.Id()),
@@ -1545,20 +1549,22 @@ func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func waitUntilDBInstanceAvailableAfterUpdate(id string, conn *rds.RDS, timeout time.Duration) error {
-	stateConf := &resource.StateChangeConf{
-		Pending:    resourceInstanceUpdatePendingStates,
-		Target:     []string{"available", "storage-optimization"},
-		Refresh:    resourceInstanceStateRefreshFunc(id, conn),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second, // Wait 30 secs before starting
+	stateConf := &tfresource.Waiter{
+		Description:     fmt.Sprintf("DB Instance (%s) update", id),
+		Pending:         resourceInstanceUpdatePendingStates,
+		Target:          []string{"available", "storage-optimization"},
+		Refresh:         resourceInstanceStateRefreshFunc(id, conn),
+		Timeout:         timeout,
+		StatusReason:    dbInstanceStatusReason,
+		MinPollInterval: 10 * time.Second,
+		Delay:           30 * time.Second, // Wait 30 secs before starting
 	}
 	_, err := stateConf.WaitForState()
 	return err
 }
 
 func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 
 	req := &rds.ModifyDBInstanceInput{
 		ApplyImmediately:     aws.Bool(d.Get("apply_immediately").(bool)),
@@ -1806,7 +1812,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
-		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n, meta.(*conns.AWSClient).IgnoreTagsConfig); err != nil {
 			return fmt.Errorf("error updating RDS DB Instance (%s) tags: %s", d.Get("arn").(string), err)
 		}
 
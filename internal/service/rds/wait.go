@@ -1,10 +1,14 @@
 package rds
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -145,7 +149,8 @@ func waitDBClusterRoleAssociationDeleted(conn *rds.RDS, dbClusterID, roleARN str
 }
 
 func waitDBInstanceDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
-	stateConf := &resource.StateChangeConf{
+	stateConf := &tfresource.Waiter{
+		Description: fmt.Sprintf("DB Instance (%s) deletion", id),
 		Pending: []string{
 			InstanceStatusAvailable,
 			InstanceStatusBackingUp,
@@ -160,11 +165,12 @@ func waitDBInstanceDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rd
 			InstanceStatusStorageFull,
 			InstanceStatusStorageOptimization,
 		},
-		Target:     []string{},
-		Refresh:    statusDBInstance(conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
+		Target:          []string{},
+		Refresh:         statusDBInstance(conn, id),
+		Timeout:         timeout,
+		StatusReason:    dbInstanceStatusReason,
+		MinPollInterval: 10 * time.Second,
+		Delay:           30 * time.Second,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -177,17 +183,19 @@ func waitDBInstanceDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rd
 }
 
 func waitDBClusterInstanceDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
-	stateConf := &resource.StateChangeConf{
+	stateConf := &tfresource.Waiter{
+		Description: fmt.Sprintf("DB Instance (%s) deletion", id),
 		Pending: []string{
 			InstanceStatusConfiguringLogExports,
 			InstanceStatusDeleting,
 			InstanceStatusModifying,
 		},
-		Target:     []string{},
-		Refresh:    statusDBInstance(conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
+		Target:          []string{},
+		Refresh:         statusDBInstance(conn, id),
+		Timeout:         timeout,
+		StatusReason:    dbInstanceStatusReason,
+		MinPollInterval: 10 * time.Second,
+		Delay:           30 * time.Second,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -198,3 +206,24 @@ func waitDBClusterInstanceDeleted(conn *rds.RDS, id string, timeout time.Duratio
 
 	return nil, err
 }
+
+// dbInstanceStatusReason returns the messages of a DB Instance's status information, if any.
+func dbInstanceStatusReason(v interface{}) string {
+	output, ok := v.(*rds.DBInstance)
+
+	if !ok {
+		return ""
+	}
+
+	var reasons []string
+
+	for _, statusInfo := range output.StatusInfos {
+		if statusInfo == nil || aws.StringValue(statusInfo.Message) == "" {
+			continue
+		}
+
+		reasons = append(reasons, fmt.Sprintf("%s: %s", aws.StringValue(statusInfo.StatusType), aws.StringValue(statusInfo.Message)))
+	}
+
+	return strings.Join(reasons, ", ")
+}
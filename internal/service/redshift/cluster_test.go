@@ -547,7 +547,7 @@ func TestAccRedshiftCluster_changeEncryption2(t *testing.T) {
 }
 
 func testAccCheckClusterDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_redshift_cluster" {
@@ -578,7 +578,7 @@ func testAccCheckDestroyClusterSnapshot(rName string) resource.TestCheckFunc {
 			}
 
 			// Try and delete the snapshot before we check for the cluster not found
-			conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+			conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn()
 
 			_, err := conn.DeleteClusterSnapshot(
 				&redshift.DeleteClusterSnapshotInput{
@@ -617,7 +617,7 @@ func testAccCheckClusterExists(n string, v *redshift.Cluster) resource.TestCheck
 			return fmt.Errorf("No Redshift Cluster ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn()
 
 		output, err := tfredshift.FindClusterByID(conn, rs.Primary.ID)
 
@@ -206,7 +206,7 @@ func testAccCheckSecurityGroupExists(n string, v *redshift.ClusterSecurityGroup)
 			return fmt.Errorf("No Redshift Security Group ID is set")
 		}
 
-		conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).RedshiftConn
+		conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).RedshiftConn()
 
 		opts := redshift.DescribeClusterSecurityGroupsInput{
 			ClusterSecurityGroupName: aws.String(rs.Primary.ID),
@@ -230,7 +230,7 @@ func testAccCheckSecurityGroupExists(n string, v *redshift.ClusterSecurityGroup)
 }
 
 func testAccCheckSecurityGroupDestroy(s *terraform.State) error {
-	conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).RedshiftConn
+	conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).RedshiftConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_redshift_security_group" {
@@ -121,7 +121,7 @@ func ResourceZone() *schema.Resource {
 }
 
 func resourceZoneCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 	region := meta.(*conns.AWSClient).Region
@@ -162,7 +162,7 @@ func resourceZoneCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if err := UpdateTags(conn, d.Id(), route53.TagResourceTypeHostedzone, nil, tags); err != nil {
+	if err := UpdateTags(conn, d.Id(), route53.TagResourceTypeHostedzone, nil, tags, meta.(*conns.AWSClient).IgnoreTagsConfig); err != nil {
 		return fmt.Errorf("error setting Route53 Zone (%s) tags: %s", d.Id(), err)
 	}
 
@@ -181,7 +181,7 @@ func resourceZoneCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceZoneRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -273,7 +273,7 @@ func resourceZoneRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceZoneUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 	region := meta.(*conns.AWSClient).Region
 
 	if d.HasChange("comment") {
@@ -292,7 +292,7 @@ func resourceZoneUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
-		if err := UpdateTags(conn, d.Id(), route53.TagResourceTypeHostedzone, o, n); err != nil {
+		if err := UpdateTags(conn, d.Id(), route53.TagResourceTypeHostedzone, o, n, meta.(*conns.AWSClient).IgnoreTagsConfig); err != nil {
 			return fmt.Errorf("error updating Route53 Zone (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -334,7 +334,7 @@ func resourceZoneUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceZoneDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 
 	if d.Get("force_destroy").(bool) {
 		if err := deleteAllRecordsInHostedZoneId(d.Id(), d.Get("name").(string), conn); err != nil {
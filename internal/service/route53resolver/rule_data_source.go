@@ -80,7 +80,7 @@ func DataSourceRule() *schema.Resource {
 }
 
 func dataSourceRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	var rule *route53resolver.ResolverRule
@@ -0,0 +1,147 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceBucketAccelerateConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketAccelerateConfigurationCreate,
+		Read:   resourceBucketAccelerateConfigurationRead,
+		Update: resourceBucketAccelerateConfigurationUpdate,
+		Delete: resourceBucketAccelerateConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(s3.BucketAccelerateStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceBucketAccelerateConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+
+	output, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.GetBucketAccelerateConfiguration(&s3.GetBucketAccelerateConfigurationInput{
+			Bucket: aws.String(bucket),
+		})
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) accelerate configuration: %w", bucket, err)
+	}
+
+	// A bucket that has never had transfer acceleration configured has no status, and deletion leaves it suspended.
+	if accelerate, ok := output.(*s3.GetBucketAccelerateConfigurationOutput); ok && accelerate != nil && aws.StringValue(accelerate.Status) == s3.BucketAccelerateStatusEnabled {
+		return bucketConfigurationExistsError(bucket, "accelerate", "acceleration_status")
+	}
+
+	if err := putBucketAccelerateConfiguration(conn, bucket, d.Get("status").(string)); err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) accelerate configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketAccelerateConfigurationRead(d, meta)
+}
+
+func resourceBucketAccelerateConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	output, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.GetBucketAccelerateConfiguration(&s3.GetBucketAccelerateConfigurationInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket Accelerate Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) accelerate configuration: %w", d.Id(), err)
+	}
+
+	accelerate, ok := output.(*s3.GetBucketAccelerateConfigurationOutput)
+
+	if !ok || accelerate == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) accelerate configuration: empty output", d.Id())
+	}
+
+	if !d.IsNewResource() && accelerate.Status == nil {
+		log.Printf("[WARN] S3 Bucket Accelerate Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("status", accelerate.Status)
+
+	return nil
+}
+
+func resourceBucketAccelerateConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	if err := putBucketAccelerateConfiguration(conn, d.Id(), d.Get("status").(string)); err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) accelerate configuration: %w", d.Id(), err)
+	}
+
+	return resourceBucketAccelerateConfigurationRead(d, meta)
+}
+
+func resourceBucketAccelerateConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	// Transfer acceleration cannot be removed from a bucket once configured, only suspended.
+	err := putBucketAccelerateConfiguration(conn, d.Id(), s3.BucketAccelerateStatusSuspended)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) accelerate configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func putBucketAccelerateConfiguration(conn *s3.S3, bucket, status string) error {
+	input := &s3.PutBucketAccelerateConfigurationInput{
+		Bucket: aws.String(bucket),
+		AccelerateConfiguration: &s3.AccelerateConfiguration{
+			Status: aws.String(status),
+		},
+	}
+
+	_, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.PutBucketAccelerateConfiguration(input)
+	})
+
+	return err
+}
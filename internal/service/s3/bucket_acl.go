@@ -0,0 +1,206 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceBucketACL() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketACLCreate,
+		Read:   resourceBucketACLRead,
+		Update: resourceBucketACLUpdate,
+		Delete: resourceBucketACLDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"acl", "grant"},
+				ValidateFunc: validation.StringInSlice(BucketCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"grant": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Set:          grantHash,
+				ExactlyOneOf: []string{"acl", "grant"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							// TypeAmazonCustomerByEmail is not currently supported
+							ValidateFunc: validation.StringInSlice([]string{
+								s3.TypeCanonicalUser,
+								s3.TypeGroup,
+							}, false),
+						},
+						"uri": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"permissions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(s3.Permission_Values(), false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBucketACLCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+
+	output, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.GetBucketAcl(&s3.GetBucketAclInput{
+			Bucket: aws.String(bucket),
+		})
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) ACL: %w", bucket, err)
+	}
+
+	// Every bucket has an ACL. Only one that differs from the default "private" ACL conflicts.
+	if len(flattenGrants(output.(*s3.GetBucketAclOutput))) > 0 {
+		return bucketConfigurationExistsError(bucket, "non-default ACL", "acl")
+	}
+
+	if err := putBucketACL(conn, bucket, d); err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) ACL: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketACLRead(d, meta)
+}
+
+func resourceBucketACLRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	output, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.GetBucketAcl(&s3.GetBucketAclInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket ACL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) ACL: %w", d.Id(), err)
+	}
+
+	acl, ok := output.(*s3.GetBucketAclOutput)
+
+	if !ok || acl == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) ACL: empty output", d.Id())
+	}
+
+	d.Set("bucket", d.Id())
+
+	// A canned ACL cannot be read back, so the grants are only set if no canned ACL is configured.
+	if _, ok := d.GetOk("acl"); ok {
+		return nil
+	}
+
+	if err := d.Set("grant", schema.NewSet(grantHash, flattenGrants(acl))); err != nil {
+		return fmt.Errorf("error setting grant: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketACLUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	if err := putBucketACL(conn, d.Id(), d); err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) ACL: %w", d.Id(), err)
+	}
+
+	return resourceBucketACLRead(d, meta)
+}
+
+func resourceBucketACLDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	// A bucket ACL cannot be removed, only reset to the default.
+	_, err := conn.PutBucketAcl(&s3.PutBucketAclInput{
+		Bucket: aws.String(d.Id()),
+		ACL:    aws.String(s3.BucketCannedACLPrivate),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) ACL: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func putBucketACL(conn *s3.S3, bucket string, d *schema.ResourceData) error {
+	input := &s3.PutBucketAclInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if v, ok := d.GetOk("acl"); ok {
+		input.ACL = aws.String(v.(string))
+	} else {
+		// The owner is required when setting grants.
+		output, err := retryWhenBucketNotFound(func() (interface{}, error) {
+			return conn.GetBucketAcl(&s3.GetBucketAclInput{
+				Bucket: aws.String(bucket),
+			})
+		})
+
+		if err != nil {
+			return err
+		}
+
+		input.AccessControlPolicy = &s3.AccessControlPolicy{
+			Grants: expandBucketGrants(d.Get("grant").(*schema.Set).List()),
+			Owner:  output.(*s3.GetBucketAclOutput).Owner,
+		}
+	}
+
+	_, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.PutBucketAcl(input)
+	})
+
+	return err
+}
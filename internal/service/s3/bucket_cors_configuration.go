@@ -0,0 +1,172 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceBucketCorsConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketCorsConfigurationCreate,
+		Read:   resourceBucketCorsConfigurationRead,
+		Update: resourceBucketCorsConfigurationUpdate,
+		Delete: resourceBucketCorsConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"cors_rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_headers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allowed_methods": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allowed_origins": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"expose_headers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"max_age_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBucketCorsConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+
+	_, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.GetBucketCors(&s3.GetBucketCorsInput{
+			Bucket: aws.String(bucket),
+		})
+	})
+
+	if err == nil {
+		return bucketConfigurationExistsError(bucket, "CORS", "cors_rule")
+	}
+
+	if !tfawserr.ErrCodeEquals(err, ErrCodeNoSuchCORSConfiguration) {
+		return fmt.Errorf("error reading S3 Bucket (%s) CORS configuration: %w", bucket, err)
+	}
+
+	if err := putBucketCorsConfiguration(conn, bucket, d.Get("cors_rule").([]interface{})); err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) CORS configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketCorsConfigurationRead(d, meta)
+}
+
+func resourceBucketCorsConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	output, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.GetBucketCors(&s3.GetBucketCorsInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeNoSuchCORSConfiguration, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket CORS Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) CORS configuration: %w", d.Id(), err)
+	}
+
+	cors, ok := output.(*s3.GetBucketCorsOutput)
+
+	if !ok || cors == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) CORS configuration: empty output", d.Id())
+	}
+
+	d.Set("bucket", d.Id())
+
+	if err := d.Set("cors_rule", flattenBucketCorsRules(cors.CORSRules)); err != nil {
+		return fmt.Errorf("error setting cors_rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketCorsConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	if err := putBucketCorsConfiguration(conn, d.Id(), d.Get("cors_rule").([]interface{})); err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) CORS configuration: %w", d.Id(), err)
+	}
+
+	return resourceBucketCorsConfigurationRead(d, meta)
+}
+
+func resourceBucketCorsConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	_, err := conn.DeleteBucketCors(&s3.DeleteBucketCorsInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeNoSuchCORSConfiguration, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) CORS configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func putBucketCorsConfiguration(conn *s3.S3, bucket string, rules []interface{}) error {
+	input := &s3.PutBucketCorsInput{
+		Bucket: aws.String(bucket),
+		CORSConfiguration: &s3.CORSConfiguration{
+			CORSRules: expandBucketCorsRules(rules),
+		},
+	}
+
+	_, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.PutBucketCors(input)
+	})
+
+	return err
+}
@@ -0,0 +1,160 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceBucketLogging() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketLoggingCreate,
+		Read:   resourceBucketLoggingRead,
+		Update: resourceBucketLoggingUpdate,
+		Delete: resourceBucketLoggingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"target_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"target_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceBucketLoggingCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+
+	output, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.GetBucketLogging(&s3.GetBucketLoggingInput{
+			Bucket: aws.String(bucket),
+		})
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) logging: %w", bucket, err)
+	}
+
+	if logging, ok := output.(*s3.GetBucketLoggingOutput); ok && logging != nil && logging.LoggingEnabled != nil {
+		return bucketConfigurationExistsError(bucket, "logging", "logging")
+	}
+
+	if err := putBucketLogging(conn, bucket, d); err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) logging: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketLoggingRead(d, meta)
+}
+
+func resourceBucketLoggingRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	output, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.GetBucketLogging(&s3.GetBucketLoggingInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket Logging (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) logging: %w", d.Id(), err)
+	}
+
+	logging, ok := output.(*s3.GetBucketLoggingOutput)
+
+	if !ok || logging == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) logging: empty output", d.Id())
+	}
+
+	if logging.LoggingEnabled == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading S3 Bucket (%s) logging: not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] S3 Bucket Logging (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("target_bucket", logging.LoggingEnabled.TargetBucket)
+	d.Set("target_prefix", logging.LoggingEnabled.TargetPrefix)
+
+	return nil
+}
+
+func resourceBucketLoggingUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	if err := putBucketLogging(conn, d.Id(), d); err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) logging: %w", d.Id(), err)
+	}
+
+	return resourceBucketLoggingRead(d, meta)
+}
+
+func resourceBucketLoggingDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	// An empty logging status disables logging.
+	_, err := conn.PutBucketLogging(&s3.PutBucketLoggingInput{
+		Bucket:              aws.String(d.Id()),
+		BucketLoggingStatus: &s3.BucketLoggingStatus{},
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) logging: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func putBucketLogging(conn *s3.S3, bucket string, d *schema.ResourceData) error {
+	input := &s3.PutBucketLoggingInput{
+		Bucket: aws.String(bucket),
+		BucketLoggingStatus: &s3.BucketLoggingStatus{
+			LoggingEnabled: &s3.LoggingEnabled{
+				TargetBucket: aws.String(d.Get("target_bucket").(string)),
+				TargetPrefix: aws.String(d.Get("target_prefix").(string)),
+			},
+		},
+	}
+
+	_, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.PutBucketLogging(input)
+	})
+
+	return err
+}
@@ -3,6 +3,7 @@ package s3
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
 	"io"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -29,7 +31,13 @@ import (
 	"github.com/mitchellh/go-homedir"
 )
 
-const s3BucketObjectCreationTimeout = 2 * time.Minute
+const (
+	s3BucketObjectCreationTimeout = 2 * time.Minute
+
+	// Objects up to the maximum size of a single PUT are not split into parts
+	// unless a part size is configured, so that their ETag remains an MD5 digest.
+	s3BucketObjectDefaultPartSize = 5 * 1024 * 1024 * 1024
+)
 
 func ResourceBucketObject() *schema.Resource {
 	return &schema.Resource{
@@ -80,6 +88,12 @@ func ResourceBucketObject() *schema.Resource {
 				Optional: true,
 			},
 
+			"checksum_sha256": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9+/]{43}=$`), "must be a base64-encoded SHA-256 digest"),
+			},
+
 			"content_disposition": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -156,12 +170,13 @@ func ResourceBucketObject() *schema.Resource {
 
 			"etag": {
 				Type: schema.TypeString,
-				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload
-				// if/when it's actually implemented. The Etag then won't match raw-file MD5.
+				// This will conflict with SSE-C and SSE-KMS encryption and multipart upload.
+				// The Etag then won't match raw-file MD5. Content larger than a single PUT is
+				// always uploaded in parts, which is checked in CustomizeDiff.
 				// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTCommonResponseHeaders.html
 				Optional:      true,
 				Computed:      true,
-				ConflictsWith: []string{"kms_key_id"},
+				ConflictsWith: []string{"kms_key_id", "multipart_part_size"},
 			},
 
 			"version_id": {
@@ -183,6 +198,19 @@ func ResourceBucketObject() *schema.Resource {
 				Default:  false,
 			},
 
+			"multipart_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"multipart_part_size": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntBetween(5, 5*1024),
+				ConflictsWith: []string{"etag"},
+			},
+
 			"object_lock_legal_hold_status": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -210,7 +238,7 @@ func ResourceBucketObject() *schema.Resource {
 }
 
 func resourceBucketObjectPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -246,18 +274,38 @@ func resourceBucketObjectPut(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error decoding content_base64: %s", err)
 		}
 		body = bytes.NewReader(contentRaw)
+	} else {
+		body = bytes.NewReader([]byte{})
 	}
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
-	putInput := &s3.PutObjectInput{
+	if v, ok := d.GetOk("checksum_sha256"); ok {
+		checksum, err := sha256Base64(body)
+
+		if err != nil {
+			return fmt.Errorf("error computing SHA-256 checksum of S3 Bucket (%s) Object (%s) content: %w", bucket, key, err)
+		}
+
+		if checksum != v.(string) {
+			return fmt.Errorf("S3 Bucket (%s) Object (%s) content SHA-256 checksum (%s) does not match checksum_sha256 (%s)", bucket, key, checksum, v.(string))
+		}
+	}
+
+	putInput := &s3manager.UploadInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 		ACL:    aws.String(d.Get("acl").(string)),
 		Body:   body,
 	}
 
+	// S3 verifies and stores the checksum of content uploaded with a single PUT. The uploader drops it for
+	// multipart uploads. ChecksumAlgorithm is not set as it would require a checksum for each part.
+	if v, ok := d.GetOk("checksum_sha256"); ok {
+		putInput.ChecksumSHA256 = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("storage_class"); ok {
 		putInput.StorageClass = aws.String(v.(string))
 	}
@@ -320,7 +368,20 @@ func resourceBucketObjectPut(d *schema.ResourceData, meta interface{}) error {
 		putInput.ObjectLockRetainUntilDate = expandS3ObjectDate(v.(string))
 	}
 
-	if _, err := conn.PutObject(putInput); err != nil {
+	uploader := s3manager.NewUploaderWithClient(conn, func(u *s3manager.Uploader) {
+		u.PartSize = s3BucketObjectDefaultPartSize
+
+		if v, ok := d.GetOk("multipart_part_size"); ok {
+			u.PartSize = int64(v.(int)) * 1024 * 1024
+		}
+
+		if v, ok := d.GetOk("multipart_concurrency"); ok {
+			u.Concurrency = v.(int)
+		}
+	})
+
+	// Bodies are always seekable and support ReadAt, so parts are streamed from the source rather than buffered in memory.
+	if _, err := uploader.Upload(putInput); err != nil {
 		return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
 	}
 
@@ -333,7 +394,7 @@ func resourceBucketObjectCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceBucketObjectRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -345,6 +406,10 @@ func resourceBucketObjectRead(d *schema.ResourceData, meta interface{}) error {
 		Key:    aws.String(key),
 	}
 
+	if _, ok := d.GetOk("checksum_sha256"); ok {
+		input.ChecksumMode = aws.String(s3.ChecksumModeEnabled)
+	}
+
 	var resp *s3.HeadObjectOutput
 
 	err := resource.Retry(s3BucketObjectCreationTimeout, func() *resource.RetryError {
@@ -410,6 +475,11 @@ func resourceBucketObjectRead(d *schema.ResourceData, meta interface{}) error {
 	// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
 	d.Set("etag", strings.Trim(aws.StringValue(resp.ETag), `"`))
 
+	// Objects uploaded in parts have no checksum of the whole content, only a checksum of the part checksums.
+	if v := aws.StringValue(resp.ChecksumSHA256); v != "" && !strings.Contains(v, "-") {
+		d.Set("checksum_sha256", v)
+	}
+
 	// The "STANDARD" (which is also the default) storage
 	// class when set would not be included in the results.
 	d.Set("storage_class", s3.StorageClassStandard)
@@ -451,7 +521,7 @@ func resourceBucketObjectUpdate(d *schema.ResourceData, meta interface{}) error
 		return resourceBucketObjectPut(d, meta)
 	}
 
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
@@ -509,7 +579,7 @@ func resourceBucketObjectUpdate(d *schema.ResourceData, meta interface{}) error
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
-		if err := ObjectUpdateTags(conn, bucket, key, o, n); err != nil {
+		if err := ObjectUpdateTags(conn, bucket, key, o, n, meta.(*conns.AWSClient).IgnoreTagsConfig); err != nil {
 			return fmt.Errorf("error updating tags: %s", err)
 		}
 	}
@@ -518,7 +588,7 @@ func resourceBucketObjectUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceBucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
@@ -564,7 +634,7 @@ func resourceBucketObjectSetKMS(d *schema.ResourceData, meta interface{}, sseKMS
 	// Only set non-default KMS key ID (one that doesn't match default)
 	if sseKMSKeyId != nil {
 		// retrieve S3 KMS Default Master Key
-		conn := meta.(*conns.AWSClient).KMSConn
+		conn := meta.(*conns.AWSClient).KMSConn()
 		keyMetadata, err := kms.FindKeyByID(conn, DefaultKmsKeyAlias)
 		if err != nil {
 			return fmt.Errorf("Failed to describe default S3 KMS key (%s): %s", DefaultKmsKeyAlias, err)
@@ -592,6 +662,10 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 }
 
 func resourceBucketObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := checkBucketObjectETagSourceSize(d); err != nil {
+		return err
+	}
+
 	if hasS3BucketObjectContentChanges(d) {
 		return d.SetNewComputed("version_id")
 	}
@@ -604,10 +678,45 @@ func resourceBucketObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// checkBucketObjectETagSourceSize returns an error if etag is configured for a source file
+// that is too large for a single PUT and so will be uploaded in parts, giving it a non-MD5 ETag.
+func checkBucketObjectETagSourceSize(d *schema.ResourceDiff) error {
+	// etag is also computed, so only the configuration shows whether it is set.
+	if rawConfig := d.GetRawConfig(); !rawConfig.IsKnown() || rawConfig.IsNull() || rawConfig.GetAttr("etag").IsNull() {
+		return nil
+	}
+
+	source := d.Get("source").(string)
+
+	if source == "" {
+		return nil
+	}
+
+	path, err := homedir.Expand(source)
+
+	if err != nil {
+		return fmt.Errorf("error expanding homedir in source (%s): %w", source, err)
+	}
+
+	// A missing source is reported when the object is uploaded.
+	fi, err := os.Stat(path)
+
+	if err != nil {
+		return nil
+	}
+
+	if fi.Size() > s3BucketObjectDefaultPartSize {
+		return fmt.Errorf("etag cannot be used with source (%s) larger than 5 GiB, which is uploaded in parts; use checksum_sha256 or source_hash instead", source)
+	}
+
+	return nil
+}
+
 func hasS3BucketObjectContentChanges(d verify.ResourceDiffer) bool {
 	for _, key := range []string{
 		"bucket_key_enabled",
 		"cache_control",
+		"checksum_sha256",
 		"content_base64",
 		"content_disposition",
 		"content_encoding",
@@ -798,6 +907,21 @@ func deleteS3ObjectVersion(conn *s3.S3, b, k, v string, force bool) error {
 	return err
 }
 
+// sha256Base64 returns the base64-encoded SHA-256 digest of r and rewinds it.
+func sha256Base64(r io.ReadSeeker) (string, error) {
+	h := sha256.New()
+
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
 func expandS3ObjectDate(v string) *time.Time {
 	t, err := time.Parse(time.RFC3339, v)
 	if err != nil {
@@ -0,0 +1,197 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceBucketObjectLockConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketObjectLockConfigurationCreate,
+		Read:   resourceBucketObjectLockConfigurationRead,
+		Update: resourceBucketObjectLockConfigurationUpdate,
+		Delete: resourceBucketObjectLockConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"object_lock_enabled": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      s3.ObjectLockEnabledEnabled,
+				ValidateFunc: validation.StringInSlice(s3.ObjectLockEnabled_Values(), false),
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_retention": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"mode": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.ObjectLockRetentionMode_Values(), false),
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"years": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBucketObjectLockConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+
+	output, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.GetObjectLockConfiguration(&s3.GetObjectLockConfigurationInput{
+			Bucket: aws.String(bucket),
+		})
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, ErrCodeObjectLockConfigurationNotFound) {
+		return fmt.Errorf("error reading S3 Bucket (%s) Object Lock configuration: %w", bucket, err)
+	}
+
+	// Object Lock is enabled when a bucket is created. Only an existing default retention rule conflicts.
+	if lock, ok := output.(*s3.GetObjectLockConfigurationOutput); ok && lock != nil && lock.ObjectLockConfiguration != nil && lock.ObjectLockConfiguration.Rule != nil {
+		return bucketConfigurationExistsError(bucket, "Object Lock rule", "object_lock_configuration")
+	}
+
+	if err := putBucketObjectLockConfiguration(conn, bucket, d); err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) Object Lock configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketObjectLockConfigurationRead(d, meta)
+}
+
+func resourceBucketObjectLockConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	output, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.GetObjectLockConfiguration(&s3.GetObjectLockConfigurationInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeObjectLockConfigurationNotFound, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket Object Lock Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Object Lock configuration: %w", d.Id(), err)
+	}
+
+	lock, ok := output.(*s3.GetObjectLockConfigurationOutput)
+
+	if !ok || lock == nil || lock.ObjectLockConfiguration == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Object Lock configuration: empty output", d.Id())
+	}
+
+	if !d.IsNewResource() && lock.ObjectLockConfiguration.Rule == nil {
+		log.Printf("[WARN] S3 Bucket Object Lock Configuration (%s) rule not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	tfMap := flattenS3ObjectLockConfiguration(lock.ObjectLockConfiguration)[0].(map[string]interface{})
+
+	d.Set("bucket", d.Id())
+	d.Set("object_lock_enabled", tfMap["object_lock_enabled"])
+
+	if err := d.Set("rule", tfMap["rule"]); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketObjectLockConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	if err := putBucketObjectLockConfiguration(conn, d.Id(), d); err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) Object Lock configuration: %w", d.Id(), err)
+	}
+
+	return resourceBucketObjectLockConfigurationRead(d, meta)
+}
+
+func resourceBucketObjectLockConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	// Object Lock cannot be disabled once enabled, only the default retention rule removed.
+	_, err := conn.PutObjectLockConfiguration(&s3.PutObjectLockConfigurationInput{
+		Bucket: aws.String(d.Id()),
+		ObjectLockConfiguration: &s3.ObjectLockConfiguration{
+			ObjectLockEnabled: aws.String(d.Get("object_lock_enabled").(string)),
+		},
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeObjectLockConfigurationNotFound, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Object Lock configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func putBucketObjectLockConfiguration(conn *s3.S3, bucket string, d *schema.ResourceData) error {
+	input := &s3.PutObjectLockConfigurationInput{
+		Bucket: aws.String(bucket),
+		ObjectLockConfiguration: expandS3ObjectLockConfiguration([]interface{}{
+			map[string]interface{}{
+				"object_lock_enabled": d.Get("object_lock_enabled"),
+				"rule":                d.Get("rule"),
+			},
+		}),
+	}
+
+	_, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.PutObjectLockConfiguration(input)
+	})
+
+	return err
+}
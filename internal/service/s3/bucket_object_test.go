@@ -1,6 +1,7 @@
 package s3_test
 
 import (
+	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
 	"io"
@@ -8,6 +9,7 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -253,6 +255,80 @@ func TestAccS3BucketObject_sourceHashTrigger(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketObject_checksumSHA256(t *testing.T) {
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_bucket_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	sum := sha256.Sum256([]byte("Ebben!"))
+	checksum := base64.StdEncoding.EncodeToString(sum[:])
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketObjectConfig_checksumSHA256(rName, "Ne andrò lontana", checksum),
+				ExpectError: regexp.MustCompile(`does not match checksum_sha256`),
+			},
+			{
+				Config: testAccBucketObjectConfig_checksumSHA256(rName, "Ebben!", checksum),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketObjectExists(resourceName, &obj),
+					testAccCheckBucketObjectBody(&obj, "Ebben!"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", checksum),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acl", "checksum_sha256", "content", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketObject_multipartUpload(t *testing.T) {
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_bucket_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	// 11 MiB, uploaded as 3 parts of at most 5 MiB.
+	data := strings.Repeat("0123456789abcdef", 11*1024*1024/16)
+	source := testAccBucketObjectCreateTempFile(t, data)
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketObjectConfig_multipartUpload(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketObjectExists(resourceName, &obj),
+					testAccCheckBucketObjectBody(&obj, data),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexp.MustCompile(`-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "multipart_part_size", "5"),
+					resource.TestCheckResourceAttr(resourceName, "multipart_concurrency", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acl", "checksum_sha256", "force_destroy", "multipart_concurrency", "multipart_part_size", "source"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketObject_withContentCharacteristics(t *testing.T) {
 	var obj s3.GetObjectOutput
 	resourceName := "aws_s3_bucket_object.object"
@@ -1343,7 +1419,7 @@ func testAccCheckBucketObjectVersionIdEquals(first, second *s3.GetObjectOutput)
 }
 
 func testAccCheckBucketObjectDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_s3_bucket_object" {
@@ -1374,7 +1450,7 @@ func testAccCheckBucketObjectExists(n string, obj *s3.GetObjectOutput) resource.
 			return fmt.Errorf("No S3 Bucket Object ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 		input := &s3.GetObjectInput{
 			Bucket:  aws.String(rs.Primary.Attributes["bucket"]),
@@ -1433,7 +1509,7 @@ func testAccCheckBucketObjectBody(obj *s3.GetObjectOutput, want string) resource
 func testAccCheckBucketObjectACL(n string, expectedPerms []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs := s.RootModule().Resources[n]
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 		out, err := conn.GetObjectAcl(&s3.GetObjectAclInput{
 			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
@@ -1461,7 +1537,7 @@ func testAccCheckBucketObjectACL(n string, expectedPerms []string) resource.Test
 func testAccCheckBucketObjectStorageClass(n, expectedClass string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs := s.RootModule().Resources[n]
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 		out, err := conn.HeadObject(&s3.HeadObjectInput{
 			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
@@ -1491,7 +1567,7 @@ func testAccCheckBucketObjectStorageClass(n, expectedClass string) resource.Test
 func testAccCheckBucketObjectSSE(n, expectedSSE string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs := s.RootModule().Resources[n]
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 		out, err := conn.HeadObject(&s3.HeadObjectInput{
 			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
@@ -1535,16 +1611,16 @@ func testAccBucketObjectCreateTempFile(t *testing.T, data string) string {
 func testAccCheckBucketObjectUpdateTags(n string, oldTags, newTags map[string]string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs := s.RootModule().Resources[n]
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
-		return tfs3.ObjectUpdateTags(conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key"], oldTags, newTags)
+		return tfs3.ObjectUpdateTags(conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key"], oldTags, newTags, nil)
 	}
 }
 
 func testAccCheckBucketObjectCheckTags(n string, expectedTags map[string]string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs := s.RootModule().Resources[n]
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 		got, err := tfs3.ObjectListTags(conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key"])
 		if err != nil {
@@ -1673,6 +1749,38 @@ resource "aws_s3_bucket_object" "object" {
 `, rName, source)
 }
 
+func testAccBucketObjectConfig_checksumSHA256(rName, content, checksum string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket          = aws_s3_bucket.test.bucket
+  key             = "test-key"
+  content         = %[2]q
+  checksum_sha256 = %[3]q
+}
+`, rName, content, checksum)
+}
+
+func testAccBucketObjectConfig_multipartUpload(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket                = aws_s3_bucket.test.bucket
+  key                   = "test-key"
+  source                = %[2]q
+  checksum_sha256       = filebase64sha256(%[2]q)
+  multipart_part_size   = 5
+  multipart_concurrency = 2
+}
+`, rName, source)
+}
+
 func testAccBucketObjectConfig_updateable(rName string, bucketVersioning bool, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "object_bucket_3" {
@@ -2,11 +2,17 @@ package s3
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 const keyRequestPageSize = 1000
@@ -45,6 +51,23 @@ func DataSourceBucketObjects() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"fetch_metadata": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"fetch_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"key_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"suffix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"keys": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -60,15 +83,65 @@ func DataSourceBucketObjects() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metadata": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"storage_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tftags.TagsSchemaComputed(),
+					},
+				},
+			},
 		},
 	}
 }
 
 func dataSourceBucketObjectsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	bucket := d.Get("bucket").(string)
 	prefix := d.Get("prefix").(string)
+	suffix := d.Get("suffix").(string)
+
+	var keyRegex *regexp.Regexp
+
+	if v, ok := d.GetOk("key_regex"); ok {
+		keyRegex = regexp.MustCompile(v.(string))
+	}
+
+	// When keys are filtered client-side, "maxKeys" caps the number of matching keys
+	// and pages are always requested at full size.
+	filtered := suffix != "" || keyRegex != nil
 
 	listInput := s3.ListObjectsV2Input{
 		Bucket: aws.String(bucket),
@@ -90,7 +163,7 @@ func dataSourceBucketObjectsRead(d *schema.ResourceData, meta interface{}) error
 	// (i.e., page size), not the total number of keys returned if you page
 	// through the results. "maxKeys" does refer to total keys returned.
 	maxKeys := int64(d.Get("max_keys").(int))
-	if maxKeys <= keyRequestPageSize {
+	if !filtered && maxKeys <= keyRequestPageSize {
 		listInput.MaxKeys = aws.Int64(maxKeys)
 	}
 
@@ -105,6 +178,7 @@ func dataSourceBucketObjectsRead(d *schema.ResourceData, meta interface{}) error
 	var commonPrefixes []string
 	var keys []string
 	var owners []string
+	var objects []*s3.Object
 
 	err := conn.ListObjectsV2Pages(&listInput, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
 		for _, commonPrefix := range page.CommonPrefixes {
@@ -112,13 +186,28 @@ func dataSourceBucketObjectsRead(d *schema.ResourceData, meta interface{}) error
 		}
 
 		for _, object := range page.Contents {
-			keys = append(keys, aws.StringValue(object.Key))
+			key := aws.StringValue(object.Key)
+
+			if !strings.HasSuffix(key, suffix) || (keyRegex != nil && !keyRegex.MatchString(key)) {
+				continue
+			}
+
+			if filtered && int64(len(keys)) >= maxKeys {
+				return false
+			}
+
+			keys = append(keys, key)
+			objects = append(objects, object)
 
 			if object.Owner != nil {
 				owners = append(owners, aws.StringValue(object.Owner.ID))
 			}
 		}
 
+		if filtered {
+			return !lastPage
+		}
+
 		maxKeys = maxKeys - aws.Int64Value(page.KeyCount)
 
 		if maxKeys <= keyRequestPageSize {
@@ -146,5 +235,64 @@ func dataSourceBucketObjectsRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error setting owners: %w", err)
 	}
 
+	tfList := make([]interface{}, 0, len(objects))
+
+	for _, object := range objects {
+		key := aws.StringValue(object.Key)
+		tfMap := flattenBucketObjectsObject(object)
+
+		if d.Get("fetch_metadata").(bool) {
+			output, err := conn.HeadObject(&s3.HeadObjectInput{
+				Bucket: aws.String(bucket),
+				Key:    aws.String(key),
+			})
+
+			if err != nil {
+				return fmt.Errorf("error reading S3 Bucket (%s) Object (%s): %w", bucket, key, err)
+			}
+
+			tfMap["metadata"] = flex.PointersMapToStringList(output.Metadata)
+		}
+
+		if d.Get("fetch_tags").(bool) {
+			tags, err := ObjectListTags(conn, bucket, key)
+
+			if err != nil {
+				return fmt.Errorf("error listing tags for S3 Bucket (%s) Object (%s): %w", bucket, key, err)
+			}
+
+			tfMap["tags"] = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	if err := d.Set("objects", tfList); err != nil {
+		return fmt.Errorf("error setting objects: %w", err)
+	}
+
 	return nil
 }
+
+func flattenBucketObjectsObject(apiObject *s3.Object) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"etag":          strings.Trim(aws.StringValue(apiObject.ETag), `"`),
+		"key":           aws.StringValue(apiObject.Key),
+		"size":          aws.Int64Value(apiObject.Size),
+		"storage_class": aws.StringValue(apiObject.StorageClass),
+	}
+
+	if v := apiObject.LastModified; v != nil {
+		tfMap["last_modified"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.Owner; v != nil {
+		tfMap["owner"] = aws.StringValue(v.ID)
+	}
+
+	return tfMap
+}
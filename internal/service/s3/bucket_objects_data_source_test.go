@@ -226,6 +226,92 @@ func TestAccS3BucketObjectsDataSource_fetchOwner(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketObjectsDataSource_suffix(t *testing.T) {
+	rInt := sdkacctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(t) },
+		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:                 acctest.Providers,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsResourcesDataSourceConfig(rInt), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccObjectsSuffixDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectsExistsDataSource("data.aws_s3_bucket_objects.yesh"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.#", "3"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.0", "arch/courthouse_towers/landscape"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.1", "arch/navajo/sand_dune"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.2", "arch/partition/park_avenue"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "objects.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketObjectsDataSource_keyRegex(t *testing.T) {
+	rInt := sdkacctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(t) },
+		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:                 acctest.Providers,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsResourcesDataSourceConfig(rInt), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccObjectsKeyRegexDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectsExistsDataSource("data.aws_s3_bucket_objects.yesh"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.0", "arch/navajo/north_window"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketObjectsDataSource_objects(t *testing.T) {
+	rInt := sdkacctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(t) },
+		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:                 acctest.Providers,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsDetailedResourceDataSourceConfig(rInt), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccObjectsObjectsDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectsExistsDataSource("data.aws_s3_bucket_objects.yesh"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "objects.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "objects.0.key", "release/app.zip"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "objects.0.size", "12"),
+					resource.TestCheckResourceAttrPair("data.aws_s3_bucket_objects.yesh", "objects.0.etag", "aws_s3_bucket_object.object8", "etag"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "objects.0.storage_class", "STANDARD"),
+					resource.TestCheckResourceAttrSet("data.aws_s3_bucket_objects.yesh", "objects.0.last_modified"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "objects.0.metadata.%", "1"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "objects.0.metadata.Version", "1.2.3"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "objects.0.tags.%", "1"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "objects.0.tags.Environment", "production"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckObjectsExistsDataSource(addr string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[addr]
@@ -401,3 +487,61 @@ data "aws_s3_bucket_objects" "yesh" {
 }
 `, testAccObjectsResourcesDataSourceConfig(randInt))
 }
+
+func testAccObjectsSuffixDataSourceConfig(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_bucket_objects" "yesh" {
+  bucket = aws_s3_bucket.objects_bucket.id
+  prefix = "arch/"
+  suffix = "e"
+}
+`, testAccObjectsResourcesDataSourceConfig(randInt))
+}
+
+func testAccObjectsKeyRegexDataSourceConfig(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_bucket_objects" "yesh" {
+  bucket    = aws_s3_bucket.objects_bucket.id
+  key_regex = "^arch/navajo/"
+  max_keys  = 1
+}
+`, testAccObjectsResourcesDataSourceConfig(randInt))
+}
+
+func testAccObjectsDetailedResourceDataSourceConfig(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_s3_bucket_object" "object8" {
+  bucket  = aws_s3_bucket.objects_bucket.id
+  key     = "release/app.zip"
+  content = "Landscape Ar"
+
+  metadata = {
+    version = "1.2.3"
+  }
+
+  tags = {
+    Environment = "production"
+  }
+}
+`, testAccObjectsResourcesDataSourceConfig(randInt))
+}
+
+func testAccObjectsObjectsDataSourceConfig(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_bucket_objects" "yesh" {
+  bucket         = aws_s3_bucket.objects_bucket.id
+  prefix         = "release/"
+  suffix         = ".zip"
+  fetch_metadata = true
+  fetch_tags     = true
+}
+`, testAccObjectsDetailedResourceDataSourceConfig(randInt))
+}
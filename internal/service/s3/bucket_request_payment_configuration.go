@@ -0,0 +1,141 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceBucketRequestPaymentConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketRequestPaymentConfigurationCreate,
+		Read:   resourceBucketRequestPaymentConfigurationRead,
+		Update: resourceBucketRequestPaymentConfigurationUpdate,
+		Delete: resourceBucketRequestPaymentConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"payer": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(s3.Payer_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceBucketRequestPaymentConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+
+	output, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.GetBucketRequestPayment(&s3.GetBucketRequestPaymentInput{
+			Bucket: aws.String(bucket),
+		})
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) request payment configuration: %w", bucket, err)
+	}
+
+	// Every bucket has a request payment configuration, which defaults to BucketOwner.
+	if payment, ok := output.(*s3.GetBucketRequestPaymentOutput); ok && payment != nil && aws.StringValue(payment.Payer) == s3.PayerRequester {
+		return bucketConfigurationExistsError(bucket, "request payment", "request_payer")
+	}
+
+	if err := putBucketRequestPaymentConfiguration(conn, bucket, d.Get("payer").(string)); err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) request payment configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketRequestPaymentConfigurationRead(d, meta)
+}
+
+func resourceBucketRequestPaymentConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	output, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.GetBucketRequestPayment(&s3.GetBucketRequestPaymentInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket Request Payment Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) request payment configuration: %w", d.Id(), err)
+	}
+
+	payment, ok := output.(*s3.GetBucketRequestPaymentOutput)
+
+	if !ok || payment == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) request payment configuration: empty output", d.Id())
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("payer", payment.Payer)
+
+	return nil
+}
+
+func resourceBucketRequestPaymentConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	if err := putBucketRequestPaymentConfiguration(conn, d.Id(), d.Get("payer").(string)); err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) request payment configuration: %w", d.Id(), err)
+	}
+
+	return resourceBucketRequestPaymentConfigurationRead(d, meta)
+}
+
+func resourceBucketRequestPaymentConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	// The request payment configuration cannot be removed, only reset to the default.
+	err := putBucketRequestPaymentConfiguration(conn, d.Id(), s3.PayerBucketOwner)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) request payment configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func putBucketRequestPaymentConfiguration(conn *s3.S3, bucket, payer string) error {
+	input := &s3.PutBucketRequestPaymentInput{
+		Bucket: aws.String(bucket),
+		RequestPaymentConfiguration: &s3.RequestPaymentConfiguration{
+			Payer: aws.String(payer),
+		},
+	}
+
+	_, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.PutBucketRequestPayment(input)
+	})
+
+	return err
+}
@@ -0,0 +1,203 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBucketServerSideEncryptionConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketServerSideEncryptionConfigurationCreate,
+		Read:   resourceBucketServerSideEncryptionConfigurationRead,
+		Update: resourceBucketServerSideEncryptionConfigurationUpdate,
+		Delete: resourceBucketServerSideEncryptionConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_server_side_encryption_by_default": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"kms_master_key_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"sse_algorithm": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.ServerSideEncryption_Values(), false),
+									},
+								},
+							},
+						},
+						"bucket_key_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBucketServerSideEncryptionConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+
+	output, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+			Bucket: aws.String(bucket),
+		})
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, ErrCodeServerSideEncryptionConfigurationNotFound) {
+		return fmt.Errorf("error reading S3 Bucket (%s) server-side encryption configuration: %w", bucket, err)
+	}
+
+	// New buckets are encrypted with SSE-S3 by default, which is also the configuration left after deletion.
+	if encryption, ok := output.(*s3.GetBucketEncryptionOutput); ok && encryption != nil && !isDefaultServerSideEncryptionConfiguration(encryption.ServerSideEncryptionConfiguration) {
+		return bucketConfigurationExistsError(bucket, "server-side encryption", "server_side_encryption_configuration")
+	}
+
+	if err := putBucketServerSideEncryptionConfiguration(conn, bucket, d.Get("rule").([]interface{})); err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) server-side encryption configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketServerSideEncryptionConfigurationRead(d, meta)
+}
+
+func resourceBucketServerSideEncryptionConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	output, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeServerSideEncryptionConfigurationNotFound, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket Server-side Encryption Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) server-side encryption configuration: %w", d.Id(), err)
+	}
+
+	encryption, ok := output.(*s3.GetBucketEncryptionOutput)
+
+	if !ok || encryption == nil || encryption.ServerSideEncryptionConfiguration == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) server-side encryption configuration: empty output", d.Id())
+	}
+
+	d.Set("bucket", d.Id())
+
+	if err := d.Set("rule", flattenServerSideEncryptionRules(encryption.ServerSideEncryptionConfiguration.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketServerSideEncryptionConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	if err := putBucketServerSideEncryptionConfiguration(conn, d.Id(), d.Get("rule").([]interface{})); err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) server-side encryption configuration: %w", d.Id(), err)
+	}
+
+	return resourceBucketServerSideEncryptionConfigurationRead(d, meta)
+}
+
+func resourceBucketServerSideEncryptionConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	_, err := conn.DeleteBucketEncryption(&s3.DeleteBucketEncryptionInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeServerSideEncryptionConfigurationNotFound, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) server-side encryption configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func putBucketServerSideEncryptionConfiguration(conn *s3.S3, bucket string, tfList []interface{}) error {
+	input := &s3.PutBucketEncryptionInput{
+		Bucket: aws.String(bucket),
+		ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+			Rules: expandServerSideEncryptionRules(tfList),
+		},
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(
+		propagationTimeout,
+		func() (interface{}, error) {
+			return conn.PutBucketEncryption(input)
+		},
+		s3.ErrCodeNoSuchBucket,
+		ErrCodeOperationAborted,
+	)
+
+	return err
+}
+
+// isDefaultServerSideEncryptionConfiguration returns whether a bucket server-side encryption
+// configuration is the S3 default, SSE-S3 without an S3 Bucket Key, or not set at all.
+func isDefaultServerSideEncryptionConfiguration(apiObject *s3.ServerSideEncryptionConfiguration) bool {
+	if apiObject == nil {
+		return true
+	}
+
+	for _, rule := range apiObject.Rules {
+		if rule == nil {
+			continue
+		}
+
+		if aws.BoolValue(rule.BucketKeyEnabled) {
+			return false
+		}
+
+		if v := rule.ApplyServerSideEncryptionByDefault; v != nil {
+			if aws.StringValue(v.SSEAlgorithm) != s3.ServerSideEncryptionAes256 || aws.StringValue(v.KMSMasterKeyID) != "" {
+				return false
+			}
+		}
+	}
+
+	return true
+}
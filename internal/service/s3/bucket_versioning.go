@@ -0,0 +1,173 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceBucketVersioning() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketVersioningCreate,
+		Read:   resourceBucketVersioningRead,
+		Update: resourceBucketVersioningUpdate,
+		Delete: resourceBucketVersioningDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"mfa_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceBucketVersioningCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+
+	output, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.GetBucketVersioning(&s3.GetBucketVersioningInput{
+			Bucket: aws.String(bucket),
+		})
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) versioning: %w", bucket, err)
+	}
+
+	// A bucket that has never had versioning configured has no status, and deletion leaves versioning suspended.
+	if versioning, ok := output.(*s3.GetBucketVersioningOutput); ok && versioning != nil && !isDefaultBucketVersioning(versioning) {
+		return bucketConfigurationExistsError(bucket, "versioning", "versioning")
+	}
+
+	if err := putBucketVersioning(conn, bucket, d.Get("enabled").(bool), d.Get("mfa_delete").(bool)); err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) versioning: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketVersioningRead(d, meta)
+}
+
+func resourceBucketVersioningRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	output, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.GetBucketVersioning(&s3.GetBucketVersioningInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket Versioning (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) versioning: %w", d.Id(), err)
+	}
+
+	versioning, ok := output.(*s3.GetBucketVersioningOutput)
+
+	if !ok || versioning == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) versioning: empty output", d.Id())
+	}
+
+	if !d.IsNewResource() && versioning.Status == nil {
+		log.Printf("[WARN] S3 Bucket Versioning (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("enabled", aws.StringValue(versioning.Status) == s3.BucketVersioningStatusEnabled)
+	d.Set("mfa_delete", aws.StringValue(versioning.MFADelete) == s3.MFADeleteStatusEnabled)
+
+	return nil
+}
+
+func resourceBucketVersioningUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	if err := putBucketVersioning(conn, d.Id(), d.Get("enabled").(bool), d.Get("mfa_delete").(bool)); err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) versioning: %w", d.Id(), err)
+	}
+
+	return resourceBucketVersioningRead(d, meta)
+}
+
+func resourceBucketVersioningDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	// Versioning cannot be removed from a bucket once enabled, only suspended.
+	err := putBucketVersioning(conn, d.Id(), false, false)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) versioning: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func putBucketVersioning(conn *s3.S3, bucket string, enabled, mfaDelete bool) error {
+	vc := &s3.VersioningConfiguration{
+		MFADelete: aws.String(s3.MFADeleteDisabled),
+		Status:    aws.String(s3.BucketVersioningStatusSuspended),
+	}
+
+	if enabled {
+		vc.Status = aws.String(s3.BucketVersioningStatusEnabled)
+	}
+
+	if mfaDelete {
+		vc.MFADelete = aws.String(s3.MFADeleteEnabled)
+	}
+
+	input := &s3.PutBucketVersioningInput{
+		Bucket:                  aws.String(bucket),
+		VersioningConfiguration: vc,
+	}
+
+	_, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.PutBucketVersioning(input)
+	})
+
+	return err
+}
+
+// isDefaultBucketVersioning returns whether a bucket versioning state is either unset or suspended without MFA delete.
+func isDefaultBucketVersioning(output *s3.GetBucketVersioningOutput) bool {
+	switch aws.StringValue(output.Status) {
+	case "", s3.BucketVersioningStatusSuspended:
+		return aws.StringValue(output.MFADelete) != s3.MFADeleteStatusEnabled
+	default:
+		return false
+	}
+}
@@ -0,0 +1,202 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceBucketWebsiteConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketWebsiteConfigurationCreate,
+		Read:   resourceBucketWebsiteConfigurationRead,
+		Update: resourceBucketWebsiteConfigurationUpdate,
+		Delete: resourceBucketWebsiteConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"error_document": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"redirect_all_requests_to"},
+			},
+			"index_document": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"index_document", "redirect_all_requests_to"},
+			},
+			"redirect_all_requests_to": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"error_document", "routing_rules"},
+				ExactlyOneOf:  []string{"index_document", "redirect_all_requests_to"},
+			},
+			"routing_rules": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"website_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"website_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBucketWebsiteConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+
+	_, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.GetBucketWebsite(&s3.GetBucketWebsiteInput{
+			Bucket: aws.String(bucket),
+		})
+	})
+
+	if err == nil {
+		return bucketConfigurationExistsError(bucket, "website", "website")
+	}
+
+	if !tfawserr.ErrCodeEquals(err, ErrCodeNoSuchWebsiteConfiguration) {
+		return fmt.Errorf("error reading S3 Bucket (%s) website configuration: %w", bucket, err)
+	}
+
+	if err := putBucketWebsiteConfiguration(conn, bucket, d); err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) website configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketWebsiteConfigurationRead(d, meta)
+}
+
+func resourceBucketWebsiteConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*conns.AWSClient)
+	conn := client.S3Conn()
+
+	output, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.GetBucketWebsite(&s3.GetBucketWebsiteInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeNoSuchWebsiteConfiguration, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket Website Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) website configuration: %w", d.Id(), err)
+	}
+
+	website, ok := output.(*s3.GetBucketWebsiteOutput)
+
+	if !ok || website == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) website configuration: empty output", d.Id())
+	}
+
+	tfMap, err := flattenBucketWebsiteConfiguration(website)
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) website configuration: %w", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+
+	for _, k := range []string{"error_document", "index_document", "redirect_all_requests_to", "routing_rules"} {
+		d.Set(k, tfMap[k])
+	}
+
+	location, err := conn.GetBucketLocation(&s3.GetBucketLocationInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) location: %w", d.Id(), err)
+	}
+
+	endpoint := WebsiteEndpoint(client, d.Id(), aws.StringValue(location.LocationConstraint))
+
+	d.Set("website_domain", endpoint.Domain)
+	d.Set("website_endpoint", endpoint.Endpoint)
+
+	return nil
+}
+
+func resourceBucketWebsiteConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	if err := putBucketWebsiteConfiguration(conn, d.Id(), d); err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) website configuration: %w", d.Id(), err)
+	}
+
+	return resourceBucketWebsiteConfigurationRead(d, meta)
+}
+
+func resourceBucketWebsiteConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	_, err := conn.DeleteBucketWebsite(&s3.DeleteBucketWebsiteInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeNoSuchWebsiteConfiguration, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) website configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func putBucketWebsiteConfiguration(conn *s3.S3, bucket string, d *schema.ResourceData) error {
+	websiteConfiguration, err := expandBucketWebsiteConfiguration(map[string]interface{}{
+		"error_document":           d.Get("error_document"),
+		"index_document":           d.Get("index_document"),
+		"redirect_all_requests_to": d.Get("redirect_all_requests_to"),
+		"routing_rules":            d.Get("routing_rules"),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	input := &s3.PutBucketWebsiteInput{
+		Bucket:               aws.String(bucket),
+		WebsiteConfiguration: websiteConfiguration,
+	}
+
+	_, err = retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.PutBucketWebsite(input)
+	})
+
+	return err
+}
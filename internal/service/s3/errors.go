@@ -1,10 +1,30 @@
 package s3
 
+import (
+	"fmt"
+)
+
 // Error code constants missing from AWS Go SDK:
 // https://docs.aws.amazon.com/sdk-for-go/api/service/s3/#pkg-constants
 
 const (
-	ErrCodeNoSuchConfiguration                  = "NoSuchConfiguration"
-	ErrCodeNoSuchPublicAccessBlockConfiguration = "NoSuchPublicAccessBlockConfiguration"
-	ErrCodeOperationAborted                     = "OperationAborted"
+	ErrCodeMethodNotAllowed                          = "MethodNotAllowed"
+	ErrCodeNoSuchConfiguration                       = "NoSuchConfiguration"
+	ErrCodeNoSuchCORSConfiguration                   = "NoSuchCORSConfiguration"
+	ErrCodeNoSuchLifecycleConfiguration              = "NoSuchLifecycleConfiguration"
+	ErrCodeNoSuchPublicAccessBlockConfiguration      = "NoSuchPublicAccessBlockConfiguration"
+	ErrCodeNoSuchWebsiteConfiguration                = "NoSuchWebsiteConfiguration"
+	ErrCodeObjectLockConfigurationNotFound           = "ObjectLockConfigurationNotFoundError"
+	ErrCodeOperationAborted                          = "OperationAborted"
+	ErrCodeServerSideEncryptionConfigurationNotFound = "ServerSideEncryptionConfigurationNotFoundError"
+	ErrCodeUnsupportedArgument                       = "UnsupportedArgument"
 )
+
+// bucketConfigurationExistsError returns the error for an attempt to create a standalone
+// bucket configuration resource for a bucket that already has that configuration,
+// typically set by the equivalent argument of the aws_s3_bucket resource.
+func bucketConfigurationExistsError(bucket, configuration, argument string) error {
+	return fmt.Errorf("S3 Bucket (%s) already has a %s configuration. To manage it with this resource, "+
+		"remove the %q argument from, and add it to the lifecycle ignore_changes of, the aws_s3_bucket resource "+
+		"and import the existing configuration", bucket, configuration, argument)
+}
@@ -46,7 +46,7 @@ func ResourceAccessPointPolicy() *schema.Resource {
 }
 
 func resourceAccessPointPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3ControlConn
+	conn := meta.(*conns.AWSClient).S3ControlConn()
 
 	resourceID, err := AccessPointCreateResourceID(d.Get("access_point_arn").(string))
 
@@ -79,7 +79,7 @@ func resourceAccessPointPolicyCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAccessPointPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3ControlConn
+	conn := meta.(*conns.AWSClient).S3ControlConn()
 
 	accountID, name, err := AccessPointParseResourceID(d.Id())
 
@@ -106,7 +106,7 @@ func resourceAccessPointPolicyRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAccessPointPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3ControlConn
+	conn := meta.(*conns.AWSClient).S3ControlConn()
 
 	accountID, name, err := AccessPointParseResourceID(d.Id())
 
@@ -131,7 +131,7 @@ func resourceAccessPointPolicyUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAccessPointPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3ControlConn
+	conn := meta.(*conns.AWSClient).S3ControlConn()
 
 	accountID, name, err := AccessPointParseResourceID(d.Id())
 
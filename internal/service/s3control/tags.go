@@ -44,15 +44,17 @@ func bucketListTags(conn *s3control.S3Control, identifier string) (tftags.KeyVal
 
 // bucketUpdateTags updates S3control bucket tags.
 // The identifier is the bucket ARN.
-func bucketUpdateTags(conn *s3control.S3Control, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+// Tags matching the key_regexes, case_insensitive_keys or key_value_pairs of the ignore
+// configuration, if any, are neither added nor removed.
+func bucketUpdateTags(conn *s3control.S3Control, identifier string, oldTagsMap interface{}, newTagsMap interface{}, ignoreConfig *tftags.IgnoreConfig) error {
 	parsedArn, err := arn.Parse(identifier)
 
 	if err != nil {
 		return fmt.Errorf("error parsing S3 Control Bucket ARN (%s): %w", identifier, err)
 	}
 
-	oldTags := tftags.New(oldTagsMap)
-	newTags := tftags.New(newTagsMap)
+	oldTags := tftags.New(oldTagsMap).IgnoreConfigMatchers(ignoreConfig)
+	newTags := tftags.New(newTagsMap).IgnoreConfigMatchers(ignoreConfig)
 
 	// We need to also consider any existing ignored tags.
 	allTags, err := bucketListTags(conn, identifier)
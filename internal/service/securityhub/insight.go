@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -144,7 +145,7 @@ func ResourceInsight() *schema.Resource {
 }
 
 func resourceInsightCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).SecurityHubConn
+	conn := meta.(*conns.AWSClient).SecurityHubConn()
 
 	name := d.Get("name").(string)
 
@@ -173,7 +174,7 @@ func resourceInsightCreate(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func resourceInsightRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).SecurityHubConn
+	conn := meta.(*conns.AWSClient).SecurityHubConn()
 
 	insight, err := FindInsight(ctx, conn, d.Id())
 
@@ -207,7 +208,7 @@ func resourceInsightRead(ctx context.Context, d *schema.ResourceData, meta inter
 }
 
 func resourceInsightUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).SecurityHubConn
+	conn := meta.(*conns.AWSClient).SecurityHubConn()
 
 	input := &securityhub.UpdateInsightInput{
 		InsightArn: aws.String(d.Id()),
@@ -235,7 +236,7 @@ func resourceInsightUpdate(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func resourceInsightDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).SecurityHubConn
+	conn := meta.(*conns.AWSClient).SecurityHubConn()
 
 	input := &securityhub.DeleteInsightInput{
 		InsightArn: aws.String(d.Id()),
@@ -357,19 +358,19 @@ func numberFilterSchema() *schema.Schema {
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"eq": {
-					Type:         schema.TypeString,
+					Type:         nullable.TypeNullableFloat,
 					Optional:     true,
-					ValidateFunc: verify.ValidTypeStringNullableFloat,
+					ValidateFunc: nullable.ValidateTypeStringNullableFloat,
 				},
 				"gte": {
-					Type:         schema.TypeString,
+					Type:         nullable.TypeNullableFloat,
 					Optional:     true,
-					ValidateFunc: verify.ValidTypeStringNullableFloat,
+					ValidateFunc: nullable.ValidateTypeStringNullableFloat,
 				},
 				"lte": {
-					Type:         schema.TypeString,
+					Type:         nullable.TypeNullableFloat,
 					Optional:     true,
-					ValidateFunc: verify.ValidTypeStringNullableFloat,
+					ValidateFunc: nullable.ValidateTypeStringNullableFloat,
 				},
 			},
 		},
@@ -926,25 +927,16 @@ func expandSecurityHubNumberFilters(l []interface{}) []*securityhub.NumberFilter
 
 		nf := &securityhub.NumberFilter{}
 
-		if v, ok := tfMap["eq"].(string); ok && v != "" {
-			val, err := strconv.ParseFloat(v, 64)
-			if err == nil {
-				nf.Eq = aws.Float64(val)
-			}
+		if v, null, _ := nullable.Float(tfMap["eq"].(string)).Value(); !null {
+			nf.Eq = aws.Float64(v)
 		}
 
-		if v, ok := tfMap["gte"].(string); ok && v != "" {
-			val, err := strconv.ParseFloat(v, 64)
-			if err == nil {
-				nf.Gte = aws.Float64(val)
-			}
+		if v, null, _ := nullable.Float(tfMap["gte"].(string)).Value(); !null {
+			nf.Gte = aws.Float64(v)
 		}
 
-		if v, ok := tfMap["lte"].(string); ok && v != "" {
-			val, err := strconv.ParseFloat(v, 64)
-			if err == nil {
-				nf.Lte = aws.Float64(val)
-			}
+		if v, null, _ := nullable.Float(tfMap["lte"].(string)).Value(); !null {
+			nf.Lte = aws.Float64(v)
 		}
 
 		numFilters = append(numFilters, nf)
@@ -73,7 +73,7 @@ func ResourceConstraint() *schema.Resource {
 }
 
 func resourceConstraintCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	input := &servicecatalog.CreateConstraintInput{
 		IdempotencyToken: aws.String(resource.UniqueId()),
@@ -130,7 +130,7 @@ func resourceConstraintCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceConstraintRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	output, err := WaitConstraintReady(conn, d.Get("accept_language").(string), d.Id())
 
@@ -171,7 +171,7 @@ func resourceConstraintRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceConstraintUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	input := &servicecatalog.UpdateConstraintInput{
 		Id: aws.String(d.Id()),
@@ -215,7 +215,7 @@ func resourceConstraintUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceConstraintDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	input := &servicecatalog.DeleteConstraintInput{
 		Id: aws.String(d.Id()),
@@ -84,7 +84,7 @@ func ResourcePlatformApplication() *schema.Resource {
 }
 
 func resourcePlatformApplicationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	attributes := make(map[string]*string)
 	name := d.Get("name").(string)
@@ -114,7 +114,7 @@ func resourcePlatformApplicationCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourcePlatformApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	attributes := make(map[string]*string)
 
@@ -194,7 +194,7 @@ func resourcePlatformApplicationUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourcePlatformApplicationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	// There is no SNS Describe/GetPlatformApplication to fetch attributes like name and platform
 	// We will use the ID, which should be a platform application ARN, to:
@@ -265,7 +265,7 @@ func resourcePlatformApplicationRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourcePlatformApplicationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	log.Printf("[DEBUG] SNS Delete Application: %s", d.Id())
 	_, err := conn.DeletePlatformApplication(&sns.DeletePlatformApplicationInput{
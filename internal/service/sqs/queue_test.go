@@ -62,6 +62,163 @@ func TestAccSQSQueue_basic(t *testing.T) {
 	})
 }
 
+func TestAccSQSQueue_requiredTags(t *testing.T) {
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	mock := acctest.NewMockEndpoints(t, conns.SQS)
+	requiredTags := `
+  default_tags {
+    tags = {
+      Environment = "test"
+    }
+  }
+
+  required_tags {
+    keys = ["Environment", "Owner"]
+
+    value_regexes = {
+      Owner = "^[a-z]+$"
+    }
+  }
+`
+
+	resource.Test(t, resource.TestCase{
+		ErrorCheck:   acctest.ErrorCheck(t, sqs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.ConfigCompose(mock.ConfigProvider(requiredTags), testAccNameConfig(rName)),
+				ExpectError: regexp.MustCompile(`aws_sqs_queue does not satisfy the provider required_tags configuration: missing required tag "Owner"`),
+			},
+			{
+				Config:      acctest.ConfigCompose(mock.ConfigProvider(requiredTags), testAccTags1Config(rName, "Owner", "Team1")),
+				ExpectError: regexp.MustCompile(`tag "Owner" value "Team1" does not match`),
+			},
+			{
+				Config: acctest.ConfigCompose(mock.ConfigProvider(requiredTags), testAccTags1Config(rName, "Owner", "team")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueExists(resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSQSQueue_ignoreTags(t *testing.T) {
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	mock := acctest.NewMockEndpoints(t, conns.SQS)
+	ignoreTags := func(status string) string {
+		return fmt.Sprintf(`
+  ignore_tags {
+    key_regexes           = ["^scanner:"]
+    case_insensitive_keys = ["owner"]
+
+    key_value_pairs = {
+      Status = %[1]q
+    }
+  }
+`, status)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ErrorCheck:   acctest.ErrorCheck(t, sqs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(mock.ConfigProvider(), testAccTags1Config(rName, "key1", "value1")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(resourceName, &queueAttributes),
+					testAccCheckQueueUpdateTags(resourceName, nil, map[string]string{
+						"scanner:id": "1",
+						"OWNER":      "team",
+						"Status":     "scanned",
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:   acctest.ConfigCompose(mock.ConfigProvider(ignoreTags("scanned")), testAccTags1Config(rName, "key1", "value1")),
+				PlanOnly: true,
+			},
+			{
+				Config:             acctest.ConfigCompose(mock.ConfigProvider(ignoreTags("unscanned")), testAccTags1Config(rName, "key1", "value1")),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSQSQueue_region(t *testing.T) {
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, sqs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueRegionConfig(rName, acctest.AlternateRegion()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueExists(resourceName, &queueAttributes),
+					acctest.CheckResourceAttrRegionalARNRegion(resourceName, "arn", "sqs", acctest.AlternateRegion(), rName),
+					resource.TestCheckResourceAttr(resourceName, "region", acctest.AlternateRegion()),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccQueueImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSQSQueue_mockEndpoints(t *testing.T) {
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	mock := acctest.NewMockEndpoints(t, conns.SQS)
+
+	resource.Test(t, resource.TestCase{
+		ErrorCheck:   acctest.ErrorCheck(t, sqs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(mock.ConfigProvider(), testAccNameConfig(rName)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueExists(resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "arn", mock.ARN("sqs", rName)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(mock.ConfigProvider(), testAccTags1Config(rName, "key1", "value1")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueExists(resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSQSQueue_disappears(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
@@ -729,9 +886,13 @@ func testAccCheckQueueExists(resourceName string, v *map[string]string) resource
 			return fmt.Errorf("No SQS Queue URL is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSConn
+		client, err := acctest.Provider.Meta().(*conns.AWSClient).RegionalClient(rs.Primary.Attributes["region"])
 
-		output, err := tfsqs.FindQueueAttributesByURL(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		output, err := tfsqs.FindQueueAttributesByURL(client.SQSConn(), rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -743,15 +904,43 @@ func testAccCheckQueueExists(resourceName string, v *map[string]string) resource
 	}
 }
 
-func testAccCheckQueueDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SQSConn
+func testAccCheckQueueUpdateTags(resourceName string, oldTags, newTags map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSConn()
+
+		return tfsqs.UpdateTags(conn, rs.Primary.ID, oldTags, newTags, nil)
+	}
+}
+
+func testAccQueueImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
 
+		return fmt.Sprintf("%s@%s", rs.Primary.ID, rs.Primary.Attributes["region"]), nil
+	}
+}
+
+func testAccCheckQueueDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sqs_queue" {
 			continue
 		}
 
-		_, err := tfsqs.FindQueueAttributesByURL(conn, rs.Primary.ID)
+		client, err := acctest.Provider.Meta().(*conns.AWSClient).RegionalClient(rs.Primary.Attributes["region"])
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfsqs.FindQueueAttributesByURL(client.SQSConn(), rs.Primary.ID)
 
 		if tfresource.NotFound(err) {
 			continue
@@ -777,6 +966,15 @@ resource "aws_sqs_queue" "test" {
 }
 `
 
+func testAccQueueRegionConfig(rName, region string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name   = %[1]q
+  region = %[2]q
+}
+`, rName, region)
+}
+
 func testAccNameConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {
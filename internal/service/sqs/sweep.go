@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sqs_queue", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sqs_queue", &resource.Sweeper{
 		Name: "aws_sqs_queue",
 		F:    sweepQueues,
 		Dependencies: []string{
@@ -36,7 +36,7 @@ func sweepQueues(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SQSConn
+	conn := client.(*conns.AWSClient).SQSConn()
 	input := &sqs.ListQueuesInput{}
 	var sweeperErrs *multierror.Error
 
@@ -137,7 +137,7 @@ func ResourceAssociation() *schema.Resource {
 }
 
 func resourceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSMConn
+	conn := meta.(*conns.AWSClient).SSMConn()
 
 	log.Printf("[DEBUG] SSM association create: %s", d.Id())
 
@@ -209,7 +209,7 @@ func resourceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSMConn
+	conn := meta.(*conns.AWSClient).SSMConn()
 
 	log.Printf("[DEBUG] Reading SSM Association: %s", d.Id())
 
@@ -259,7 +259,7 @@ func resourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSMConn
+	conn := meta.(*conns.AWSClient).SSMConn()
 
 	log.Printf("[DEBUG] SSM Association update: %s", d.Id())
 
@@ -321,7 +321,7 @@ func resourceAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSMConn
+	conn := meta.(*conns.AWSClient).SSMConn()
 
 	log.Printf("[DEBUG] Deleting SSM Association: %s", d.Id())
 
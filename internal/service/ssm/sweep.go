@@ -17,12 +17,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ssm_maintenance_window", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_maintenance_window", &resource.Sweeper{
 		Name: "aws_ssm_maintenance_window",
 		F:    sweepMaintenanceWindows,
 	})
 
-	resource.AddTestSweepers("aws_ssm_resource_data_sync", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_resource_data_sync", &resource.Sweeper{
 		Name: "aws_ssm_resource_data_sync",
 		F:    sweepResourceDataSyncs,
 	})
@@ -35,7 +35,7 @@ func sweepMaintenanceWindows(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).SSMConn
+	conn := client.(*conns.AWSClient).SSMConn()
 	input := &ssm.DescribeMaintenanceWindowsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -90,7 +90,7 @@ func sweepResourceDataSyncs(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).SSMConn
+	conn := client.(*conns.AWSClient).SSMConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -108,7 +108,10 @@ func sweepResourceDataSyncs(region string) error {
 			d.SetId(aws.StringValue(resourceDataSync.SyncName))
 			d.Set("name", resourceDataSync.SyncName)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResource := sweep.NewSweepResource(r, d, client)
+			sweepResource.CreationTime = aws.TimeValue(resourceDataSync.SyncCreatedTime)
+
+			sweepResources = append(sweepResources, sweepResource)
 		}
 
 		return !lastPage
@@ -253,7 +253,7 @@ func ResourceCanary() *schema.Resource {
 }
 
 func resourceCanaryCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SyntheticsConn
+	conn := meta.(*conns.AWSClient).SyntheticsConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -346,7 +346,7 @@ func resourceCanaryCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCanaryRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SyntheticsConn
+	conn := meta.(*conns.AWSClient).SyntheticsConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -416,7 +416,7 @@ func resourceCanaryRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCanaryUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SyntheticsConn
+	conn := meta.(*conns.AWSClient).SyntheticsConn()
 
 	if d.HasChangesExcept("tags", "tags_all", "start_canary") {
 		input := &synthetics.UpdateCanaryInput{
@@ -521,7 +521,7 @@ func resourceCanaryUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
-		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n, meta.(*conns.AWSClient).IgnoreTagsConfig); err != nil {
 			return fmt.Errorf("error updating Synthetics Canary (%s) tags: %w", d.Id(), err)
 		}
 	}
@@ -530,7 +530,7 @@ func resourceCanaryUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCanaryDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SyntheticsConn
+	conn := meta.(*conns.AWSClient).SyntheticsConn()
 
 	if status := d.Get("status").(string); status == synthetics.CanaryStateRunning {
 		if err := syntheticsStopCanary(d.Id(), conn); err != nil {
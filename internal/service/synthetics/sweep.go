@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_synthetics_canary", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_synthetics_canary", &resource.Sweeper{
 		Name: "aws_synthetics_canary",
 		F:    sweepCanaries,
 		Dependencies: []string{
@@ -32,7 +32,7 @@ func sweepCanaries(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).SyntheticsConn
+	conn := client.(*conns.AWSClient).SyntheticsConn()
 	input := &synthetics.DescribeCanariesInput{}
 	var sweeperErrs *multierror.Error
 
@@ -0,0 +1,97 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Filter restricts the resources which are swept.
+// Resources without the information needed to apply a filter, e.g. with unknown tags, are not swept.
+// Filters can only be applied by SweepOrchestrator, so sweepers which delete resources directly are
+// refused when a filter is set.
+type Filter struct {
+	MinAge     time.Duration
+	NamePrefix string
+	Tags       map[string]string
+
+	now func() time.Time
+}
+
+// NewFilter returns a filter for the name prefix, comma separated KEY=VALUE tags and minimum age,
+// or nil if there are none.
+func NewFilter(namePrefix, tags string, minAge time.Duration) (*Filter, error) {
+	if namePrefix == "" && tags == "" && minAge == 0 {
+		return nil, nil
+	}
+
+	filter := &Filter{
+		MinAge:     minAge,
+		NamePrefix: namePrefix,
+		Tags:       make(map[string]string),
+		now:        time.Now,
+	}
+
+	if tags != "" {
+		for _, pair := range strings.Split(tags, ",") {
+			parts := strings.SplitN(pair, "=", 2)
+
+			if len(parts) != 2 || parts[0] == "" {
+				return nil, fmt.Errorf("invalid tag filter (%s), expected KEY=VALUE", pair)
+			}
+
+			filter.Tags[parts[0]] = parts[1]
+		}
+	}
+
+	return filter, nil
+}
+
+// Match returns whether the resource is to be swept.
+func (f *Filter) Match(r *SweepResource) bool {
+	if f == nil {
+		return true
+	}
+
+	if f.NamePrefix != "" && !strings.HasPrefix(r.name(), f.NamePrefix) {
+		return false
+	}
+
+	for k, v := range f.Tags {
+		if value, ok := r.Tags[k]; !ok || value != v {
+			return false
+		}
+	}
+
+	if f.MinAge > 0 {
+		if r.CreationTime.IsZero() || f.now().Sub(r.CreationTime) < f.MinAge {
+			return false
+		}
+	}
+
+	return true
+}
+
+// needsRead returns whether the resource must be read to obtain information needed by the filter.
+func (f *Filter) needsRead(r *SweepResource) bool {
+	if f == nil {
+		return false
+	}
+
+	if f.NamePrefix != "" && r.name() == r.d.Id() {
+		return true
+	}
+
+	if len(f.Tags) > 0 && r.Tags == nil {
+		return true
+	}
+
+	if f.MinAge > 0 && r.CreationTime.IsZero() {
+		return true
+	}
+
+	return false
+}
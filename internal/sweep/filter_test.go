@@ -0,0 +1,196 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFilterMatch(t *testing.T) {
+	now := time.Now()
+	r := &schema.Resource{Schema: map[string]*schema.Schema{}}
+
+	newSweepResource := func(id, name string, tags map[string]string, creationTime time.Time) *SweepResource {
+		d := r.Data(nil)
+		d.SetId(id)
+
+		sweepResource := NewSweepResource(r, d, nil)
+		sweepResource.Name = name
+		sweepResource.Tags = tags
+		sweepResource.CreationTime = creationTime
+
+		return sweepResource
+	}
+
+	testCases := []struct {
+		Name       string
+		NamePrefix string
+		Tags       string
+		MinAge     time.Duration
+		Resource   *SweepResource
+		Expected   bool
+	}{
+		{
+			Name:     "no filter",
+			Resource: newSweepResource("id", "", nil, time.Time{}),
+			Expected: true,
+		},
+		{
+			Name:       "name prefix",
+			NamePrefix: "tf-acc-test",
+			Resource:   newSweepResource("id", "tf-acc-test-1", nil, time.Time{}),
+			Expected:   true,
+		},
+		{
+			Name:       "name prefix Name tag",
+			NamePrefix: "tf-acc-test",
+			Resource:   newSweepResource("id", "", map[string]string{"Name": "tf-acc-test-1"}, time.Time{}),
+			Expected:   true,
+		},
+		{
+			Name:       "name prefix ID",
+			NamePrefix: "tf-acc-test",
+			Resource:   newSweepResource("tf-acc-test-1", "", nil, time.Time{}),
+			Expected:   true,
+		},
+		{
+			Name:       "name prefix not matching",
+			NamePrefix: "tf-acc-test",
+			Resource:   newSweepResource("id", "production", nil, time.Time{}),
+			Expected:   false,
+		},
+		{
+			Name:     "tags",
+			Tags:     "Environment=test,Owner=ci",
+			Resource: newSweepResource("id", "", map[string]string{"Environment": "test", "Owner": "ci", "Other": "x"}, time.Time{}),
+			Expected: true,
+		},
+		{
+			Name:     "tags value not matching",
+			Tags:     "Environment=test",
+			Resource: newSweepResource("id", "", map[string]string{"Environment": "production"}, time.Time{}),
+			Expected: false,
+		},
+		{
+			Name:     "tags unknown",
+			Tags:     "Environment=test",
+			Resource: newSweepResource("id", "", nil, time.Time{}),
+			Expected: false,
+		},
+		{
+			Name:     "min age",
+			MinAge:   time.Hour,
+			Resource: newSweepResource("id", "", nil, now.Add(-2*time.Hour)),
+			Expected: true,
+		},
+		{
+			Name:     "min age too new",
+			MinAge:   time.Hour,
+			Resource: newSweepResource("id", "", nil, now.Add(-time.Minute)),
+			Expected: false,
+		},
+		{
+			Name:     "min age unknown",
+			MinAge:   time.Hour,
+			Resource: newSweepResource("id", "", nil, time.Time{}),
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			filter, err := NewFilter(testCase.NamePrefix, testCase.Tags, testCase.MinAge)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if filter != nil {
+				filter.now = func() time.Time { return now }
+			}
+
+			if got := filter.Match(testCase.Resource); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+
+	if _, err := NewFilter("", "Environment", 0); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestSweepResourceReadFilterInformation(t *testing.T) {
+	var reads int
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			reads++
+
+			if d.Id() == "gone" {
+				d.SetId("")
+				return nil
+			}
+
+			d.Set("creation_date", "2021-01-02T03:04:05Z")               //nolint:errcheck
+			d.Set("tags", map[string]interface{}{"Environment": "test"}) //nolint:errcheck
+
+			return nil
+		},
+	}
+
+	filter, err := NewFilter("", "Environment=test", time.Hour)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	d := r.Data(nil)
+	d.SetId("id")
+	sweepResource := NewSweepResource(r, d, nil)
+
+	if !sweepResource.readFilterInformation(filter) {
+		t.Fatal("expected resource to be read")
+	}
+
+	if got, want := sweepResource.Tags["Environment"], "test"; got != want {
+		t.Errorf("got Environment tag %q, expected %q", got, want)
+	}
+
+	if got, want := sweepResource.CreationTime, time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("got creation time %s, expected %s", got, want)
+	}
+
+	if !filter.Match(sweepResource) {
+		t.Error("expected resource to match")
+	}
+
+	// Information set by the sweeper is not read again.
+	reads = 0
+
+	if !sweepResource.readFilterInformation(filter) || reads != 0 {
+		t.Errorf("got %d reads, expected none", reads)
+	}
+
+	d = r.Data(nil)
+	d.SetId("gone")
+
+	if NewSweepResource(r, d, nil).readFilterInformation(filter) {
+		t.Error("expected resource not found")
+	}
+}
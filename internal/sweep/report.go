@@ -0,0 +1,344 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	SweeperStatusCompleted = "completed"
+	SweeperStatusFailed    = "failed"
+	SweeperStatusRefused   = "refused"
+	SweeperStatusResumed   = "resumed"
+	SweeperStatusSkipped   = "skipped"
+)
+
+const (
+	// ErrCodeDryRun is the code of the error returned by AWS API requests blocked during a dry run.
+	ErrCodeDryRun = "SweepDryRun"
+	// ErrCodeFiltered is the code of the error returned by AWS API requests blocked because a filter is set
+	// and they are not made by SweepOrchestrator.
+	ErrCodeFiltered = "SweepFiltered"
+)
+
+// readOnlyOperationPrefixes are the prefixes of AWS API operations which are sent during a dry run.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+}
+
+// Report is an inventory of the resources swept, or which would be swept during a dry run.
+type Report struct {
+	DryRun            bool               `json:"dry_run"`
+	Resources         []*ReportResource  `json:"resources"`
+	BlockedOperations []*ReportOperation `json:"blocked_operations,omitempty"`
+	Sweepers          []*ReportSweeper   `json:"sweepers"`
+
+	mutex sync.Mutex
+}
+
+// ReportResource is a resource swept by a sweeper.
+type ReportResource struct {
+	Region       string            `json:"region"`
+	Sweeper      string            `json:"sweeper"`
+	Service      string            `json:"service"`
+	ID           string            `json:"id"`
+	Name         string            `json:"name,omitempty"`
+	Tags         map[string]string `json:"tags,omitempty"`
+	CreationTime *time.Time        `json:"creation_time,omitempty"`
+}
+
+// ReportOperation is an AWS API request blocked during a dry run or, when a filter is set, outside SweepOrchestrator.
+// These are made by sweepers which delete resources directly rather than with SweepOrchestrator.
+type ReportOperation struct {
+	Region    string          `json:"region"`
+	Sweeper   string          `json:"sweeper"`
+	Service   string          `json:"service"`
+	Operation string          `json:"operation"`
+	Params    json.RawMessage `json:"params,omitempty"`
+}
+
+// ReportSweeper is the outcome of running a sweeper in a region.
+type ReportSweeper struct {
+	Region string `json:"region"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+func NewReport(dryRun bool) *Report {
+	return &Report{
+		DryRun: dryRun,
+	}
+}
+
+func (r *Report) addResource(run *sweeperRun, resource *SweepResource) {
+	v := &ReportResource{
+		Region:  run.region,
+		Sweeper: run.sweeper.Name,
+		Service: run.sweeper.service,
+		ID:      resource.d.Id(),
+		Name:    resource.name(),
+		Tags:    resource.Tags,
+	}
+
+	if v.Name == v.ID {
+		v.Name = ""
+	}
+
+	if !resource.CreationTime.IsZero() {
+		v.CreationTime = &resource.CreationTime
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.Resources = append(r.Resources, v)
+}
+
+func (r *Report) addSweeper(region, name, status string, err error) {
+	v := &ReportSweeper{
+		Region: region,
+		Name:   name,
+		Status: status,
+	}
+
+	if err != nil {
+		v.Error = err.Error()
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.Sweepers = append(r.Sweepers, v)
+}
+
+func (r *Report) failed() bool {
+	for _, v := range r.Sweepers {
+		if v.Status == SweeperStatusFailed || v.Status == SweeperStatusSkipped {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AddHandlers adds a handler which fails every AWS API request that is not read-only before it is sent,
+// recording it in the report, during a dry run or, when a filter is set, unless it is made by SweepOrchestrator.
+func (r *Report) AddHandlers(handlers *request.Handlers) {
+	handlers.Validate.PushBackNamed(request.NamedHandler{
+		Name: "tf-sweep-block",
+		Fn:   r.blockRequest,
+	})
+}
+
+func (r *Report) blockRequest(req *request.Request) {
+	if readOnlyOperation(req.Operation.Name) {
+		return
+	}
+
+	run := currentRun
+	code, message := ErrCodeDryRun, "request not sent during sweeper dry run"
+
+	if !r.DryRun {
+		if run == nil || run.filter == nil || run.orchestrating() {
+			return
+		}
+
+		code, message = ErrCodeFiltered, "request not sent as sweeper filters are only applied by SweepOrchestrator"
+		run.refuse()
+	}
+
+	v := &ReportOperation{
+		Service:   req.ClientInfo.ServiceID,
+		Operation: req.Operation.Name,
+	}
+
+	if run != nil {
+		v.Region = run.region
+		v.Sweeper = run.sweeper.Name
+	}
+
+	if b, err := json.Marshal(req.Params); err == nil {
+		v.Params = b
+	}
+
+	r.mutex.Lock()
+	r.BlockedOperations = append(r.BlockedOperations, v)
+	r.mutex.Unlock()
+
+	req.Error = awserr.New(code, fmt.Sprintf("%s %s %s", v.Service, v.Operation, message), nil)
+}
+
+func readOnlyOperation(name string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WriteFile writes the report as Markdown if the file name ends in ".md", otherwise as JSON.
+func (r *Report) WriteFile(path string) error {
+	f, err := os.Create(path)
+
+	if err != nil {
+		return fmt.Errorf("error creating sweep report (%s): %w", path, err)
+	}
+
+	if strings.HasSuffix(path, ".md") {
+		err = r.WriteMarkdown(f)
+	} else {
+		err = r.WriteJSON(f)
+	}
+
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("error writing sweep report (%s): %w", path, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error writing sweep report (%s): %w", path, err)
+	}
+
+	return nil
+}
+
+func (r *Report) WriteJSON(w io.Writer) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.sort()
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(r)
+}
+
+func (r *Report) WriteMarkdown(w io.Writer) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.sort()
+
+	var b strings.Builder
+
+	if r.DryRun {
+		b.WriteString("# Sweeper Dry Run\n\n## Resources That Would Be Deleted\n\n")
+	} else {
+		b.WriteString("# Sweeper Run\n\n## Deleted Resources\n\n")
+	}
+
+	if len(r.Resources) == 0 {
+		b.WriteString("None.\n")
+	} else {
+		b.WriteString("| Region | Sweeper | ID | Name | Tags | Created |\n")
+		b.WriteString("|---|---|---|---|---|---|\n")
+
+		for _, v := range r.Resources {
+			var created string
+			if v.CreationTime != nil {
+				created = v.CreationTime.UTC().Format(time.RFC3339)
+			}
+
+			fmt.Fprintf(&b, "| %s | %s | %s | %s | %s | %s |\n", v.Region, v.Sweeper, markdownEscape(v.ID), markdownEscape(v.Name), markdownEscape(formatTags(v.Tags)), created)
+		}
+	}
+
+	if len(r.BlockedOperations) > 0 {
+		if r.DryRun {
+			b.WriteString("\n## Blocked Requests\n\nThese sweepers delete resources without `SweepOrchestrator`, so their resources are not listed above.\n\n")
+		} else {
+			b.WriteString("\n## Blocked Requests\n\nThese sweepers delete resources without `SweepOrchestrator`, so were refused as they cannot apply the filters.\n\n")
+		}
+		b.WriteString("| Region | Sweeper | Service | Operation | Parameters |\n")
+		b.WriteString("|---|---|---|---|---|\n")
+
+		for _, v := range r.BlockedOperations {
+			fmt.Fprintf(&b, "| %s | %s | %s | %s | `%s` |\n", v.Region, v.Sweeper, v.Service, v.Operation, markdownEscape(string(v.Params)))
+		}
+	}
+
+	b.WriteString("\n## Sweepers\n\n")
+	b.WriteString("| Region | Sweeper | Status | Error |\n")
+	b.WriteString("|---|---|---|---|\n")
+
+	for _, v := range r.Sweepers {
+		fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", v.Region, v.Name, v.Status, markdownEscape(v.Error))
+	}
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+// sort orders resources and blocked requests, which are recorded concurrently.
+// Sweepers are left in the order in which they ran.
+func (r *Report) sort() {
+	sort.SliceStable(r.Resources, func(i, j int) bool {
+		a, b := r.Resources[i], r.Resources[j]
+
+		if a.Region != b.Region {
+			return a.Region < b.Region
+		}
+
+		if a.Sweeper != b.Sweeper {
+			return a.Sweeper < b.Sweeper
+		}
+
+		return a.ID < b.ID
+	})
+
+	sort.SliceStable(r.BlockedOperations, func(i, j int) bool {
+		a, b := r.BlockedOperations[i], r.BlockedOperations[j]
+
+		if a.Region != b.Region {
+			return a.Region < b.Region
+		}
+
+		return a.Sweeper < b.Sweeper
+	})
+}
+
+func formatTags(tags map[string]string) string {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+"="+tags[k])
+	}
+
+	return strings.Join(pairs, ", ")
+}
+
+func markdownEscape(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.ReplaceAll(s, "\n", " ")
+}
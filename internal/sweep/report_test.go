@@ -0,0 +1,252 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestReportAddHandlers(t *testing.T) {
+	var requests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+
+		w.Header().Set("Content-Type", "text/xml")
+		w.Write([]byte(`<ListQueuesResponse><ListQueuesResult><QueueUrl>http://example.com/q</QueueUrl></ListQueuesResult></ListQueuesResponse>`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("mock_access_key", "mock_secret_key", ""),
+		Endpoint:    aws.String(server.URL),
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	report := NewReport(true)
+	report.AddHandlers(&sess.Handlers)
+	conn := sqs.New(sess)
+
+	if _, err := conn.ListQueues(&sqs.ListQueuesInput{}); err != nil {
+		t.Fatalf("error listing queues: %s", err)
+	}
+
+	_, err = conn.DeleteQueue(&sqs.DeleteQueueInput{QueueUrl: aws.String("http://example.com/q")})
+
+	if !tfawserr.ErrCodeEquals(err, ErrCodeDryRun) {
+		t.Fatalf("got error %v, expected %s", err, ErrCodeDryRun)
+	}
+
+	if got, want := atomic.LoadInt32(&requests), int32(1); got != want {
+		t.Errorf("got %d requests, expected %d", got, want)
+	}
+
+	if got, want := len(report.BlockedOperations), 1; got != want {
+		t.Fatalf("got %d blocked operations, expected %d", got, want)
+	}
+
+	if got := report.BlockedOperations[0]; got.Operation != "DeleteQueue" || !strings.Contains(string(got.Params), "http://example.com/q") {
+		t.Errorf("unexpected blocked operation: %+v", got)
+	}
+}
+
+func TestSweepOrchestratorDryRun(t *testing.T) {
+	var deleted int32
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			atomic.AddInt32(&deleted, 1)
+			return nil
+		},
+	}
+
+	newSweepResource := func(id, name string, tags map[string]string) *SweepResource {
+		d := r.Data(nil)
+		d.SetId(id)
+		d.Set("name", name) //nolint:errcheck
+
+		sweepResource := NewSweepResource(r, d, nil)
+		sweepResource.Tags = tags
+
+		return sweepResource
+	}
+
+	filter, err := NewFilter("tf-acc-test", "", 0)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, dryRun := range []bool{true, false} {
+		deleted = 0
+		report := NewReport(dryRun)
+		currentRun = &sweeperRun{
+			concurrency: 1,
+			dryRun:      dryRun,
+			filter:      filter,
+			region:      "us-west-2", //lintignore:AWSAT003
+			report:      report,
+			sweeper:     &sweeper{Sweeper: &resource.Sweeper{Name: "aws_test"}, service: "test"},
+		}
+
+		err := SweepOrchestrator([]*SweepResource{
+			newSweepResource("1", "tf-acc-test-1", map[string]string{"Owner": "test"}),
+			newSweepResource("2", "production", nil),
+			newSweepResource("3", "tf-acc-test-3", nil),
+		})
+		currentRun = nil
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		expectedDeleted := int32(2)
+		if dryRun {
+			expectedDeleted = 0
+		}
+
+		if got := atomic.LoadInt32(&deleted); got != expectedDeleted {
+			t.Errorf("dry run %t: got %d deleted, expected %d", dryRun, got, expectedDeleted)
+		}
+
+		var b bytes.Buffer
+
+		if err := report.WriteJSON(&b); err != nil {
+			t.Fatalf("error writing report: %s", err)
+		}
+
+		var got Report
+
+		if err := json.Unmarshal(b.Bytes(), &got); err != nil {
+			t.Fatalf("error decoding report: %s", err)
+		}
+
+		if got, want := len(got.Resources), 2; got != want {
+			t.Fatalf("dry run %t: got %d resources, expected %d", dryRun, got, want)
+		}
+
+		if got := got.Resources[0]; got.ID != "1" || got.Name != "tf-acc-test-1" || got.Tags["Owner"] != "test" || got.Service != "test" {
+			t.Errorf("dry run %t: unexpected resource: %+v", dryRun, got)
+		}
+
+		b.Reset()
+
+		if err := report.WriteMarkdown(&b); err != nil {
+			t.Fatalf("error writing report: %s", err)
+		}
+
+		if got, want := b.String(), "| us-west-2 | aws_test | 3 | tf-acc-test-3 |  |  |\n"; !strings.Contains(got, want) { //lintignore:AWSAT003
+			t.Errorf("dry run %t: report\n%s\ndoes not contain\n%s", dryRun, got, want)
+		}
+	}
+}
+
+func TestSweepOrchestratorFiltered(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		w.Write([]byte(`<DeleteQueueResponse></DeleteQueueResponse>`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("mock_access_key", "mock_secret_key", ""),
+		Endpoint:    aws.String(server.URL),
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	report := NewReport(false)
+	report.AddHandlers(&sess.Handlers)
+	conn := sqs.New(sess)
+
+	deleteQueue := func(d *schema.ResourceData, meta interface{}) error {
+		_, err := conn.DeleteQueue(&sqs.DeleteQueueInput{QueueUrl: aws.String(d.Id())})
+		return err
+	}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		Delete: deleteQueue,
+	}
+
+	filter, err := NewFilter("http://example.com/tf-acc-test", "", 0)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	run := &sweeperRun{
+		filter:  filter,
+		region:  "us-west-2", //lintignore:AWSAT003
+		report:  report,
+		sweeper: &sweeper{Sweeper: &resource.Sweeper{Name: "aws_sqs_queue"}, service: "sqs"},
+	}
+	currentRun = run
+	defer func() { currentRun = nil }()
+
+	d := r.Data(nil)
+	d.SetId("http://example.com/tf-acc-test-1")
+
+	if err := SweepOrchestrator([]*SweepResource{NewSweepResource(r, d, nil)}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if run.refused() {
+		t.Fatal("expected SweepOrchestrator deletion to be sent")
+	}
+
+	// Deletions made directly by the sweeper are refused.
+	d = r.Data(nil)
+	d.SetId("http://example.com/production")
+
+	if err := deleteQueue(d, nil); !tfawserr.ErrCodeEquals(err, ErrCodeFiltered) {
+		t.Fatalf("got error %v, expected %s", err, ErrCodeFiltered)
+	}
+
+	if !run.refused() {
+		t.Error("expected sweeper to be refused")
+	}
+
+	if got, want := len(report.BlockedOperations), 1; got != want {
+		t.Fatalf("got %d blocked operations, expected %d", got, want)
+	}
+
+	if got := report.BlockedOperations[0]; got.Sweeper != "aws_sqs_queue" || !strings.Contains(string(got.Params), "production") {
+		t.Errorf("unexpected blocked operation: %+v", got)
+	}
+
+	// Without a filter, deletions made directly by the sweeper are sent.
+	run.filter = nil
+
+	if err := deleteQueue(d, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
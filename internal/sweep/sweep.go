@@ -59,24 +59,32 @@ func SharedRegionalSweepClient(region string) (interface{}, error) {
 	}
 
 	if role := os.Getenv(conns.EnvVarAssumeRoleARN); role != "" {
-		conf.AssumeRoleARN = role
+		assumeRole := &conns.AssumeRole{
+			RoleARN: role,
+		}
 
-		conf.AssumeRoleDurationSeconds = defaultSweeperAssumeRoleDurationSeconds
+		assumeRole.DurationSeconds = defaultSweeperAssumeRoleDurationSeconds
 		if v := os.Getenv(conns.EnvVarAssumeRoleDuration); v != "" {
 			d, err := strconv.Atoi(v)
 			if err != nil {
 				return nil, fmt.Errorf("environment variable %s: %w", conns.EnvVarAssumeRoleDuration, err)
 			}
-			conf.AssumeRoleDurationSeconds = d
+			assumeRole.DurationSeconds = d
 		}
 
 		if v := os.Getenv(conns.EnvVarAssumeRoleExternalID); v != "" {
-			conf.AssumeRoleExternalID = v
+			assumeRole.ExternalID = v
 		}
 
 		if v := os.Getenv(conns.EnvVarAssumeRoleSessionName); v != "" {
-			conf.AssumeRoleSessionName = v
+			assumeRole.SessionName = v
 		}
+
+		conf.AssumeRoles = []*conns.AssumeRole{assumeRole}
+	}
+
+	if run := currentRun; run != nil {
+		conf.RequestHandlers = run.report.AddHandlers
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -94,6 +102,24 @@ type SweepResource struct {
 	d        *schema.ResourceData
 	meta     interface{}
 	resource *schema.Resource
+
+	// Optional information used to filter the resources swept and included in the sweep report.
+	// Sweepers set these from the list or describe output where it includes them.
+	// Any that a filter needs but which are not set are read from the resource's state.
+	CreationTime time.Time
+	Name         string
+	Tags         map[string]string
+}
+
+// creationTimeAttributes are the names of attributes commonly used for a resource's creation time.
+var creationTimeAttributes = []string{
+	"create_date",
+	"create_time",
+	"created_at",
+	"created_date",
+	"created_time",
+	"creation_date",
+	"creation_time",
 }
 
 func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) *SweepResource {
@@ -104,17 +130,136 @@ func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta in
 	}
 }
 
+// name returns the resource's name, its Name tag or its ID, in order of preference.
+func (r *SweepResource) name() string {
+	if r.Name != "" {
+		return r.Name
+	}
+
+	if v, ok := r.Tags["Name"]; ok && v != "" {
+		return v
+	}
+
+	if _, ok := r.resource.Schema["name"]; ok {
+		if v, ok := r.d.Get("name").(string); ok && v != "" {
+			return v
+		}
+	}
+
+	return r.d.Id()
+}
+
+// readFilterInformation sets any name, tags or creation time needed by the filter but not set by the
+// sweeper from the resource's state, reading the resource first.
+// It returns false if the resource could not be read, in which case it is not swept.
+func (r *SweepResource) readFilterInformation(f *Filter) bool {
+	if !f.needsRead(r) {
+		return true
+	}
+
+	id := r.d.Id()
+
+	if err := readResource(r.resource, r.d, r.meta); err != nil {
+		log.Printf("[WARN] Error reading resource (%s) to apply sweeper filter: %s", id, err)
+		return false
+	}
+
+	if r.d.Id() == "" {
+		log.Printf("[DEBUG] Resource (%s) not found while applying sweeper filter", id)
+		return false
+	}
+
+	if r.Tags == nil {
+		r.Tags = tagsFromResourceData(r.resource, r.d)
+	}
+
+	if r.CreationTime.IsZero() {
+		r.CreationTime = creationTimeFromResourceData(r.resource, r.d)
+	}
+
+	return true
+}
+
+// tagsFromResourceData returns the resource's tags, or nil if it does not support tags.
+func tagsFromResourceData(resource *schema.Resource, d *schema.ResourceData) map[string]string {
+	for _, key := range []string{"tags_all", "tags"} {
+		if s, ok := resource.Schema[key]; !ok || s.Type != schema.TypeMap {
+			continue
+		}
+
+		tags := make(map[string]string)
+
+		for k, v := range d.Get(key).(map[string]interface{}) {
+			if v, ok := v.(string); ok {
+				tags[k] = v
+			}
+		}
+
+		return tags
+	}
+
+	return nil
+}
+
+// creationTimeFromResourceData returns the resource's creation time from an RFC3339 timestamp attribute,
+// or the zero time if there is none.
+func creationTimeFromResourceData(resource *schema.Resource, d *schema.ResourceData) time.Time {
+	for _, key := range creationTimeAttributes {
+		if s, ok := resource.Schema[key]; !ok || s.Type != schema.TypeString {
+			continue
+		}
+
+		if t, err := time.Parse(time.RFC3339, d.Get(key).(string)); err == nil {
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
 func SweepOrchestrator(sweepResources []*SweepResource) error {
 	return SweepOrchestratorContext(context.Background(), sweepResources, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, SweepThrottlingRetryTimeout)
 }
 
+// SweepOrchestratorContext deletes the resources concurrently, retrying throttled requests.
+// When run by TestMain, resources not matching any filter are skipped, the number of concurrent
+// deletions is limited per service and, during a dry run, resources are only recorded in the report.
+// Only deletions made here are allowed when a filter is set.
 func SweepOrchestratorContext(ctx context.Context, sweepResources []*SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
 	var g multierror.Group
+	var sem chan struct{}
+	run := currentRun
+
+	if run != nil {
+		if run.concurrency > 0 {
+			sem = make(chan struct{}, run.concurrency)
+		}
+
+		run.startOrchestrating()
+		defer run.stopOrchestrating()
+	}
 
 	for _, sweepResource := range sweepResources {
 		sweepResource := sweepResource
 
+		if run != nil {
+			if !sweepResource.readFilterInformation(run.filter) || !run.filter.Match(sweepResource) {
+				log.Printf("[DEBUG] Skipping resource (%s) not matching sweeper filter", sweepResource.d.Id())
+				continue
+			}
+
+			if run.dryRun {
+				run.report.addResource(run, sweepResource)
+				continue
+			}
+		}
+
 		g.Go(func() error {
+			if sem != nil {
+				sem <- struct{}{}
+				defer func() { <-sem }()
+			}
+
 			err := tfresource.RetryConfigContext(ctx, delay, delayRand, minTimeout, pollInterval, timeout, func() *resource.RetryError {
 				err := DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
 
@@ -134,6 +279,10 @@ func SweepOrchestratorContext(ctx context.Context, sweepResources []*SweepResour
 				err = DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
 			}
 
+			if err == nil && run != nil {
+				run.report.addResource(run, sweepResource)
+			}
+
 			return err
 		})
 	}
@@ -203,6 +352,32 @@ func SkipSweepError(err error) bool {
 	return false
 }
 
+func readResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
+	if resource.ReadContext != nil || resource.ReadWithoutTimeout != nil {
+		var diags diag.Diagnostics
+
+		if resource.ReadContext != nil {
+			diags = resource.ReadContext(context.Background(), d, meta)
+		} else {
+			diags = resource.ReadWithoutTimeout(context.Background(), d, meta)
+		}
+
+		for i := range diags {
+			if diags[i].Severity == diag.Error {
+				return fmt.Errorf("error reading resource: %s", diags[i].Summary)
+			}
+		}
+
+		return nil
+	}
+
+	if resource.Read == nil {
+		return nil
+	}
+
+	return resource.Read(d, meta)
+}
+
 func DeleteResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
 	if resource.DeleteContext != nil || resource.DeleteWithoutTimeout != nil {
 		var diags diag.Diagnostics
@@ -0,0 +1,460 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const DefaultSweepConcurrency = 10
+
+var (
+	flagSweepConcurrency        = flag.Int("sweep-concurrency", DefaultSweepConcurrency, "Maximum number of resources of a service to delete concurrently, 0 for no limit")
+	flagSweepDryRun             = flag.Bool("sweep-dry-run", false, "Report the resources that would be swept without deleting them")
+	flagSweepMinAge             = flag.Duration("sweep-min-age", 0, "Minimum age of resources to sweep")
+	flagSweepNamePrefix         = flag.String("sweep-name-prefix", "", "Name prefix of resources to sweep")
+	flagSweepReport             = flag.String("sweep-report", "", "File to write the sweep report to, as Markdown if the name ends in .md and JSON otherwise")
+	flagSweepServiceConcurrency = flag.String("sweep-service-concurrency", "", "Comma separated list of SERVICE=N overrides of -sweep-concurrency")
+	flagSweepState              = flag.String("sweep-state", "", "File recording completed sweepers, used to resume an interrupted sweep")
+	flagSweepTags               = flag.String("sweep-tags", "", "Comma separated list of KEY=VALUE tags that resources must have to be swept")
+)
+
+// sweeper is a registered sweeper and the service it belongs to.
+type sweeper struct {
+	*resource.Sweeper
+	service string
+}
+
+// sweepers are all registered sweepers, keyed by name.
+var sweepers = make(map[string]*sweeper)
+
+// currentRun is the sweeper currently being run by TestMain, if any.
+var currentRun *sweeperRun
+
+// sweeperRun contains the settings used while running a single sweeper in a region.
+type sweeperRun struct {
+	concurrency int
+	dryRun      bool
+	filter      *Filter
+	region      string
+	report      *Report
+	sweeper     *sweeper
+
+	orchestrations int32
+	refusals       int32
+}
+
+// errSweeperRefused is the reason recorded for a sweeper refused because it cannot apply the filters.
+var errSweeperRefused = errors.New("sweeper deletes resources without SweepOrchestrator, so cannot apply the sweeper filters")
+
+func (r *sweeperRun) startOrchestrating() {
+	atomic.AddInt32(&r.orchestrations, 1)
+}
+
+func (r *sweeperRun) stopOrchestrating() {
+	atomic.AddInt32(&r.orchestrations, -1)
+}
+
+// orchestrating returns whether SweepOrchestrator is running.
+func (r *sweeperRun) orchestrating() bool {
+	return atomic.LoadInt32(&r.orchestrations) > 0
+}
+
+// refuse records that a request was not sent because the sweeper cannot apply the filters.
+func (r *sweeperRun) refuse() {
+	atomic.AddInt32(&r.refusals, 1)
+}
+
+func (r *sweeperRun) refused() bool {
+	return atomic.LoadInt32(&r.refusals) > 0
+}
+
+// AddTestSweepers registers a sweeper with the acceptance testing framework
+// and with the dependency-aware sweeper run by TestMain.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	resource.AddTestSweepers(name, s)
+
+	sweepers[name] = &sweeper{
+		Sweeper: s,
+		service: serviceFromFunc(s.F),
+	}
+}
+
+// serviceFromFunc returns the name of the service package containing the function,
+// e.g. "ec2" for ".../internal/service/ec2.sweepSubnets".
+func serviceFromFunc(f interface{}) string {
+	if f == nil || reflect.ValueOf(f).IsNil() {
+		return ""
+	}
+
+	name := runtime.FuncForPC(reflect.ValueOf(f).Pointer()).Name()
+	name = name[strings.LastIndex(name, "/")+1:]
+
+	return strings.SplitN(name, ".", 2)[0]
+}
+
+// TestMain runs the registered sweepers if the -sweep flag is set, otherwise the tests.
+// Sweepers are run one at a time in dependency order, so that resources are deleted
+// before those they depend on.
+func TestMain(m interface {
+	Run() int
+}) {
+	flag.Parse()
+
+	regions := flag.Lookup("sweep").Value.String()
+
+	if regions == "" {
+		resource.TestMain(m)
+		return
+	}
+
+	allowFailures, _ := strconv.ParseBool(flag.Lookup("sweep-allow-failures").Value.String())
+
+	if err := runSweepers(strings.Split(regions, ","), flag.Lookup("sweep-run").Value.String(), allowFailures); err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+func runSweepers(regions []string, run string, allowFailures bool) error {
+	filter, err := NewFilter(*flagSweepNamePrefix, *flagSweepTags, *flagSweepMinAge)
+
+	if err != nil {
+		return err
+	}
+
+	serviceConcurrency, err := parseServiceConcurrency(*flagSweepServiceConcurrency)
+
+	if err != nil {
+		return err
+	}
+
+	order, err := sweeperOrder(sweepers, selectSweepers(sweepers, run))
+
+	if err != nil {
+		return err
+	}
+
+	state, err := readSweeperState(*flagSweepState)
+
+	if err != nil {
+		return err
+	}
+
+	report := NewReport(*flagSweepDryRun)
+
+	if *flagSweepDryRun {
+		// Sweepers that delete resources directly fail, so keep going.
+		allowFailures = true
+	}
+
+	var sweepErr error
+
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+		failed := make(map[string]bool)
+
+		log.Printf("[DEBUG] Running Sweepers for region (%s)", region)
+
+		for _, name := range order {
+			s := sweepers[name]
+
+			if !report.DryRun && state.completed(region, name) {
+				log.Printf("[DEBUG] Sweeper (%s) already completed in region (%s)", name, region)
+				report.addSweeper(region, name, SweeperStatusResumed, nil)
+				continue
+			}
+
+			if dependency := failedDependency(s, failed); dependency != "" {
+				failed[name] = true
+				report.addSweeper(region, name, SweeperStatusSkipped, fmt.Errorf("dependency (%s) failed", dependency))
+				continue
+			}
+
+			concurrency := *flagSweepConcurrency
+			if v, ok := serviceConcurrency[s.service]; ok {
+				concurrency = v
+			}
+
+			run := &sweeperRun{
+				concurrency: concurrency,
+				dryRun:      report.DryRun,
+				filter:      filter,
+				region:      region,
+				report:      report,
+				sweeper:     s,
+			}
+			currentRun = run
+
+			log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+			start := time.Now()
+			err := s.F(region)
+			currentRun = nil
+
+			// Sweepers refused because of the filters are neither failed nor completed,
+			// so that they are run again by an unfiltered sweep.
+			if !report.DryRun && run.refused() {
+				log.Printf("[WARN] Sweeper (%s) in region (%s) refused: %s", name, region, errSweeperRefused)
+				report.addSweeper(region, name, SweeperStatusRefused, errSweeperRefused)
+				continue
+			}
+
+			if err != nil {
+				log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", name, region, err)
+				failed[name] = true
+				report.addSweeper(region, name, SweeperStatusFailed, err)
+
+				if !allowFailures {
+					sweepErr = fmt.Errorf("sweeper (%s) for region (%s) failed: %w", name, region, err)
+					break
+				}
+
+				continue
+			}
+
+			log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", name, region, time.Since(start))
+			report.addSweeper(region, name, SweeperStatusCompleted, nil)
+
+			if !report.DryRun {
+				if err := state.complete(region, name); err != nil {
+					return err
+				}
+			}
+		}
+
+		if sweepErr != nil {
+			break
+		}
+	}
+
+	if *flagSweepReport != "" {
+		if err := report.WriteFile(*flagSweepReport); err != nil {
+			return err
+		}
+	} else if report.DryRun {
+		if err := report.WriteMarkdown(os.Stdout); err != nil {
+			return err
+		}
+	}
+
+	if sweepErr != nil {
+		return sweepErr
+	}
+
+	if !report.DryRun && report.failed() {
+		return errors.New("at least one sweeper failed")
+	}
+
+	return nil
+}
+
+// selectSweepers returns the names of the sweepers whose names contain any of the comma separated
+// values, ignoring case, or of all sweepers if there are none.
+func selectSweepers(sweepers map[string]*sweeper, run string) []string {
+	var names []string
+
+	for name := range sweepers {
+		if run == "" {
+			names = append(names, name)
+			continue
+		}
+
+		for _, v := range strings.Split(strings.ToLower(run), ",") {
+			if strings.Contains(strings.ToLower(name), v) {
+				names = append(names, name)
+				break
+			}
+		}
+	}
+
+	return names
+}
+
+// sweeperOrder returns the names of the sweepers and all of their dependencies,
+// each after all of the sweepers it depends on.
+// Dependencies which are not registered are ignored.
+func sweeperOrder(sweepers map[string]*sweeper, names []string) ([]string, error) {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+
+	var order []string
+	state := make(map[string]int)
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		s, ok := sweepers[name]
+
+		if !ok {
+			log.Printf("[WARN] Sweeper (%s) has dependency (%s), but that sweeper was not found", path[len(path)-1], name)
+			return nil
+		}
+
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("sweeper dependency cycle: %s", strings.Join(append(path, name), " -> "))
+		case visited:
+			return nil
+		}
+
+		state[name] = visiting
+
+		dependencies := make([]string, len(s.Dependencies))
+		copy(dependencies, s.Dependencies)
+		sort.Strings(dependencies)
+
+		for _, dependency := range dependencies {
+			if err := visit(dependency, append(path, name)); err != nil {
+				return err
+			}
+		}
+
+		state[name] = visited
+		order = append(order, name)
+
+		return nil
+	}
+
+	sorted := make([]string, len(names))
+	copy(sorted, names)
+	sort.Strings(sorted)
+
+	for _, name := range sorted {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
+
+// failedDependency returns the name of a dependency of the sweeper which failed, if any.
+func failedDependency(s *sweeper, failed map[string]bool) string {
+	for _, dependency := range s.Dependencies {
+		if failed[dependency] {
+			return dependency
+		}
+	}
+
+	return ""
+}
+
+// parseServiceConcurrency parses a comma separated list of SERVICE=N values.
+func parseServiceConcurrency(v string) (map[string]int, error) {
+	result := make(map[string]int)
+
+	if v == "" {
+		return result, nil
+	}
+
+	for _, pair := range strings.Split(v, ",") {
+		parts := strings.SplitN(pair, "=", 2)
+
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid service concurrency (%s), expected SERVICE=N", pair)
+		}
+
+		n, err := strconv.Atoi(parts[1])
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid service concurrency (%s): %w", pair, err)
+		}
+
+		result[strings.TrimSpace(parts[0])] = n
+	}
+
+	return result, nil
+}
+
+// sweeperState records the sweepers completed in each region, so that an interrupted
+// or failed sweep can be resumed without repeating them.
+type sweeperState struct {
+	path string
+
+	Regions map[string][]string `json:"regions"`
+}
+
+func readSweeperState(path string) (*sweeperState, error) {
+	state := &sweeperState{
+		path:    path,
+		Regions: make(map[string][]string),
+	}
+
+	if path == "" {
+		return state, nil
+	}
+
+	b, err := ioutil.ReadFile(path)
+
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading sweeper state (%s): %w", path, err)
+	}
+
+	if err := json.Unmarshal(b, state); err != nil {
+		return nil, fmt.Errorf("error decoding sweeper state (%s): %w", path, err)
+	}
+
+	return state, nil
+}
+
+func (s *sweeperState) completed(region, name string) bool {
+	for _, v := range s.Regions[region] {
+		if v == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// complete records the sweeper as completed in the region, writing the state file if any.
+func (s *sweeperState) complete(region, name string) error {
+	s.Regions[region] = append(s.Regions[region], name)
+
+	if s.path == "" {
+		return nil
+	}
+
+	b, err := json.MarshalIndent(s, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("error encoding sweeper state: %w", err)
+	}
+
+	// Write then rename so that an interruption does not leave a partial file.
+	tmp := filepath.Join(filepath.Dir(s.path), "."+filepath.Base(s.path)+".tmp")
+
+	if err := ioutil.WriteFile(tmp, b, 0600); err != nil {
+		return fmt.Errorf("error writing sweeper state (%s): %w", tmp, err)
+	}
+
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("error writing sweeper state (%s): %w", s.path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"errors"
+	"flag"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestSweeperOrder(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Sweepers      map[string][]string
+		Names         []string
+		Expected      []string
+		ExpectedError string
+	}{
+		{
+			Name: "dependencies first",
+			Sweepers: map[string][]string{
+				"aws_vpc":               {"aws_subnet", "aws_internet_gateway"},
+				"aws_subnet":            {"aws_network_interface"},
+				"aws_internet_gateway":  nil,
+				"aws_network_interface": {"aws_instance"},
+				"aws_instance":          nil,
+			},
+			Names:    []string{"aws_vpc"},
+			Expected: []string{"aws_internet_gateway", "aws_instance", "aws_network_interface", "aws_subnet", "aws_vpc"},
+		},
+		{
+			Name: "shared dependency",
+			Sweepers: map[string][]string{
+				"aws_cloudwatch_event_bus":    {"aws_cloudwatch_event_rule", "aws_cloudwatch_event_target"},
+				"aws_cloudwatch_event_rule":   {"aws_cloudwatch_event_target"},
+				"aws_cloudwatch_event_target": nil,
+			},
+			Names:    []string{"aws_cloudwatch_event_rule", "aws_cloudwatch_event_bus"},
+			Expected: []string{"aws_cloudwatch_event_target", "aws_cloudwatch_event_rule", "aws_cloudwatch_event_bus"},
+		},
+		{
+			Name: "unregistered dependency",
+			Sweepers: map[string][]string{
+				"aws_subnet": {"aws_not_registered"},
+			},
+			Names:    []string{"aws_subnet"},
+			Expected: []string{"aws_subnet"},
+		},
+		{
+			Name: "cycle",
+			Sweepers: map[string][]string{
+				"a": {"b"},
+				"b": {"c"},
+				"c": {"a"},
+			},
+			Names:         []string{"a"},
+			ExpectedError: "sweeper dependency cycle: a -> b -> c -> a",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			sweepers := make(map[string]*sweeper)
+
+			for name, dependencies := range testCase.Sweepers {
+				sweepers[name] = &sweeper{Sweeper: &resource.Sweeper{Name: name, Dependencies: dependencies}}
+			}
+
+			got, err := sweeperOrder(sweepers, testCase.Names)
+
+			if testCase.ExpectedError != "" {
+				if err == nil || err.Error() != testCase.ExpectedError {
+					t.Fatalf("got error %v, expected %s", err, testCase.ExpectedError)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestServiceFromFunc(t *testing.T) {
+	if got, want := serviceFromFunc(resource.SweeperFunc(testSweeperFunc)), "sweep"; got != want {
+		t.Errorf("got %s, expected %s", got, want)
+	}
+
+	if got := serviceFromFunc(resource.SweeperFunc(nil)); got != "" {
+		t.Errorf("got %s, expected no service", got)
+	}
+}
+
+func testSweeperFunc(string) error {
+	return nil
+}
+
+func TestRunSweepersResume(t *testing.T) {
+	var calls []string
+
+	sweeperFunc := func(name string, err error) func(string) error {
+		return func(region string) error {
+			calls = append(calls, region+":"+name)
+			return err
+		}
+	}
+
+	testSweepers(t, map[string]*sweeper{
+		"aws_vpc": {Sweeper: &resource.Sweeper{
+			Name:         "aws_vpc",
+			Dependencies: []string{"aws_subnet"},
+			F:            sweeperFunc("aws_vpc", nil),
+		}},
+		"aws_subnet": {Sweeper: &resource.Sweeper{
+			Name:         "aws_subnet",
+			Dependencies: []string{"aws_network_interface"},
+			F:            sweeperFunc("aws_subnet", errors.New("DependencyViolation")),
+		}},
+		"aws_network_interface": {Sweeper: &resource.Sweeper{
+			Name: "aws_network_interface",
+			F:    sweeperFunc("aws_network_interface", nil),
+		}},
+	})
+
+	testSetFlag(t, "sweep-state", filepath.Join(t.TempDir(), "state.json"))
+
+	if err := runSweepers([]string{"us-west-2"}, "", true); err == nil { //lintignore:AWSAT003
+		t.Fatal("expected error")
+	}
+
+	// The VPC sweeper is skipped as the subnet sweeper it depends on failed.
+	if got, want := strings.Join(calls, ","), "us-west-2:aws_network_interface,us-west-2:aws_subnet"; got != want {
+		t.Errorf("got calls %s, expected %s", got, want)
+	}
+
+	calls = nil
+	sweepers["aws_subnet"].F = sweeperFunc("aws_subnet", nil)
+
+	if err := runSweepers([]string{"us-west-2"}, "", true); err != nil { //lintignore:AWSAT003
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Completed sweepers are not run again.
+	if got, want := strings.Join(calls, ","), "us-west-2:aws_subnet,us-west-2:aws_vpc"; got != want {
+		t.Errorf("got calls %s, expected %s", got, want)
+	}
+}
+
+func TestParseServiceConcurrency(t *testing.T) {
+	got, err := parseServiceConcurrency("ec2=2, iam=1")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := map[string]int{"ec2": 2, "iam": 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, expected %v", got, want)
+	}
+
+	if _, err := parseServiceConcurrency("ec2"); err == nil {
+		t.Error("expected error")
+	}
+}
+
+// testSweepers replaces the registered sweepers for the duration of the test.
+func testSweepers(t *testing.T, v map[string]*sweeper) {
+	t.Helper()
+
+	registered := sweepers
+	sweepers = v
+	t.Cleanup(func() { sweepers = registered })
+}
+
+// testSetFlag sets a flag for the duration of the test.
+func testSetFlag(t *testing.T, name, value string) {
+	t.Helper()
+
+	f := flag.Lookup(name)
+	previous := f.Value.String()
+
+	if err := f.Value.Set(value); err != nil {
+		t.Fatalf("error setting flag %s: %s", name, err)
+	}
+
+	t.Cleanup(func() { f.Value.Set(previous) }) //nolint:errcheck
+}
@@ -26,8 +26,17 @@ type DefaultConfig struct {
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
-	Keys        KeyValueTags
-	KeyPrefixes KeyValueTags
+	Keys                KeyValueTags
+	KeyPrefixes         KeyValueTags
+	KeyRegexes          []*regexp.Regexp
+	CaseInsensitiveKeys KeyValueTags
+	KeyValuePairs       KeyValueTags
+}
+
+// RequiredConfig contains tags that must be present on all resources.
+type RequiredConfig struct {
+	Keys         KeyValueTags
+	ValueRegexes map[string]*regexp.Regexp
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -96,10 +105,59 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
 	result = result.Ignore(config.Keys)
+	result = result.IgnoreKeyRegexes(config.KeyRegexes)
+	result = result.IgnoreCaseInsensitive(config.CaseInsensitiveKeys)
+	result = result.IgnoreKeyValuePairs(config.KeyValuePairs)
+
+	return result
+}
+
+// IgnoreConfigMatchers returns any tags not removed by the key_regexes, case_insensitive_keys
+// and key_value_pairs matchers of a given configuration.
+// Unlike IgnoreConfig, tags matching the configuration's keys and key_prefixes are kept, so that
+// tags configured under those keys continue to be written to the resource.
+func (tags KeyValueTags) IgnoreConfigMatchers(config *IgnoreConfig) KeyValueTags {
+	if config == nil {
+		return tags
+	}
+
+	result := tags.IgnoreKeyRegexes(config.KeyRegexes)
+	result = result.IgnoreCaseInsensitive(config.CaseInsensitiveKeys)
+	result = result.IgnoreKeyValuePairs(config.KeyValuePairs)
 
 	return result
 }
 
+// Violations returns a description of each way in which the given tags do not satisfy the configuration,
+// in a stable order. Tags with a nil value, e.g. one not known until apply, are not checked against ValueRegexes.
+func (rc *RequiredConfig) Violations(tags KeyValueTags) []string {
+	if rc == nil {
+		return nil
+	}
+
+	var violations []string
+
+	for _, k := range rc.Keys.Keys() {
+		if !tags.KeyExists(k) {
+			violations = append(violations, fmt.Sprintf("missing required tag %q", k))
+		}
+	}
+
+	for k, re := range rc.ValueRegexes {
+		v := tags.KeyValue(k)
+
+		if v == nil || re.MatchString(*v) {
+			continue
+		}
+
+		violations = append(violations, fmt.Sprintf("tag %q value %q does not match %q", k, *v, re.String()))
+	}
+
+	sort.Strings(violations)
+
+	return violations
+}
+
 // IgnoreElasticbeanstalk returns non-AWS and non-Elasticbeanstalk tag keys.
 func (tags KeyValueTags) IgnoreElasticbeanstalk() KeyValueTags {
 	result := make(KeyValueTags)
@@ -147,6 +205,70 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnoreKeyRegexes returns tag keys not matching any of the regular expressions.
+func (tags KeyValueTags) IgnoreKeyRegexes(ignoreTagKeyRegexes []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for _, re := range ignoreTagKeyRegexes {
+			if re.MatchString(k) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreCaseInsensitive returns tag keys not matching, ignoring case.
+func (tags KeyValueTags) IgnoreCaseInsensitive(ignoreTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for ignoreTag := range ignoreTags {
+			if strings.EqualFold(k, ignoreTag) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreKeyValuePairs returns tags not matching both key and value.
+// Tags with a nil value, e.g. one not known until apply, are never ignored.
+func (tags KeyValueTags) IgnoreKeyValuePairs(ignoreTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if ignoreValue, value := ignoreTags.KeyValue(k), tags.KeyValue(k); ignoreValue != nil && value != nil && *value == *ignoreValue {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreRDS returns non-AWS and non-RDS tag keys.
 func (tags KeyValueTags) IgnoreRds() KeyValueTags {
 	result := make(KeyValueTags)
@@ -1,6 +1,8 @@
 package tags
 
 import (
+	"reflect"
+	"regexp"
 	"testing"
 )
 
@@ -533,6 +535,79 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key regexes some matching",
+			tags: New(map[string]string{
+				"key1":      "value1",
+				"key2":      "value2",
+				"scanner:1": "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyRegexes: []*regexp.Regexp{
+					regexp.MustCompile(`^scanner:`),
+					regexp.MustCompile(`2$`),
+				},
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "case insensitive keys some matching",
+			tags: New(map[string]string{
+				"KEY1": "value1",
+				"Key2": "value2",
+				"key3": "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				CaseInsensitiveKeys: New([]string{
+					"key1",
+					"key2",
+				}),
+			},
+			want: map[string]string{
+				"key3": "value3",
+			},
+		},
+		{
+			name: "key value pairs some matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyValuePairs: New(map[string]string{
+					"key1": "value1",
+					"key2": "value3",
+				}),
+			},
+			want: map[string]string{
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "all options",
+			tags: New(map[string]string{
+				"key1":      "value1",
+				"KEY2":      "value2",
+				"key3":      "value3",
+				"prefix1":   "value4",
+				"scanner:1": "value5",
+				"key6":      "value6",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys:                New([]string{"key1"}),
+				KeyPrefixes:         New([]string{"prefix"}),
+				KeyRegexes:          []*regexp.Regexp{regexp.MustCompile(`^scanner:`)},
+				CaseInsensitiveKeys: New([]string{"key2"}),
+				KeyValuePairs:       New(map[string]string{"key3": "value3"}),
+			},
+			want: map[string]string{
+				"key6": "value6",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -544,6 +619,191 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsIgnoreConfigMatchers(t *testing.T) {
+	testCases := []struct {
+		name         string
+		tags         KeyValueTags
+		ignoreConfig *IgnoreConfig
+		want         map[string]string
+	}{
+		{
+			name: "no config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			ignoreConfig: nil,
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "configured tags with ignored keys and key prefixes",
+			tags: New(map[string]string{
+				"key1":    "value1",
+				"prefix1": "value2",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New([]string{"key1"}),
+				KeyPrefixes: New([]string{"prefix"}),
+			},
+			want: map[string]string{
+				"key1":    "value1",
+				"prefix1": "value2",
+			},
+		},
+		{
+			name: "all options",
+			tags: New(map[string]string{
+				"key1":      "value1",
+				"KEY2":      "value2",
+				"key3":      "value3",
+				"prefix1":   "value4",
+				"scanner:1": "value5",
+				"key6":      "value6",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys:                New([]string{"key1"}),
+				KeyPrefixes:         New([]string{"prefix"}),
+				KeyRegexes:          []*regexp.Regexp{regexp.MustCompile(`^scanner:`)},
+				CaseInsensitiveKeys: New([]string{"key2"}),
+				KeyValuePairs:       New(map[string]string{"key3": "value3"}),
+			},
+			want: map[string]string{
+				"key1":    "value1",
+				"prefix1": "value4",
+				"key6":    "value6",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreConfigMatchers(testCase.ignoreConfig)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+// A tag configured under an ignored key is still written by UpdateTags, as before key_regexes,
+// case_insensitive_keys and key_value_pairs were added.
+func TestKeyValueTagsIgnoreConfigMatchersUpdated(t *testing.T) {
+	ignoreConfig := &IgnoreConfig{
+		Keys:       New([]string{"key1"}),
+		KeyRegexes: []*regexp.Regexp{regexp.MustCompile(`^scanner:`)},
+	}
+	oldTags := New(map[string]string{
+		"scanner:1": "value1",
+	}).IgnoreConfigMatchers(ignoreConfig)
+	newTags := New(map[string]string{
+		"key1":      "value1",
+		"scanner:1": "value2",
+	}).IgnoreConfigMatchers(ignoreConfig)
+
+	testKeyValueTagsVerifyMap(t, oldTags.Removed(newTags).Map(), map[string]string{})
+	testKeyValueTagsVerifyMap(t, oldTags.Updated(newTags).Map(), map[string]string{
+		"key1": "value1",
+	})
+}
+
+func TestRequiredConfigViolations(t *testing.T) {
+	testCases := []struct {
+		name           string
+		tags           KeyValueTags
+		requiredConfig *RequiredConfig
+		want           []string
+	}{
+		{
+			name:           "no config",
+			tags:           New(map[string]string{}),
+			requiredConfig: nil,
+			want:           nil,
+		},
+		{
+			name: "all present",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			requiredConfig: &RequiredConfig{
+				Keys: New([]string{
+					"key1",
+					"key2",
+				}),
+			},
+			want: nil,
+		},
+		{
+			name: "missing keys",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			requiredConfig: &RequiredConfig{
+				Keys: New([]string{
+					"key1",
+					"key2",
+					"key3",
+				}),
+			},
+			want: []string{
+				`missing required tag "key2"`,
+				`missing required tag "key3"`,
+			},
+		},
+		{
+			name: "value not matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "VALUE2",
+			}),
+			requiredConfig: &RequiredConfig{
+				ValueRegexes: map[string]*regexp.Regexp{
+					"key1": regexp.MustCompile(`^value\d$`),
+					"key2": regexp.MustCompile(`^value\d$`),
+				},
+			},
+			want: []string{
+				`tag "key2" value "VALUE2" does not match "^value\\d$"`,
+			},
+		},
+		{
+			name: "value regex for absent optional key",
+			tags: New(map[string]string{}),
+			requiredConfig: &RequiredConfig{
+				ValueRegexes: map[string]*regexp.Regexp{
+					"key1": regexp.MustCompile(`^value\d$`),
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "unknown value",
+			tags: KeyValueTags{
+				"key1": &TagData{},
+			},
+			requiredConfig: &RequiredConfig{
+				Keys: New([]string{
+					"key1",
+				}),
+				ValueRegexes: map[string]*regexp.Regexp{
+					"key1": regexp.MustCompile(`^value\d$`),
+				},
+			},
+			want: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.requiredConfig.Violations(testCase.tags)
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %q, expected %q", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreElasticbeanstalk(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -689,6 +949,170 @@ func TestKeyValueTagsIgnorePrefixes(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsIgnoreKeyRegexes(t *testing.T) {
+	testCases := []struct {
+		name       string
+		tags       KeyValueTags
+		keyRegexes []*regexp.Regexp
+		want       map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			keyRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`^key`),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "all",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			keyRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`^key`),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"key1":   "value1",
+				"key2":   "value2",
+				"other3": "value3",
+			}),
+			keyRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`1`),
+				regexp.MustCompile(`^other`),
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "none",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			keyRegexes: nil,
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreKeyRegexes(testCase.keyRegexes)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnoreCaseInsensitive(t *testing.T) {
+	testCases := []struct {
+		name       string
+		tags       KeyValueTags
+		ignoreTags KeyValueTags
+		want       map[string]string
+	}{
+		{
+			name:       "empty",
+			tags:       New(map[string]string{}),
+			ignoreTags: New([]string{"key1"}),
+			want:       map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"KEY1": "value2",
+				"Key2": "value3",
+				"key3": "value4",
+			}),
+			ignoreTags: New([]string{"Key1", "key2"}),
+			want: map[string]string{
+				"key3": "value4",
+			},
+		},
+		{
+			name: "none",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			ignoreTags: New([]string{"key10"}),
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreCaseInsensitive(testCase.ignoreTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnoreKeyValuePairs(t *testing.T) {
+	testCases := []struct {
+		name       string
+		tags       KeyValueTags
+		ignoreTags KeyValueTags
+		want       map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			ignoreTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"KEY3": "value3",
+			}),
+			ignoreTags: New(map[string]string{
+				"key1": "value1",
+				"key2": "VALUE2",
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"key2": "value2",
+				"KEY3": "value3",
+			},
+		},
+		{
+			name: "nil value",
+			tags: New(map[string]*string{
+				"key1": nil,
+			}),
+			ignoreTags: New(map[string]string{
+				"key1": "",
+			}),
+			want: map[string]string{
+				"key1": "",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreKeyValuePairs(testCase.ignoreTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreRds(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -37,5 +37,10 @@ func SetLastError(err, lastErr error) {
 		if err.LastError == nil {
 			err.LastError = lastErr
 		}
+
+	case *UnexpectedStateError:
+		if err.LastError == nil {
+			err.LastError = lastErr
+		}
 	}
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/metrics"
 )
 
 // Retryable is a function that is used to decide if a function's error is retryable or not.
@@ -19,6 +20,7 @@ type Retryable func(error) (bool, error)
 // RetryWhenContext retries the function `f` when the error it returns satisfies `predicate`.
 // `f` is retried until `timeout` expires.
 func RetryWhenContext(ctx context.Context, timeout time.Duration, f func() (interface{}, error), retryable Retryable) (interface{}, error) {
+	recordPoll := metrics.Poll("RetryWhen")
 	var output interface{}
 
 	err := resource.Retry(timeout, func() *resource.RetryError { // nosemgrep: helper-schema-resource-Retry-without-TimeoutError-check
@@ -42,6 +44,8 @@ func RetryWhenContext(ctx context.Context, timeout time.Duration, f func() (inte
 		output, err = f()
 	}
 
+	recordPoll(err)
+
 	if err != nil {
 		return nil, err
 	}
@@ -158,6 +162,7 @@ func RetryConfigContext(ctx context.Context, delay time.Duration, delayRand time
 		c.PollInterval = pollInterval
 	}
 
+	recordPoll := metrics.Poll("RetryConfig")
 	_, waitErr := c.WaitForStateContext(ctx)
 
 	// Need to acquire the lock here to be able to avoid race using resultErr as
@@ -168,9 +173,11 @@ func RetryConfigContext(ctx context.Context, delay time.Duration, delayRand time
 	// resultErr may be nil because the wait timed out and resultErr was never
 	// set; this is still an error
 	if resultErr == nil {
+		recordPoll(waitErr)
 		return waitErr
 	}
 	// resultErr takes precedence over waitErr if both are set because it is
 	// more likely to be useful
+	recordPoll(resultErr)
 	return resultErr
 }
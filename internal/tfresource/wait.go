@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/metrics"
 )
 
 type WaitOpts struct {
@@ -25,6 +26,8 @@ const (
 // If `timeout` is exceeded before `f` returns `true`, return an error.
 // Waits between calls to `f` using exponential backoff, except when waiting for the target state to reoccur.
 func WaitUntilContext(ctx context.Context, timeout time.Duration, f func() (bool, error), opts WaitOpts) error {
+	recordPoll := metrics.Poll("WaitUntil")
+
 	refresh := func() (interface{}, string, error) {
 		done, err := f()
 
@@ -52,6 +55,8 @@ func WaitUntilContext(ctx context.Context, timeout time.Duration, f func() (bool
 
 	_, err := stateConf.WaitForStateContext(ctx)
 
+	recordPoll(err)
+
 	return err
 }
 
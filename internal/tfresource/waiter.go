@@ -0,0 +1,336 @@
+package tfresource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/metrics"
+)
+
+const (
+	waiterDefaultMinPollInterval  = 5 * time.Second
+	waiterDefaultMaxPollInterval  = 1 * time.Minute
+	waiterDefaultNotFoundChecks   = 20
+	waiterDefaultProgressInterval = 1 * time.Minute
+)
+
+// Waiter waits for the state returned by Refresh to reach one of the Target states.
+// It is used in the same way as resource.StateChangeConf, but the interval between polls grows
+// exponentially with jitter up to MaxPollInterval, progress is reported while waiting and
+// the returned errors include the reason for the last observed status.
+type Waiter struct {
+	// Description is used in progress messages, e.g. "RDS DB Instance (example) creation".
+	Description string
+
+	Pending []string
+	Target  []string
+	Refresh resource.StateRefreshFunc
+	Timeout time.Duration
+
+	// StatusReason, if set, returns a human-readable reason for the status of the result of Refresh,
+	// e.g. the contents of a status message field. The reason is included in progress reports and errors.
+	StatusReason func(result interface{}) string
+
+	ContinuousTargetOccurence int           // Number of times the target state has to occur continuously.
+	Delay                     time.Duration // Wait this time before starting checks.
+	MinPollInterval           time.Duration // Wait this time before the second check. Defaults to 5 seconds.
+	MaxPollInterval           time.Duration // Longest time to wait between checks. Defaults to 1 minute.
+	NotFoundChecks            int           // Number of times to allow not found (nil result from Refresh) before failing. Defaults to 20.
+
+	// Progress, if set, is called after every check. If not set, progress is logged
+	// whenever the state changes and at least every ProgressInterval.
+	Progress         func(WaiterProgress)
+	ProgressInterval time.Duration // Defaults to 1 minute.
+}
+
+// WaiterProgress describes the progress of a Waiter.
+type WaiterProgress struct {
+	Description string
+	Elapsed     time.Duration
+	Polls       int
+	State       string
+	Reason      string
+}
+
+func (p WaiterProgress) String() string {
+	var b strings.Builder
+
+	description := p.Description
+
+	if description == "" {
+		description = "resource"
+	}
+
+	fmt.Fprintf(&b, "Waiting for %s: state %q after %s (%d checks)", description, p.State, p.Elapsed.Round(time.Second), p.Polls)
+
+	if p.Reason != "" {
+		fmt.Fprintf(&b, ": %s", p.Reason)
+	}
+
+	return b.String()
+}
+
+// UnexpectedStateError is returned by a Waiter when Refresh returns a state that is neither pending nor a target.
+type UnexpectedStateError struct {
+	LastError     error
+	State         string
+	ExpectedState []string
+	Reason        string
+}
+
+func (e *UnexpectedStateError) Error() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "unexpected state '%s', wanted target '%s'", e.State, strings.Join(e.ExpectedState, ", "))
+
+	if e.Reason != "" {
+		fmt.Fprintf(&b, ": %s", e.Reason)
+	}
+
+	if e.LastError != nil {
+		fmt.Fprintf(&b, ". last error: %s", e.LastError)
+	}
+
+	return b.String()
+}
+
+func (e *UnexpectedStateError) Unwrap() error {
+	return e.LastError
+}
+
+// WaitForStateContext waits until the Target state is reached, the Refresh function returns an error,
+// an unexpected state is returned or Timeout expires.
+// The last result of Refresh is returned, along with any error.
+// An error returned by Refresh is returned as is. A timeout returns a *resource.TimeoutError,
+// an unexpected state a *UnexpectedStateError and too many not found results a *resource.NotFoundError.
+func (w *Waiter) WaitForStateContext(ctx context.Context) (interface{}, error) {
+	recordPoll := metrics.Poll("Waiter")
+
+	result, err := w.wait(ctx)
+
+	recordPoll(err)
+
+	return result, err
+}
+
+// WaitForState waits as WaitForStateContext does, without a context.
+func (w *Waiter) WaitForState() (interface{}, error) {
+	return w.WaitForStateContext(context.Background())
+}
+
+func (w *Waiter) wait(ctx context.Context) (interface{}, error) {
+	minPollInterval := w.MinPollInterval
+	if minPollInterval <= 0 {
+		minPollInterval = waiterDefaultMinPollInterval
+	}
+	maxPollInterval := w.MaxPollInterval
+	if maxPollInterval <= 0 {
+		maxPollInterval = waiterDefaultMaxPollInterval
+	}
+	if maxPollInterval < minPollInterval {
+		maxPollInterval = minPollInterval
+	}
+	notFoundChecks := w.NotFoundChecks
+	if notFoundChecks <= 0 {
+		notFoundChecks = waiterDefaultNotFoundChecks
+	}
+	continuousTargetOccurence := w.ContinuousTargetOccurence
+	if continuousTargetOccurence <= 0 {
+		continuousTargetOccurence = 1
+	}
+	progress := w.Progress
+	if progress == nil {
+		progress = w.logProgress()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, w.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	interval := minPollInterval
+	wait := w.Delay
+
+	var (
+		result         interface{}
+		lastState      string
+		lastReason     string
+		polls          int
+		notFoundCount  int
+		targetOccurred int
+	)
+
+	for {
+		if wait > 0 {
+			timer := time.NewTimer(wait)
+
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+
+				return result, w.timeoutError(ctx, lastState, lastReason)
+			case <-timer.C:
+			}
+		}
+
+		res, state, err := w.Refresh()
+		polls++
+
+		if err != nil {
+			return res, err
+		}
+
+		// A nil result means the resource was not found.
+		if res == nil {
+			result = nil
+
+			if len(w.Target) == 0 {
+				targetOccurred++
+
+				if targetOccurred >= continuousTargetOccurence {
+					return nil, nil
+				}
+
+				wait = minPollInterval
+
+				continue
+			}
+
+			notFoundCount++
+
+			if notFoundCount > notFoundChecks {
+				return nil, &resource.NotFoundError{
+					Message: waiterNotFoundMessage(lastState, lastReason, notFoundCount),
+					Retries: notFoundCount,
+				}
+			}
+		} else {
+			result = res
+			notFoundCount = 0
+			lastState = state
+			lastReason = ""
+
+			if w.StatusReason != nil {
+				lastReason = w.StatusReason(res)
+			}
+
+			progress(WaiterProgress{
+				Description: w.Description,
+				Elapsed:     time.Since(start),
+				Polls:       polls,
+				State:       state,
+				Reason:      lastReason,
+			})
+
+			switch {
+			case stringInSlice(state, w.Target):
+				targetOccurred++
+
+				if targetOccurred >= continuousTargetOccurence {
+					return result, nil
+				}
+
+				// Check the target state again promptly.
+				wait = minPollInterval
+
+				continue
+			case stringInSlice(state, w.Pending):
+				targetOccurred = 0
+			default:
+				return result, &UnexpectedStateError{
+					State:         state,
+					ExpectedState: w.Target,
+					Reason:        lastReason,
+				}
+			}
+		}
+
+		wait = jitter(interval)
+		interval *= 2
+
+		if interval > maxPollInterval {
+			interval = maxPollInterval
+		}
+	}
+}
+
+// timeoutError returns the error for a wait that ended before the target state was reached.
+func (w *Waiter) timeoutError(ctx context.Context, lastState, lastReason string) error {
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return ctx.Err()
+	}
+
+	err := &resource.TimeoutError{
+		LastState:     lastState,
+		Timeout:       w.Timeout,
+		ExpectedState: w.Target,
+	}
+
+	if lastReason != "" {
+		err.LastError = errors.New(lastReason)
+	}
+
+	return err
+}
+
+// logProgress returns a progress function that logs whenever the state or reason changes
+// and at least every ProgressInterval.
+func (w *Waiter) logProgress() func(WaiterProgress) {
+	progressInterval := w.ProgressInterval
+	if progressInterval <= 0 {
+		progressInterval = waiterDefaultProgressInterval
+	}
+
+	var (
+		lastLogged         time.Duration
+		lastState, lastMsg string
+	)
+
+	return func(p WaiterProgress) {
+		if p.Polls > 1 && p.State == lastState && p.Reason == lastMsg && p.Elapsed-lastLogged < progressInterval {
+			return
+		}
+
+		lastLogged, lastState, lastMsg = p.Elapsed, p.State, p.Reason
+
+		log.Printf("[INFO] %s", p)
+	}
+}
+
+func waiterNotFoundMessage(lastState, lastReason string, retries int) string {
+	if lastState == "" {
+		return fmt.Sprintf("couldn't find resource (%d retries)", retries)
+	}
+
+	if lastReason != "" {
+		return fmt.Sprintf("couldn't find resource (%d retries, last state: %q: %s)", retries, lastState, lastReason)
+	}
+
+	return fmt.Sprintf("couldn't find resource (%d retries, last state: %q)", retries, lastState)
+}
+
+// jitter returns a random duration between half of and the specified duration.
+func jitter(d time.Duration) time.Duration {
+	half := int64(d / 2)
+
+	if half <= 0 {
+		return d
+	}
+
+	return time.Duration(half + rand.Int63n(half+1)) //nolint:gosec
+}
+
+func stringInSlice(s string, slice []string) bool {
+	for _, v := range slice {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,220 @@
+package tfresource_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type waiterTestResult struct {
+	Status  string
+	Message string
+}
+
+// waiterTestRefresh returns a refresh function that returns the specified states in turn,
+// then the last state indefinitely. An empty state means that the resource is not found.
+func waiterTestRefresh(states ...string) resource.StateRefreshFunc {
+	i := 0
+
+	return func() (interface{}, string, error) {
+		state := states[i]
+
+		if i < len(states)-1 {
+			i++
+		}
+
+		if state == "" {
+			return nil, "", nil
+		}
+
+		return &waiterTestResult{Status: state, Message: "message for " + state}, state, nil
+	}
+}
+
+func waiterTestStatusReason(result interface{}) string {
+	return result.(*waiterTestResult).Message
+}
+
+func TestWaiter(t *testing.T) {
+	testCases := []struct {
+		Name                      string
+		Pending                   []string
+		Target                    []string
+		Refresh                   resource.StateRefreshFunc
+		ContinuousTargetOccurence int
+		ExpectedStatus            string
+		ExpectedPolls             int
+		ExpectedError             func(error) bool
+	}{
+		{
+			Name:           "immediate target",
+			Pending:        []string{"creating"},
+			Target:         []string{"available"},
+			Refresh:        waiterTestRefresh("available"),
+			ExpectedStatus: "available",
+			ExpectedPolls:  1,
+		},
+		{
+			Name:           "pending then target",
+			Pending:        []string{"creating"},
+			Target:         []string{"available"},
+			Refresh:        waiterTestRefresh("creating", "creating", "available"),
+			ExpectedStatus: "available",
+			ExpectedPolls:  3,
+		},
+		{
+			Name:                      "continuous target",
+			Pending:                   []string{"creating"},
+			Target:                    []string{"available"},
+			Refresh:                   waiterTestRefresh("available", "creating", "available", "available"),
+			ContinuousTargetOccurence: 2,
+			ExpectedStatus:            "available",
+			ExpectedPolls:             4,
+		},
+		{
+			Name:          "deleted",
+			Pending:       []string{"deleting"},
+			Target:        []string{},
+			Refresh:       waiterTestRefresh("deleting", ""),
+			ExpectedPolls: 2,
+		},
+		{
+			Name:    "refresh error",
+			Pending: []string{"creating"},
+			Target:  []string{"available"},
+			Refresh: func() (interface{}, string, error) {
+				return nil, "", errors.New("test")
+			},
+			ExpectedPolls: 1,
+			ExpectedError: func(err error) bool {
+				return err.Error() == "test"
+			},
+		},
+		{
+			Name:           "unexpected state",
+			Pending:        []string{"creating"},
+			Target:         []string{"available"},
+			Refresh:        waiterTestRefresh("creating", "failed"),
+			ExpectedStatus: "failed",
+			ExpectedPolls:  2,
+			ExpectedError: func(err error) bool {
+				var e *tfresource.UnexpectedStateError
+				return errors.As(err, &e) && e.State == "failed" && e.Reason == "message for failed" &&
+					err.Error() == "unexpected state 'failed', wanted target 'available': message for failed"
+			},
+		},
+		{
+			Name:          "not found",
+			Pending:       []string{"creating"},
+			Target:        []string{"available"},
+			Refresh:       waiterTestRefresh("creating", ""),
+			ExpectedPolls: 4,
+			ExpectedError: func(err error) bool {
+				return tfresource.NotFound(err) && strings.Contains(err.Error(), `last state: "creating": message for creating`)
+			},
+		},
+		{
+			Name:           "timeout",
+			Pending:        []string{"creating"},
+			Target:         []string{"available"},
+			Refresh:        waiterTestRefresh("creating"),
+			ExpectedStatus: "creating",
+			ExpectedError: func(err error) bool {
+				var e *resource.TimeoutError
+				return errors.As(err, &e) && e.LastState == "creating" && e.LastError != nil && e.LastError.Error() == "message for creating"
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			var progress []tfresource.WaiterProgress
+
+			waiter := &tfresource.Waiter{
+				Pending:                   testCase.Pending,
+				Target:                    testCase.Target,
+				Refresh:                   testCase.Refresh,
+				Timeout:                   100 * time.Millisecond,
+				StatusReason:              waiterTestStatusReason,
+				ContinuousTargetOccurence: testCase.ContinuousTargetOccurence,
+				MinPollInterval:           1 * time.Millisecond,
+				MaxPollInterval:           4 * time.Millisecond,
+				NotFoundChecks:            2,
+				Progress: func(p tfresource.WaiterProgress) {
+					progress = append(progress, p)
+				},
+			}
+
+			outputRaw, err := waiter.WaitForStateContext(context.Background())
+
+			if testCase.ExpectedError != nil {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				if !testCase.ExpectedError(err) {
+					t.Errorf("unexpected error: %s", err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectedStatus == "" {
+				if outputRaw != nil {
+					t.Errorf("expected no result, got %v", outputRaw)
+				}
+			} else if output, ok := outputRaw.(*waiterTestResult); !ok || output.Status != testCase.ExpectedStatus {
+				t.Errorf("expected result with status %q, got %v", testCase.ExpectedStatus, outputRaw)
+			}
+
+			if testCase.ExpectedPolls > 0 && len(progress) > 0 && progress[len(progress)-1].Polls > testCase.ExpectedPolls {
+				t.Errorf("expected at most %d checks, got %d", testCase.ExpectedPolls, progress[len(progress)-1].Polls)
+			}
+
+			for _, p := range progress {
+				if p.State != "" && p.Reason != "message for "+p.State {
+					t.Errorf("unexpected progress reason: %q", p.Reason)
+				}
+			}
+		})
+	}
+}
+
+func TestWaiter_contextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	waiter := &tfresource.Waiter{
+		Pending: []string{"creating"},
+		Target:  []string{"available"},
+		Refresh: waiterTestRefresh("creating"),
+		Timeout: 1 * time.Minute,
+	}
+
+	_, err := waiter.WaitForStateContext(ctx)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestWaiterProgressString(t *testing.T) {
+	p := tfresource.WaiterProgress{
+		Description: "RDS DB Instance (example) creation",
+		Elapsed:     90*time.Second + 400*time.Millisecond,
+		Polls:       7,
+		State:       "creating",
+		Reason:      "waiting for storage",
+	}
+
+	if got, expected := p.String(), `Waiting for RDS DB Instance (example) creation: state "creating" after 1m30s (7 checks): waiting for storage`; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
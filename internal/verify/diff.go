@@ -3,8 +3,10 @@ package verify
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -18,9 +20,11 @@ import (
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
-func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+// The merged tags are checked against any provider-level required tags.
+func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	requiredTagsConfig := meta.(*conns.AWSClient).RequiredTagsConfig
 
 	resourceTags := tftags.New(diff.Get("tags").(map[string]interface{}))
 
@@ -28,7 +32,13 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 		return fmt.Errorf(`"tags" are identical to those in the "default_tags" configuration block of the provider: please de-duplicate and try again`)
 	}
 
-	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
+	mergedTags := defaultTagsConfig.MergeTags(resourceTags)
+
+	if err := checkRequiredTags(ctx, diff, requiredTagsConfig, mergedTags); err != nil {
+		return err
+	}
+
+	allTags := mergedTags.IgnoreConfig(ignoreTagsConfig)
 
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
 	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
@@ -53,6 +63,162 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 	return nil
 }
 
+// checkRequiredTags returns an error naming the resource and any tags which do not satisfy the
+// provider-level required tags.
+func checkRequiredTags(ctx context.Context, diff *schema.ResourceDiff, config *tftags.RequiredConfig, tags tftags.KeyValueTags) error {
+	if config == nil {
+		return nil
+	}
+
+	// Tags which are entirely unknown are checked during the plan that follows apply.
+	if !diff.NewValueKnown("tags") {
+		return nil
+	}
+
+	knownTags := make(tftags.KeyValueTags, len(tags))
+
+	for k, v := range tags {
+		knownTags[k] = v
+	}
+
+	for _, k := range unknownTagKeys(diff) {
+		knownTags[k] = &tftags.TagData{}
+	}
+
+	violations := config.Violations(knownTags)
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	resource := "resource"
+	if typeName, ok := conns.ResourceTypeNameFromContext(ctx); ok {
+		resource = typeName
+	}
+	if id := diff.Id(); id != "" {
+		resource = fmt.Sprintf("%s (%s)", resource, id)
+	}
+
+	return fmt.Errorf("%s does not satisfy the provider required_tags configuration: %s", resource, strings.Join(violations, ", "))
+}
+
+// unknownTagKeys returns the keys of the configured resource tags whose values are not known until apply.
+func unknownTagKeys(diff *schema.ResourceDiff) []string {
+	config := diff.GetRawConfig()
+
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute("tags") {
+		return nil
+	}
+
+	tags := config.GetAttr("tags")
+
+	if tags.IsNull() || !tags.IsKnown() || !tags.CanIterateElements() {
+		return nil
+	}
+
+	var keys []string
+
+	for it := tags.ElementIterator(); it.Next(); {
+		k, v := it.Element()
+
+		if !v.IsKnown() {
+			keys = append(keys, k.AsString())
+		}
+	}
+
+	return keys
+}
+
+// ARNInProviderPartition returns a CustomizeDiffFunc that checks that the ARNs in the specified attributes
+// are in the partition in which the resource is managed, e.g. that an "aws-us-gov" ARN is not used with a
+// provider configured for a commercial region.
+// Attributes may be strings or lists or sets of strings. Only new or changed values that are known at plan
+// time are checked and values that are not ARNs are ignored.
+func ARNInProviderPartition(keys ...string) schema.CustomizeDiffFunc {
+	return arnDiff(keys, func(client *conns.AWSClient, key string, parsedARN arn.ARN) error {
+		if parsedARN.Partition != client.Partition {
+			return fmt.Errorf("%q (%s) is in partition %q, expected the provider partition (%s)", key, parsedARN, parsedARN.Partition, client.Partition)
+		}
+
+		return nil
+	})
+}
+
+// ARNInProviderRegion returns a CustomizeDiffFunc that checks that regional ARNs in the specified attributes
+// are in the partition and region in which the resource is managed, for example for resources that require
+// a KMS key in the same region. ARNs of global resources, with no region, are only checked for partition.
+func ARNInProviderRegion(keys ...string) schema.CustomizeDiffFunc {
+	return arnDiff(keys, func(client *conns.AWSClient, key string, parsedARN arn.ARN) error {
+		if parsedARN.Partition != client.Partition {
+			return fmt.Errorf("%q (%s) is in partition %q, expected the provider partition (%s)", key, parsedARN, parsedARN.Partition, client.Partition)
+		}
+
+		if parsedARN.Region != "" && parsedARN.Region != client.Region {
+			return fmt.Errorf("%q (%s) is in region %q, expected the resource region (%s)", key, parsedARN, parsedARN.Region, client.Region)
+		}
+
+		return nil
+	})
+}
+
+// ARNInProviderAccount returns a CustomizeDiffFunc that checks that ARNs in the specified attributes
+// belong to the account of the provider's credentials. ARNs with no account ID or
+// with the "aws" account ID of AWS managed resources are not checked.
+func ARNInProviderAccount(keys ...string) schema.CustomizeDiffFunc {
+	return arnDiff(keys, func(client *conns.AWSClient, key string, parsedARN arn.ARN) error {
+		if parsedARN.AccountID != "" && parsedARN.AccountID != "aws" && parsedARN.AccountID != client.AccountID {
+			return fmt.Errorf("%q (%s) is in account %q, expected the provider account (%s)", key, parsedARN, parsedARN.AccountID, client.AccountID)
+		}
+
+		return nil
+	})
+}
+
+func arnDiff(keys []string, check func(*conns.AWSClient, string, arn.ARN) error) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		client := meta.(*conns.AWSClient)
+
+		for _, key := range keys {
+			if !diff.HasChange(key) || !diff.NewValueKnown(key) {
+				continue
+			}
+
+			var values []interface{}
+
+			switch v := diff.Get(key).(type) {
+			case string:
+				values = []interface{}{v}
+			case []interface{}:
+				values = v
+			case *schema.Set:
+				values = v.List()
+			default:
+				return fmt.Errorf("%q is not a string, list or set", key)
+			}
+
+			for _, v := range values {
+				value, ok := v.(string)
+
+				if !ok || value == "" {
+					continue
+				}
+
+				parsedARN, err := arn.Parse(value)
+
+				if err != nil {
+					continue
+				}
+
+				if err := check(client, key, parsedARN); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
 // SuppressEquivalentTypeStringBoolean provides custom difference suppression for TypeString booleans
 // Some arguments require three values: true, false, and "" (unspecified), but
 // confusing behavior exists when converting bare true/false values with state.
@@ -1,9 +1,14 @@
 package verify
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 )
 
@@ -144,3 +149,106 @@ func TestDiffStringMaps(t *testing.T) {
 		}
 	}
 }
+
+func TestARNInProvider(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"kms_key_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"role_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		CustomizeDiff: customdiff.Sequence(
+			ARNInProviderRegion("kms_key_arn"),
+			ARNInProviderPartition("role_arns"),
+			ARNInProviderAccount("kms_key_arn", "role_arns"),
+		),
+	}
+	meta := &conns.AWSClient{
+		AccountID: "123456789012",
+		Partition: "aws",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	cases := []struct {
+		Name        string
+		State       map[string]string
+		Config      map[string]interface{}
+		ExpectError bool
+	}{
+		{
+			Name: "valid",
+			Config: map[string]interface{}{
+				"kms_key_arn": "arn:aws:kms:us-west-2:123456789012:key/1234abcd",            //lintignore:AWSAT003,AWSAT005
+				"role_arns":   []interface{}{"arn:aws:iam::123456789012:role/example", "a"}, //lintignore:AWSAT005
+			},
+		},
+		{
+			Name: "AWS managed",
+			Config: map[string]interface{}{
+				"role_arns": []interface{}{"arn:aws:iam::aws:policy/ReadOnlyAccess"}, //lintignore:AWSAT005
+			},
+		},
+		{
+			Name: "region",
+			Config: map[string]interface{}{
+				"kms_key_arn": "arn:aws:kms:us-east-1:123456789012:key/1234abcd", //lintignore:AWSAT003,AWSAT005
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "partition",
+			Config: map[string]interface{}{
+				"role_arns": []interface{}{"arn:aws-us-gov:iam::123456789012:role/example"}, //lintignore:AWSAT005
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "account",
+			Config: map[string]interface{}{
+				"kms_key_arn": "arn:aws:kms:us-west-2:210987654321:key/1234abcd", //lintignore:AWSAT003,AWSAT005
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "unchanged",
+			State: map[string]string{
+				"id":          "example",
+				"kms_key_arn": "arn:aws:kms:us-east-1:123456789012:key/1234abcd", //lintignore:AWSAT003,AWSAT005
+			},
+			Config: map[string]interface{}{
+				"kms_key_arn": "arn:aws:kms:us-east-1:123456789012:key/1234abcd", //lintignore:AWSAT003,AWSAT005
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.Name, func(t *testing.T) {
+			var state *terraform.InstanceState
+
+			if tc.State != nil {
+				state = &terraform.InstanceState{
+					ID:         tc.State["id"],
+					Attributes: tc.State,
+				}
+			}
+
+			_, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(tc.Config), meta)
+
+			if tc.ExpectError && err == nil {
+				t.Fatal("expected error")
+			}
+
+			if !tc.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
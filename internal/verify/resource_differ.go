@@ -6,5 +6,6 @@ package verify
 // * schema.ResourceDiff
 // FIXME: can be removed if https://github.com/hashicorp/terraform-plugin-sdk/pull/626/files is merged
 type ResourceDiffer interface {
+	GetChange(string) (interface{}, interface{})
 	HasChange(string) bool
 }
@@ -9,6 +9,9 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -52,6 +55,42 @@ func ValidARN(v interface{}, k string) (ws []string, errors []error) {
 	return ws, errors
 }
 
+// ValidARNPartitionRegion validates that a value is an ARN, as ValidARN does, and that the ARN's region,
+// if any, is in the ARN's partition, e.g. "arn:aws:kms:us-gov-west-1:..." is rejected.
+// It does not have access to the provider configuration; use ARNInProviderPartition and ARNInProviderRegion
+// to check ARNs against the partition and region in which the resource is managed.
+func ValidARNPartitionRegion(v interface{}, path cty.Path) diag.Diagnostics {
+	diags := validation.ToDiagFunc(ValidARN)(v, path)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	value, ok := v.(string)
+
+	if !ok || value == "" {
+		return diags
+	}
+
+	parsedARN, err := arn.Parse(value)
+
+	if err != nil || parsedARN.Region == "" {
+		return diags
+	}
+
+	// Regions not known to the AWS SDK are not checked.
+	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), parsedARN.Region); ok && partition.ID() != parsedARN.Partition {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid ARN partition",
+			Detail:        fmt.Sprintf("%s is an invalid ARN: region %q is in partition %q, not %q", value, parsedARN.Region, partition.ID(), parsedARN.Partition),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
 func ValidAccountID(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -281,6 +320,8 @@ func ValidTypeStringNullableBoolean(v interface{}, k string) (ws []string, es []
 
 // ValidTypeStringNullableFloat provides custom error messaging for TypeString floats
 // Some arguments require a floating point value or an unspecified, empty field.
+//
+// Deprecated: Use nullable.TypeNullableFloat and nullable.ValidateTypeStringNullableFloat instead.
 func ValidTypeStringNullableFloat(v interface{}, k string) (ws []string, es []error) {
 	value, ok := v.(string)
 	if !ok {
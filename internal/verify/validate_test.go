@@ -4,6 +4,8 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
 )
 
 func TestValidTypeStringNullableBoolean(t *testing.T) {
@@ -184,6 +186,42 @@ func TestValidARN(t *testing.T) {
 	}
 }
 
+func TestValidARNPartitionRegion(t *testing.T) {
+	validNames := []string{
+		"",
+		"arn:aws:iam::123456789012:user/David", // lintignore:AWSAT005          // Global
+		"arn:aws:kms:us-east-1:123456789012:key/1234abcd",                    // lintignore:AWSAT003,AWSAT005 // Commercial
+		"arn:aws:kms:xx-unknown-1:123456789012:key/1234abcd",                 // lintignore:AWSAT003,AWSAT005 // Unknown region
+		"arn:aws-cn:ec2:cn-north-1:123456789012:instance/i-12345678",         // lintignore:AWSAT003,AWSAT005 // China
+		"arn:aws-us-gov:ec2:us-gov-west-1:123456789012:instance/i-12345678",  // lintignore:AWSAT003,AWSAT005 // GovCloud
+		"arn:aws-iso:ec2:us-iso-east-1:123456789012:instance/i-12345678",     // lintignore:AWSAT003,AWSAT005 // C2S
+		"arn:aws-iso-b:ec2:us-isob-east-1:123456789012:instance/i-12345678",  // lintignore:AWSAT003,AWSAT005 // SC2S
+		"arn:aws-us-gov:s3:::bucket/object",                                  // lintignore:AWSAT005          // GovCloud S3
+		"arn:aws:elasticbeanstalk:eu-west-1:123456789012:environment/My App", // lintignore:AWSAT003,AWSAT005 // Beanstalk
+	}
+	for _, v := range validNames {
+		diags := ValidARNPartitionRegion(v, cty.GetAttrPath("arn"))
+		if diags.HasError() {
+			t.Fatalf("%q should be a valid ARN: %v", v, diags)
+		}
+	}
+
+	invalidNames := []string{
+		"arn",
+		"arn:aws:logs", //lintignore:AWSAT005
+		"arn:aws:kms:us-gov-west-1:123456789012:key/1234abcd",             //lintignore:AWSAT003,AWSAT005
+		"arn:aws-us-gov:kms:us-east-1:123456789012:key/1234abcd",          //lintignore:AWSAT003,AWSAT005
+		"arn:aws:ec2:cn-north-1:123456789012:instance/i-12345678",         //lintignore:AWSAT003,AWSAT005
+		"arn:aws-iso:ec2:us-isob-east-1:123456789012:instance/i-12345678", //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range invalidNames {
+		diags := ValidARNPartitionRegion(v, cty.GetAttrPath("arn"))
+		if !diags.HasError() {
+			t.Fatalf("%q should be an invalid ARN", v)
+		}
+	}
+}
+
 func TestValidateCIDRBlock(t *testing.T) {
 	for _, ts := range []struct {
 		cidr  string
@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-provider-aws/internal/metrics"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
@@ -28,4 +29,8 @@ func main() {
 	}
 
 	plugin.Serve(opts)
+
+	if err := metrics.Flush(); err != nil {
+		log.Printf("[WARN] %s", err)
+	}
 }
@@ -0,0 +1,97 @@
+package AWSAT007
+
+import (
+	"go/ast"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for resource acceptance tests missing a disappears test
+
+The AWSAT007 analyzer reports when a test file contains a resource acceptance
+test ending in _basic, but no acceptance test in the file calls
+acctest.CheckResourceDisappears(). A disappears test verifies that the
+resource correctly handles removal outside of Terraform, returning to a plan
+to recreate the resource instead of an error.
+
+Data source acceptance tests, identified by DataSource in the test function
+name, are not reported.
+`
+
+const analyzerName = "AWSAT007"
+
+const checkResourceDisappearsFuncName = "CheckResourceDisappears"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+
+	for _, file := range pass.Files {
+		if !strings.HasSuffix(pass.Fset.File(file.Pos()).Name(), "_test.go") {
+			continue
+		}
+
+		var basicFuncDecls []*ast.FuncDecl
+		var disappearsCallExprFound bool
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncDecl:
+				if IsResourceBasicTestFuncName(n.Name.Name) {
+					basicFuncDecls = append(basicFuncDecls, n)
+				}
+			case *ast.CallExpr:
+				if isCheckResourceDisappearsFunc(n.Fun) {
+					disappearsCallExprFound = true
+				}
+			}
+
+			return true
+		})
+
+		if disappearsCallExprFound {
+			continue
+		}
+
+		for _, funcDecl := range basicFuncDecls {
+			if commentIgnorer.ShouldIgnore(analyzerName, funcDecl) {
+				continue
+			}
+
+			pass.Reportf(funcDecl.Pos(), "%s: missing acctest.CheckResourceDisappears() test (ignore if not applicable)", analyzerName)
+		}
+	}
+
+	return nil, nil
+}
+
+// IsResourceBasicTestFuncName returns true if the function name is a resource
+// acceptance test ending in _basic, e.g. TestAccLambdaFunction_basic.
+func IsResourceBasicTestFuncName(name string) bool {
+	if !strings.HasPrefix(name, "TestAcc") || !strings.HasSuffix(name, "_basic") {
+		return false
+	}
+
+	return !strings.Contains(name, "DataSource")
+}
+
+func isCheckResourceDisappearsFunc(e ast.Expr) bool {
+	switch e := e.(type) {
+	case *ast.Ident:
+		return e.Name == checkResourceDisappearsFuncName
+	case *ast.SelectorExpr:
+		return e.Sel.Name == checkResourceDisappearsFuncName
+	}
+
+	return false
+}
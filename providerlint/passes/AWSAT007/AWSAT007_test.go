@@ -0,0 +1,59 @@
+package AWSAT007_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/providerlint/passes/AWSAT007"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAWSAT007(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, AWSAT007.Analyzer, "a")
+}
+
+func TestIsResourceBasicTestFuncName(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		FuncName string
+		Expected bool
+	}{
+		{
+			Name:     "empty",
+			FuncName: "",
+			Expected: false,
+		},
+		{
+			Name:     "resource basic",
+			FuncName: "TestAccLambdaFunction_basic",
+			Expected: true,
+		},
+		{
+			Name:     "resource other",
+			FuncName: "TestAccLambdaFunction_tags",
+			Expected: false,
+		},
+		{
+			Name:     "data source basic",
+			FuncName: "TestAccLambdaFunctionDataSource_basic",
+			Expected: false,
+		},
+		{
+			Name:     "not acceptance test",
+			FuncName: "TestLambdaFunction_basic",
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := AWSAT007.IsResourceBasicTestFuncName(testCase.FuncName)
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
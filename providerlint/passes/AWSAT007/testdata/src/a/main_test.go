@@ -0,0 +1,13 @@
+package a
+
+import (
+	"testing"
+)
+
+func TestAccExampleThing_basic(t *testing.T) {} // want "missing acctest.CheckResourceDisappears\\(\\) test"
+
+func TestAccExampleOtherThing_basic(t *testing.T) {} //lintignore:AWSAT007
+
+func TestAccExampleThing_tags(t *testing.T) {}
+
+func TestAccExampleThingDataSource_basic(t *testing.T) {}
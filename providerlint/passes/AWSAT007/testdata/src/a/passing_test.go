@@ -0,0 +1,13 @@
+package a
+
+import (
+	"testing"
+)
+
+func TestAccExamplePassingThing_basic(t *testing.T) {}
+
+func TestAccExamplePassingThing_disappears(t *testing.T) {
+	CheckResourceDisappears()
+}
+
+func CheckResourceDisappears() {}
@@ -0,0 +1,99 @@
+package AWSR003
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/crudfuncinfo"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for fmt.Errorf() calls wrapping errors without %w in CRUD functions
+
+The AWSR003 analyzer reports when a fmt.Errorf() call within a resource
+Create, Read, Update, or Delete function receives an error argument, but the
+format string does not contain the %w verb. Wrapping the error preserves it
+for errors.As() and errors.Is() and the tfawserr helpers used by callers, such
+as retry and not found handling.
+`
+
+const analyzerName = "AWSR003"
+
+var errorType = types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		crudfuncinfo.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	crudFuncs := pass.ResultOf[crudfuncinfo.Analyzer].([]*schema.CRUDFuncInfo)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+
+	for _, crudFunc := range crudFuncs {
+		if crudFunc.Body == nil {
+			continue
+		}
+
+		ast.Inspect(crudFunc.Body, func(n ast.Node) bool {
+			callExpr, ok := n.(*ast.CallExpr)
+
+			if !ok {
+				return true
+			}
+
+			if !astutils.IsStdlibPackageFunc(callExpr.Fun, pass.TypesInfo, "fmt", "Errorf") {
+				return true
+			}
+
+			if len(callExpr.Args) < 2 {
+				return true
+			}
+
+			formatString := astutils.ExprStringValue(callExpr.Args[0])
+
+			if formatString == nil || strings.Contains(*formatString, "%w") {
+				return true
+			}
+
+			if !hasErrorArg(pass, callExpr.Args[1:]) {
+				return true
+			}
+
+			if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+				return true
+			}
+
+			pass.Reportf(callExpr.Pos(), "%s: prefer %%w verb when wrapping errors with fmt.Errorf()", analyzerName)
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+func hasErrorArg(pass *analysis.Pass, args []ast.Expr) bool {
+	for _, arg := range args {
+		t := pass.TypesInfo.TypeOf(arg)
+
+		if t == nil {
+			continue
+		}
+
+		if types.Implements(t, errorType) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,14 @@
+package AWSR003_test
+
+import (
+	"testing"
+
+	_ "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/passes/AWSR003"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAWSR003(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, AWSR003.Analyzer, "a")
+}
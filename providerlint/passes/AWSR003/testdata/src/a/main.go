@@ -0,0 +1,53 @@
+package a
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func f() {
+	_ = &schema.Resource{
+		Create: resourceExampleThingCreate,
+		Read:   resourceExampleThingRead,
+		Delete: resourceExampleThingDelete,
+	}
+}
+
+func resourceExampleThingCreate(d *schema.ResourceData, meta interface{}) error {
+	err := errors.New("test")
+
+	/* Passing cases */
+
+	fmt.Errorf("error creating Example Thing (%s): %w", d.Id(), err)
+
+	fmt.Errorf("error creating Example Thing (%s)", d.Id())
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR003
+	fmt.Errorf("error creating Example Thing (%s): %s", d.Id(), err)
+
+	fmt.Errorf("error creating Example Thing (%s): %s", d.Id(), err) //lintignore:AWSR003
+
+	/* Failing cases */
+
+	fmt.Errorf("error creating Example Thing (%s): %s", d.Id(), err) // want "prefer %w verb when wrapping errors with fmt.Errorf\\(\\)"
+
+	return fmt.Errorf("error creating Example Thing: %v", err) // want "prefer %w verb when wrapping errors with fmt.Errorf\\(\\)"
+}
+
+func resourceExampleThingRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceExampleThingDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func notCRUDFunc() error {
+	err := errors.New("test")
+
+	return fmt.Errorf("error: %s", err)
+}
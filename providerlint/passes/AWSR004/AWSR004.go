@@ -0,0 +1,65 @@
+package AWSR004
+
+import (
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourceinfo"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for Resource declaring Create, Read, Update, or Delete
+
+The AWSR004 analyzer reports when a schema.Resource declares the Create, Read,
+Update, or Delete fields instead of the CreateContext, ReadContext,
+UpdateContext, or DeleteContext fields. The context-aware variants receive a
+context.Context, which should be passed to AWS Go SDK WithContext calls, and
+return diag.Diagnostics.
+`
+
+const analyzerName = "AWSR004"
+
+// contextFields maps each deprecated field to its context-aware replacement.
+var contextFields = map[string]string{
+	schema.ResourceFieldCreate: schema.ResourceFieldCreateContext,
+	schema.ResourceFieldRead:   schema.ResourceFieldReadContext,
+	schema.ResourceFieldUpdate: schema.ResourceFieldUpdateContext,
+	schema.ResourceFieldDelete: schema.ResourceFieldDeleteContext,
+}
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		resourceinfo.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	resourceInfos := pass.ResultOf[resourceinfo.Analyzer].([]*schema.ResourceInfo)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+
+	for _, resourceInfo := range resourceInfos {
+		for _, field := range []string{
+			schema.ResourceFieldCreate,
+			schema.ResourceFieldRead,
+			schema.ResourceFieldUpdate,
+			schema.ResourceFieldDelete,
+		} {
+			kvExpr := resourceInfo.Fields[field]
+
+			if kvExpr == nil {
+				continue
+			}
+
+			if commentIgnorer.ShouldIgnore(analyzerName, kvExpr) {
+				continue
+			}
+
+			pass.Reportf(kvExpr.Pos(), "%s: prefer %s over %s", analyzerName, contextFields[field], field)
+		}
+	}
+
+	return nil, nil
+}
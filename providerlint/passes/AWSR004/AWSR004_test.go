@@ -0,0 +1,14 @@
+package AWSR004_test
+
+import (
+	"testing"
+
+	_ "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/passes/AWSR004"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAWSR004(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, AWSR004.Analyzer, "a")
+}
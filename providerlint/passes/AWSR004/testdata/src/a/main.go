@@ -0,0 +1,68 @@
+package a
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func f() {
+	/* Passing cases */
+
+	_ = &schema.Resource{
+		CreateContext: resourceExampleThingCreateContext,
+		ReadContext:   resourceExampleThingReadContext,
+		UpdateContext: resourceExampleThingUpdateContext,
+		DeleteContext: resourceExampleThingDeleteContext,
+	}
+
+	/* Comment ignored cases */
+
+	_ = &schema.Resource{
+		//lintignore:AWSR004
+		Create: resourceExampleThingCreate,
+		Read:   resourceExampleThingRead, //lintignore:AWSR004
+	}
+
+	/* Failing cases */
+
+	_ = &schema.Resource{
+		Create: resourceExampleThingCreate, // want "prefer CreateContext over Create"
+		Read:   resourceExampleThingRead,   // want "prefer ReadContext over Read"
+		Update: resourceExampleThingUpdate, // want "prefer UpdateContext over Update"
+		Delete: resourceExampleThingDelete, // want "prefer DeleteContext over Delete"
+	}
+}
+
+func resourceExampleThingCreate(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceExampleThingRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceExampleThingUpdate(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceExampleThingDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceExampleThingCreateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceExampleThingReadContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceExampleThingUpdateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceExampleThingDeleteContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
@@ -0,0 +1,123 @@
+package AWSR005
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for d.Set() calls ignoring the returned error with complex values
+
+The AWSR005 analyzer reports when a (schema.ResourceData).Set() call receives
+a complex value, such as a list, map, set, or structure, and the returned error
+is ignored, either by discarding the call result or by assigning it to the
+blank identifier. Setting complex values can fail when the value does not
+match the schema, which otherwise silently breaks drift detection.
+
+This is similar to the tfproviderlint XR004 analyzer, but also reports errors
+assigned to the blank identifier.
+`
+
+const analyzerName = "AWSR005"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.AssignStmt)(nil),
+		(*ast.ExprStmt)(nil),
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		var callExpr *ast.CallExpr
+
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Lhs) != 1 || len(n.Rhs) != 1 {
+				return
+			}
+
+			if ident, ok := n.Lhs[0].(*ast.Ident); !ok || ident.Name != "_" {
+				return
+			}
+
+			callExpr, _ = n.Rhs[0].(*ast.CallExpr)
+		case *ast.ExprStmt:
+			callExpr, _ = n.X.(*ast.CallExpr)
+		}
+
+		if callExpr == nil {
+			return
+		}
+
+		if !schema.IsReceiverMethod(callExpr.Fun, pass.TypesInfo, schema.TypeNameResourceData, "Set") {
+			return
+		}
+
+		if len(callExpr.Args) < 2 {
+			return
+		}
+
+		if IsBasicType(pass.TypesInfo.TypeOf(callExpr.Args[1])) {
+			return
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, n) {
+			return
+		}
+
+		pass.Reportf(callExpr.Pos(), "%s: check the error returned by d.Set() with complex values", analyzerName)
+	})
+
+	return nil, nil
+}
+
+// IsBasicType returns true if the type is a basic type, or a pointer to one,
+// which cannot fail to be set.
+func IsBasicType(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	switch t := t.Underlying().(type) {
+	case *types.Basic:
+		for _, kind := range basicKinds {
+			if t.Kind() == kind {
+				return true
+			}
+		}
+	case *types.Pointer:
+		return IsBasicType(t.Elem())
+	}
+
+	return false
+}
+
+var basicKinds = []types.BasicKind{
+	types.Bool,
+	types.Float32,
+	types.Float64,
+	types.Int,
+	types.Int8,
+	types.Int16,
+	types.Int32,
+	types.Int64,
+	types.String,
+	types.UntypedNil,
+}
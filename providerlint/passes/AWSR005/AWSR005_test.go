@@ -0,0 +1,66 @@
+package AWSR005_test
+
+import (
+	"go/types"
+	"testing"
+
+	_ "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/passes/AWSR005"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAWSR005(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, AWSR005.Analyzer, "a")
+}
+
+func TestIsBasicType(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Type     types.Type
+		Expected bool
+	}{
+		{
+			Name:     "nil",
+			Type:     nil,
+			Expected: false,
+		},
+		{
+			Name:     "string",
+			Type:     types.Typ[types.String],
+			Expected: true,
+		},
+		{
+			Name:     "pointer to int64",
+			Type:     types.NewPointer(types.Typ[types.Int64]),
+			Expected: true,
+		},
+		{
+			Name:     "untyped nil",
+			Type:     types.Typ[types.UntypedNil],
+			Expected: true,
+		},
+		{
+			Name:     "slice",
+			Type:     types.NewSlice(types.NewInterfaceType(nil, nil)),
+			Expected: false,
+		},
+		{
+			Name:     "map",
+			Type:     types.NewMap(types.Typ[types.String], types.Typ[types.String]),
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := AWSR005.IsBasicType(testCase.Type)
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
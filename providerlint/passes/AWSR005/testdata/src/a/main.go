@@ -0,0 +1,42 @@
+package a
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func f() {
+	var d schema.ResourceData
+
+	var s string
+	var l []interface{}
+	var m map[string]interface{}
+
+	/* Passing cases */
+
+	d.Set("name", "test")
+
+	d.Set("name", s)
+
+	d.Set("name", &s)
+
+	_ = d.Set("name", s)
+
+	if err := d.Set("list", l); err != nil {
+		return
+	}
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR005
+	d.Set("list", l)
+
+	_ = d.Set("map", m) //lintignore:AWSR005
+
+	/* Failing cases */
+
+	d.Set("list", l) // want "check the error returned by d.Set\\(\\) with complex values"
+
+	d.Set("map", m) // want "check the error returned by d.Set\\(\\) with complex values"
+
+	_ = d.Set("list", l) // want "check the error returned by d.Set\\(\\) with complex values"
+}
@@ -7,8 +7,12 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/providerlint/passes/AWSAT004"
 	"github.com/hashicorp/terraform-provider-aws/providerlint/passes/AWSAT005"
 	"github.com/hashicorp/terraform-provider-aws/providerlint/passes/AWSAT006"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/passes/AWSAT007"
 	"github.com/hashicorp/terraform-provider-aws/providerlint/passes/AWSR001"
 	"github.com/hashicorp/terraform-provider-aws/providerlint/passes/AWSR002"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/passes/AWSR003"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/passes/AWSR004"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/passes/AWSR005"
 	"github.com/hashicorp/terraform-provider-aws/providerlint/passes/AWSV001"
 	"golang.org/x/tools/go/analysis"
 )
@@ -20,7 +24,11 @@ var AllChecks = []*analysis.Analyzer{
 	AWSAT004.Analyzer,
 	AWSAT005.Analyzer,
 	AWSAT006.Analyzer,
+	AWSAT007.Analyzer,
 	AWSR001.Analyzer,
 	AWSR002.Analyzer,
+	AWSR003.Analyzer,
+	AWSR004.Analyzer,
+	AWSR005.Analyzer,
 	AWSV001.Analyzer,
 }